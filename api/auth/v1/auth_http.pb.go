@@ -19,18 +19,148 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthAcceptInvitation = "/auth.v1.Auth/AcceptInvitation"
+const OperationAuthChangePassword = "/auth.v1.Auth/ChangePassword"
+const OperationAuthConfirmTOTP = "/auth.v1.Auth/ConfirmTOTP"
+const OperationAuthCreateAPIKey = "/auth.v1.Auth/CreateAPIKey"
+const OperationAuthCreateServiceAccount = "/auth.v1.Auth/CreateServiceAccount"
+const OperationAuthDisableServiceAccount = "/auth.v1.Auth/DisableServiceAccount"
+const OperationAuthDisableTOTP = "/auth.v1.Auth/DisableTOTP"
+const OperationAuthEnrollTOTP = "/auth.v1.Auth/EnrollTOTP"
+const OperationAuthEnrollTOTPForLogin = "/auth.v1.Auth/EnrollTOTPForLogin"
+const OperationAuthForgotPassword = "/auth.v1.Auth/ForgotPassword"
+const OperationAuthInviteUser = "/auth.v1.Auth/InviteUser"
+const OperationAuthLinkEmployee = "/auth.v1.Auth/LinkEmployee"
+const OperationAuthListAuthEvents = "/auth.v1.Auth/ListAuthEvents"
+const OperationAuthListServiceAccounts = "/auth.v1.Auth/ListServiceAccounts"
+const OperationAuthListSessions = "/auth.v1.Auth/ListSessions"
 const OperationAuthLogin = "/auth.v1.Auth/Login"
+const OperationAuthLoginTwoFactor = "/auth.v1.Auth/LoginTwoFactor"
+const OperationAuthLogout = "/auth.v1.Auth/Logout"
+const OperationAuthLogoutAll = "/auth.v1.Auth/LogoutAll"
+const OperationAuthOIDCCallback = "/auth.v1.Auth/OIDCCallback"
+const OperationAuthOIDCLogin = "/auth.v1.Auth/OIDCLogin"
+const OperationAuthRefreshToken = "/auth.v1.Auth/RefreshToken"
+const OperationAuthRegenerateRecoveryCodes = "/auth.v1.Auth/RegenerateRecoveryCodes"
 const OperationAuthRegister = "/auth.v1.Auth/Register"
+const OperationAuthResendVerification = "/auth.v1.Auth/ResendVerification"
+const OperationAuthResetPassword = "/auth.v1.Auth/ResetPassword"
+const OperationAuthResetUserTOTP = "/auth.v1.Auth/ResetUserTOTP"
+const OperationAuthRevokeAPIKey = "/auth.v1.Auth/RevokeAPIKey"
+const OperationAuthRevokeSession = "/auth.v1.Auth/RevokeSession"
+const OperationAuthSetUserRole = "/auth.v1.Auth/SetUserRole"
+const OperationAuthUnlockUser = "/auth.v1.Auth/UnlockUser"
+const OperationAuthVerifyEmail = "/auth.v1.Auth/VerifyEmail"
 
 type AuthHTTPServer interface {
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationReply, error)
+	// ChangePassword ChangePassword sets a new password for the caller and ends their other
+	// sessions. New passwords must follow the password policy and differ from
+	// the latest ones.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*AccountReply, error)
+	ConfirmTOTP(context.Context, *TOTPCodeRequest) (*RecoveryCodesReply, error)
+	// CreateAPIKey CreateAPIKey returns the new key once; only a hash of it is stored.
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyReply, error)
+	// CreateServiceAccount Service accounts call the API with API keys limited to the scopes
+	// granted to each key.
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*ServiceAccountReply, error)
+	// DisableServiceAccount DisableServiceAccount stops all of the account's keys from working.
+	DisableServiceAccount(context.Context, *DisableServiceAccountRequest) (*ServiceAccountReply, error)
+	// DisableTOTP DisableTOTP is refused for roles that require two-factor authentication.
+	DisableTOTP(context.Context, *TOTPCodeRequest) (*AccountReply, error)
+	// EnrollTOTP EnrollTOTP starts TOTP enrollment for the caller; ConfirmTOTP finishes it.
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error)
+	// EnrollTOTPForLogin EnrollTOTPForLogin starts TOTP enrollment for a user whose role requires
+	// two-factor authentication, from the login challenge. The first code is
+	// sent to LoginTwoFactor.
+	EnrollTOTPForLogin(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error)
+	// ForgotPassword ForgotPassword emails a single-use link to reset the password.
+	ForgotPassword(context.Context, *ForgotPasswordRequest) (*AccountReply, error)
+	// InviteUser InviteUser emails a one-time link to create an account bound to the
+	// employee.
+	InviteUser(context.Context, *InviteUserRequest) (*InviteUserReply, error)
+	// LinkEmployee LinkEmployee binds an existing account to an employee record. The
+	// user's sessions are ended so the link shows in their next token.
+	LinkEmployee(context.Context, *LinkEmployeeRequest) (*LinkEmployeeReply, error)
+	// ListAuthEvents ListAuthEvents returns the audit trail of lockouts, unlocks and blocked
+	// IPs, newest first.
+	ListAuthEvents(context.Context, *ListAuthEventsRequest) (*ListAuthEventsReply, error)
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsReply, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsReply, error)
+	// Login Login fails until the account's email address is verified. Unknown
+	// usernames and wrong passwords get the same error; repeated failures lock
+	// the username for a growing time and refuse further logins from the IP.
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// LoginTwoFactor LoginTwoFactor completes a login that returned a challenge token.
+	LoginTwoFactor(context.Context, *LoginTwoFactorRequest) (*LoginReply, error)
+	// Logout Logout ends the session of the calling token.
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	// LogoutAll LogoutAll ends every session of the caller.
+	LogoutAll(context.Context, *LogoutRequest) (*LogoutReply, error)
+	// OIDCCallback OIDCCallback finishes the login with the code the provider redirected
+	// back with. The account is linked by verified email or created on first
+	// login. Like Login it may answer with a second-factor challenge.
+	OIDCCallback(context.Context, *OIDCCallbackRequest) (*LoginReply, error)
+	// OIDCLogin OIDCLogin starts a login through the company's identity provider.
+	OIDCLogin(context.Context, *OIDCLoginRequest) (*OIDCLoginReply, error)
+	// RefreshToken RefreshToken exchanges a refresh token for a new access and refresh
+	// token. Reusing a spent refresh token ends its session.
+	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginReply, error)
+	RegenerateRecoveryCodes(context.Context, *TOTPCodeRequest) (*RecoveryCodesReply, error)
 	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
+	ResendVerification(context.Context, *ResendVerificationRequest) (*AccountReply, error)
+	// ResetPassword ResetPassword sets a new password with the token from the reset email
+	// and ends all sessions of the account.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*AccountReply, error)
+	// ResetUserTOTP ResetUserTOTP removes a user's two-factor authentication after they lost
+	// their authenticator and recovery codes, and ends their sessions.
+	ResetUserTOTP(context.Context, *ResetUserTOTPRequest) (*ResetUserTOTPReply, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKeyReply, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*LogoutReply, error)
+	// SetUserRole SetUserRole changes a user's role. The user's sessions are ended so the
+	// new role applies from their next login.
+	SetUserRole(context.Context, *SetUserRoleRequest) (*SetUserRoleReply, error)
+	// UnlockUser UnlockUser lifts a lockout caused by failed logins.
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserReply, error)
+	// VerifyEmail VerifyEmail confirms the address with the token from the verification
+	// email sent on registration.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*AccountReply, error)
 }
 
 func RegisterAuthHTTPServer(s *http.Server, srv AuthHTTPServer) {
 	r := s.Route("/")
 	r.POST("/auth/register", _Auth_Register0_HTTP_Handler(srv))
 	r.POST("/auth/login", _Auth_Login0_HTTP_Handler(srv))
+	r.GET("/auth/oidc/login", _Auth_OIDCLogin0_HTTP_Handler(srv))
+	r.POST("/auth/oidc/callback", _Auth_OIDCCallback0_HTTP_Handler(srv))
+	r.POST("/auth/login/2fa", _Auth_LoginTwoFactor0_HTTP_Handler(srv))
+	r.POST("/auth/login/2fa/enroll", _Auth_EnrollTOTPForLogin0_HTTP_Handler(srv))
+	r.POST("/auth/2fa/enroll", _Auth_EnrollTOTP0_HTTP_Handler(srv))
+	r.POST("/auth/2fa/confirm", _Auth_ConfirmTOTP0_HTTP_Handler(srv))
+	r.POST("/auth/2fa/disable", _Auth_DisableTOTP0_HTTP_Handler(srv))
+	r.POST("/auth/2fa/recovery-codes", _Auth_RegenerateRecoveryCodes0_HTTP_Handler(srv))
+	r.POST("/auth/password/change", _Auth_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/auth/verify-email", _Auth_VerifyEmail0_HTTP_Handler(srv))
+	r.POST("/auth/verify-email/resend", _Auth_ResendVerification0_HTTP_Handler(srv))
+	r.POST("/auth/password/forgot", _Auth_ForgotPassword0_HTTP_Handler(srv))
+	r.POST("/auth/password/reset", _Auth_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/auth/refresh", _Auth_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/auth/logout", _Auth_Logout0_HTTP_Handler(srv))
+	r.POST("/auth/logout-all", _Auth_LogoutAll0_HTTP_Handler(srv))
+	r.GET("/auth/sessions", _Auth_ListSessions0_HTTP_Handler(srv))
+	r.DELETE("/auth/sessions/{id}", _Auth_RevokeSession0_HTTP_Handler(srv))
+	r.POST("/auth/users/{id}/role", _Auth_SetUserRole0_HTTP_Handler(srv))
+	r.POST("/auth/invitations", _Auth_InviteUser0_HTTP_Handler(srv))
+	r.POST("/auth/invitations/accept", _Auth_AcceptInvitation0_HTTP_Handler(srv))
+	r.POST("/auth/users/{id}/employee", _Auth_LinkEmployee0_HTTP_Handler(srv))
+	r.POST("/auth/users/{id}/unlock", _Auth_UnlockUser0_HTTP_Handler(srv))
+	r.GET("/auth/audit-events", _Auth_ListAuthEvents0_HTTP_Handler(srv))
+	r.POST("/auth/users/{id}/2fa/reset", _Auth_ResetUserTOTP0_HTTP_Handler(srv))
+	r.POST("/auth/service-accounts", _Auth_CreateServiceAccount0_HTTP_Handler(srv))
+	r.GET("/auth/service-accounts", _Auth_ListServiceAccounts0_HTTP_Handler(srv))
+	r.DELETE("/auth/service-accounts/{id}", _Auth_DisableServiceAccount0_HTTP_Handler(srv))
+	r.POST("/auth/service-accounts/{id}/keys", _Auth_CreateAPIKey0_HTTP_Handler(srv))
+	r.DELETE("/auth/api-keys/{id}", _Auth_RevokeAPIKey0_HTTP_Handler(srv))
 }
 
 func _Auth_Register0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
@@ -77,17 +207,905 @@ func _Auth_Login0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error
 	}
 }
 
+func _Auth_OIDCLogin0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OIDCLoginRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthOIDCLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OIDCLogin(ctx, req.(*OIDCLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OIDCLoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_OIDCCallback0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OIDCCallbackRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthOIDCCallback)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OIDCCallback(ctx, req.(*OIDCCallbackRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_LoginTwoFactor0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginTwoFactorRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthLoginTwoFactor)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginTwoFactor(ctx, req.(*LoginTwoFactorRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_EnrollTOTPForLogin0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthEnrollTOTPForLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollTOTPForLogin(ctx, req.(*EnrollTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollTOTPReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_EnrollTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthEnrollTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollTOTPReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ConfirmTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TOTPCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthConfirmTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmTOTP(ctx, req.(*TOTPCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecoveryCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_DisableTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TOTPCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthDisableTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableTOTP(ctx, req.(*TOTPCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RegenerateRecoveryCodes0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TOTPCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRegenerateRecoveryCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RegenerateRecoveryCodes(ctx, req.(*TOTPCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecoveryCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ChangePassword0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_VerifyEmail0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthVerifyEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ResendVerification0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResendVerificationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthResendVerification)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResendVerification(ctx, req.(*ResendVerificationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ForgotPassword0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ForgotPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthForgotPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ForgotPassword(ctx, req.(*ForgotPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ResetPassword0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RefreshToken0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_Logout0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_LogoutAll0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthLogoutAll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LogoutAll(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ListSessions0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSessionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthListSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSessions(ctx, req.(*ListSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RevokeSession0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRevokeSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSession(ctx, req.(*RevokeSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_SetUserRole0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetUserRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthSetUserRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetUserRole(ctx, req.(*SetUserRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetUserRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_InviteUser0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InviteUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthInviteUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.InviteUser(ctx, req.(*InviteUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*InviteUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_AcceptInvitation0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptInvitationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthAcceptInvitation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AcceptInvitationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_LinkEmployee0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LinkEmployeeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthLinkEmployee)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LinkEmployee(ctx, req.(*LinkEmployeeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LinkEmployeeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_UnlockUser0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthUnlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockUser(ctx, req.(*UnlockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlockUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ListAuthEvents0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuthEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthListAuthEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAuthEvents(ctx, req.(*ListAuthEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuthEventsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ResetUserTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetUserTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthResetUserTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetUserTOTP(ctx, req.(*ResetUserTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetUserTOTPReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_CreateServiceAccount0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateServiceAccountRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthCreateServiceAccount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ServiceAccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ListServiceAccounts0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListServiceAccountsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthListServiceAccounts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListServiceAccountsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_DisableServiceAccount0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisableServiceAccountRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthDisableServiceAccount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableServiceAccount(ctx, req.(*DisableServiceAccountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ServiceAccountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_CreateAPIKey0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateAPIKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthCreateAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateAPIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RevokeAPIKey0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeAPIKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRevokeAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*APIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthHTTPClient interface {
+	AcceptInvitation(ctx context.Context, req *AcceptInvitationRequest, opts ...http.CallOption) (rsp *AcceptInvitationReply, err error)
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *AccountReply, err error)
+	ConfirmTOTP(ctx context.Context, req *TOTPCodeRequest, opts ...http.CallOption) (rsp *RecoveryCodesReply, err error)
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest, opts ...http.CallOption) (rsp *CreateAPIKeyReply, err error)
+	CreateServiceAccount(ctx context.Context, req *CreateServiceAccountRequest, opts ...http.CallOption) (rsp *ServiceAccountReply, err error)
+	DisableServiceAccount(ctx context.Context, req *DisableServiceAccountRequest, opts ...http.CallOption) (rsp *ServiceAccountReply, err error)
+	DisableTOTP(ctx context.Context, req *TOTPCodeRequest, opts ...http.CallOption) (rsp *AccountReply, err error)
+	EnrollTOTP(ctx context.Context, req *EnrollTOTPRequest, opts ...http.CallOption) (rsp *EnrollTOTPReply, err error)
+	EnrollTOTPForLogin(ctx context.Context, req *EnrollTOTPRequest, opts ...http.CallOption) (rsp *EnrollTOTPReply, err error)
+	ForgotPassword(ctx context.Context, req *ForgotPasswordRequest, opts ...http.CallOption) (rsp *AccountReply, err error)
+	InviteUser(ctx context.Context, req *InviteUserRequest, opts ...http.CallOption) (rsp *InviteUserReply, err error)
+	LinkEmployee(ctx context.Context, req *LinkEmployeeRequest, opts ...http.CallOption) (rsp *LinkEmployeeReply, err error)
+	ListAuthEvents(ctx context.Context, req *ListAuthEventsRequest, opts ...http.CallOption) (rsp *ListAuthEventsReply, err error)
+	ListServiceAccounts(ctx context.Context, req *ListServiceAccountsRequest, opts ...http.CallOption) (rsp *ListServiceAccountsReply, err error)
+	ListSessions(ctx context.Context, req *ListSessionsRequest, opts ...http.CallOption) (rsp *ListSessionsReply, err error)
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	LoginTwoFactor(ctx context.Context, req *LoginTwoFactorRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	LogoutAll(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	OIDCCallback(ctx context.Context, req *OIDCCallbackRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	OIDCLogin(ctx context.Context, req *OIDCLoginRequest, opts ...http.CallOption) (rsp *OIDCLoginReply, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	RegenerateRecoveryCodes(ctx context.Context, req *TOTPCodeRequest, opts ...http.CallOption) (rsp *RecoveryCodesReply, err error)
 	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	ResendVerification(ctx context.Context, req *ResendVerificationRequest, opts ...http.CallOption) (rsp *AccountReply, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *AccountReply, err error)
+	ResetUserTOTP(ctx context.Context, req *ResetUserTOTPRequest, opts ...http.CallOption) (rsp *ResetUserTOTPReply, err error)
+	RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest, opts ...http.CallOption) (rsp *APIKeyReply, err error)
+	RevokeSession(ctx context.Context, req *RevokeSessionRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	SetUserRole(ctx context.Context, req *SetUserRoleRequest, opts ...http.CallOption) (rsp *SetUserRoleReply, err error)
+	UnlockUser(ctx context.Context, req *UnlockUserRequest, opts ...http.CallOption) (rsp *UnlockUserReply, err error)
+	VerifyEmail(ctx context.Context, req *VerifyEmailRequest, opts ...http.CallOption) (rsp *AccountReply, err error)
+}
+
+type AuthHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewAuthHTTPClient(client *http.Client) AuthHTTPClient {
+	return &AuthHTTPClientImpl{client}
+}
+
+func (c *AuthHTTPClientImpl) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...http.CallOption) (*AcceptInvitationReply, error) {
+	var out AcceptInvitationReply
+	pattern := "/auth/invitations/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthAcceptInvitation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-type AuthHTTPClientImpl struct {
-	cc *http.Client
+func (c *AuthHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...http.CallOption) (*AccountReply, error) {
+	var out AccountReply
+	pattern := "/auth/password/change"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
-func NewAuthHTTPClient(client *http.Client) AuthHTTPClient {
-	return &AuthHTTPClientImpl{client}
+func (c *AuthHTTPClientImpl) ConfirmTOTP(ctx context.Context, in *TOTPCodeRequest, opts ...http.CallOption) (*RecoveryCodesReply, error) {
+	var out RecoveryCodesReply
+	pattern := "/auth/2fa/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthConfirmTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...http.CallOption) (*CreateAPIKeyReply, error) {
+	var out CreateAPIKeyReply
+	pattern := "/auth/service-accounts/{id}/keys"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthCreateAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...http.CallOption) (*ServiceAccountReply, error) {
+	var out ServiceAccountReply
+	pattern := "/auth/service-accounts"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthCreateServiceAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) DisableServiceAccount(ctx context.Context, in *DisableServiceAccountRequest, opts ...http.CallOption) (*ServiceAccountReply, error) {
+	var out ServiceAccountReply
+	pattern := "/auth/service-accounts/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthDisableServiceAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) DisableTOTP(ctx context.Context, in *TOTPCodeRequest, opts ...http.CallOption) (*AccountReply, error) {
+	var out AccountReply
+	pattern := "/auth/2fa/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthDisableTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...http.CallOption) (*EnrollTOTPReply, error) {
+	var out EnrollTOTPReply
+	pattern := "/auth/2fa/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthEnrollTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) EnrollTOTPForLogin(ctx context.Context, in *EnrollTOTPRequest, opts ...http.CallOption) (*EnrollTOTPReply, error) {
+	var out EnrollTOTPReply
+	pattern := "/auth/login/2fa/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthEnrollTOTPForLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...http.CallOption) (*AccountReply, error) {
+	var out AccountReply
+	pattern := "/auth/password/forgot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthForgotPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...http.CallOption) (*InviteUserReply, error) {
+	var out InviteUserReply
+	pattern := "/auth/invitations"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthInviteUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) LinkEmployee(ctx context.Context, in *LinkEmployeeRequest, opts ...http.CallOption) (*LinkEmployeeReply, error) {
+	var out LinkEmployeeReply
+	pattern := "/auth/users/{id}/employee"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthLinkEmployee))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ListAuthEvents(ctx context.Context, in *ListAuthEventsRequest, opts ...http.CallOption) (*ListAuthEventsReply, error) {
+	var out ListAuthEventsReply
+	pattern := "/auth/audit-events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthListAuthEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...http.CallOption) (*ListServiceAccountsReply, error) {
+	var out ListServiceAccountsReply
+	pattern := "/auth/service-accounts"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthListServiceAccounts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...http.CallOption) (*ListSessionsReply, error) {
+	var out ListSessionsReply
+	pattern := "/auth/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthListSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
 }
 
 func (c *AuthHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginReply, error) {
@@ -103,6 +1121,97 @@ func (c *AuthHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts .
 	return &out, nil
 }
 
+func (c *AuthHTTPClientImpl) LoginTwoFactor(ctx context.Context, in *LoginTwoFactorRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/auth/login/2fa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthLoginTwoFactor))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/auth/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) LogoutAll(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/auth/logout-all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthLogoutAll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/auth/oidc/callback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthOIDCCallback))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...http.CallOption) (*OIDCLoginReply, error) {
+	var out OIDCLoginReply
+	pattern := "/auth/oidc/login"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthOIDCLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/auth/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RegenerateRecoveryCodes(ctx context.Context, in *TOTPCodeRequest, opts ...http.CallOption) (*RecoveryCodesReply, error) {
+	var out RecoveryCodesReply
+	pattern := "/auth/2fa/recovery-codes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthRegenerateRecoveryCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AuthHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*RegisterReply, error) {
 	var out RegisterReply
 	pattern := "/auth/register"
@@ -115,3 +1224,107 @@ func (c *AuthHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest,
 	}
 	return &out, nil
 }
+
+func (c *AuthHTTPClientImpl) ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...http.CallOption) (*AccountReply, error) {
+	var out AccountReply
+	pattern := "/auth/verify-email/resend"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthResendVerification))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*AccountReply, error) {
+	var out AccountReply
+	pattern := "/auth/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ResetUserTOTP(ctx context.Context, in *ResetUserTOTPRequest, opts ...http.CallOption) (*ResetUserTOTPReply, error) {
+	var out ResetUserTOTPReply
+	pattern := "/auth/users/{id}/2fa/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthResetUserTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...http.CallOption) (*APIKeyReply, error) {
+	var out APIKeyReply
+	pattern := "/auth/api-keys/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthRevokeAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/auth/sessions/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthRevokeSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...http.CallOption) (*SetUserRoleReply, error) {
+	var out SetUserRoleReply
+	pattern := "/auth/users/{id}/role"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthSetUserRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...http.CallOption) (*UnlockUserReply, error) {
+	var out UnlockUserReply
+	pattern := "/auth/users/{id}/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthUnlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...http.CallOption) (*AccountReply, error) {
+	var out AccountReply
+	pattern := "/auth/verify-email"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthVerifyEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -24,16 +24,29 @@ const (
 )
 
 type EmployeeItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Position      string                 `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
-	BaseSalary    float64                `protobuf:"fixed64,4,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
-	BankAccount   string                 `protobuf:"bytes,5,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
-	JoinDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=join_date,json=joinDate,proto3" json:"join_date,omitempty"`
-	Dependents    int32                  `protobuf:"varint,7,opt,name=dependents,proto3" json:"dependents,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position    string                 `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	BaseSalary  float64                `protobuf:"fixed64,4,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
+	BankAccount string                 `protobuf:"bytes,5,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
+	JoinDate    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=join_date,json=joinDate,proto3" json:"join_date,omitempty"`
+	// Deprecated: Marked as deprecated in api/employee/v1/employee.proto.
+	Dependents     int32                        `protobuf:"varint,7,opt,name=dependents,proto3" json:"dependents,omitempty"` // superseded by dependent records
+	DepartmentId   uint32                       `protobuf:"varint,8,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	PositionId     uint32                       `protobuf:"varint,9,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	ManagerId      uint32                       `protobuf:"varint,10,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	Status         string                       `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`                                 // probation, official, on_leave or terminated
+	TerminatedAt   *timestamppb.Timestamp       `protobuf:"bytes,12,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"` // last working day, set once terminated
+	PiiMasked      bool                         `protobuf:"varint,13,opt,name=pii_masked,json=piiMasked,proto3" json:"pii_masked,omitempty"`         // bank_account shows the last 4 digits and base_salary is 0
+	WorkEmail      string                       `protobuf:"bytes,14,opt,name=work_email,json=workEmail,proto3" json:"work_email,omitempty"`
+	PersonalEmail  string                       `protobuf:"bytes,15,opt,name=personal_email,json=personalEmail,proto3" json:"personal_email,omitempty"`
+	Phone          string                       `protobuf:"bytes,16,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address        string                       `protobuf:"bytes,17,opt,name=address,proto3" json:"address,omitempty"`
+	PayslipChannel string                       `protobuf:"bytes,18,opt,name=payslip_channel,json=payslipChannel,proto3" json:"payslip_channel,omitempty"`                                                                     // work_email, personal_email or paper
+	CustomFields   map[string]*CustomFieldValue `protobuf:"bytes,19,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // by field key, active fields only
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *EmployeeItem) Reset() {
@@ -108,6 +121,7 @@ func (x *EmployeeItem) GetJoinDate() *timestamppb.Timestamp {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in api/employee/v1/employee.proto.
 func (x *EmployeeItem) GetDependents() int32 {
 	if x != nil {
 		return x.Dependents
@@ -115,17 +129,226 @@ func (x *EmployeeItem) GetDependents() int32 {
 	return 0
 }
 
+func (x *EmployeeItem) GetDepartmentId() uint32 {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return 0
+}
+
+func (x *EmployeeItem) GetPositionId() uint32 {
+	if x != nil {
+		return x.PositionId
+	}
+	return 0
+}
+
+func (x *EmployeeItem) GetManagerId() uint32 {
+	if x != nil {
+		return x.ManagerId
+	}
+	return 0
+}
+
+func (x *EmployeeItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EmployeeItem) GetTerminatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TerminatedAt
+	}
+	return nil
+}
+
+func (x *EmployeeItem) GetPiiMasked() bool {
+	if x != nil {
+		return x.PiiMasked
+	}
+	return false
+}
+
+func (x *EmployeeItem) GetWorkEmail() string {
+	if x != nil {
+		return x.WorkEmail
+	}
+	return ""
+}
+
+func (x *EmployeeItem) GetPersonalEmail() string {
+	if x != nil {
+		return x.PersonalEmail
+	}
+	return ""
+}
+
+func (x *EmployeeItem) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *EmployeeItem) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EmployeeItem) GetPayslipChannel() string {
+	if x != nil {
+		return x.PayslipChannel
+	}
+	return ""
+}
+
+func (x *EmployeeItem) GetCustomFields() map[string]*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+// CustomFieldValue is a custom field value typed by its definition. Enum
+// values are returned as string_value.
+type CustomFieldValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*CustomFieldValue_StringValue
+	//	*CustomFieldValue_NumberValue
+	//	*CustomFieldValue_BoolValue
+	//	*CustomFieldValue_DateValue
+	Kind          isCustomFieldValue_Kind `protobuf_oneof:"kind"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldValue) Reset() {
+	*x = CustomFieldValue{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldValue) ProtoMessage() {}
+
+func (x *CustomFieldValue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldValue.ProtoReflect.Descriptor instead.
+func (*CustomFieldValue) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CustomFieldValue) GetKind() isCustomFieldValue_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *CustomFieldValue) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*CustomFieldValue_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *CustomFieldValue) GetNumberValue() float64 {
+	if x != nil {
+		if x, ok := x.Kind.(*CustomFieldValue_NumberValue); ok {
+			return x.NumberValue
+		}
+	}
+	return 0
+}
+
+func (x *CustomFieldValue) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Kind.(*CustomFieldValue_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *CustomFieldValue) GetDateValue() string {
+	if x != nil {
+		if x, ok := x.Kind.(*CustomFieldValue_DateValue); ok {
+			return x.DateValue
+		}
+	}
+	return ""
+}
+
+type isCustomFieldValue_Kind interface {
+	isCustomFieldValue_Kind()
+}
+
+type CustomFieldValue_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type CustomFieldValue_NumberValue struct {
+	NumberValue float64 `protobuf:"fixed64,2,opt,name=number_value,json=numberValue,proto3,oneof"`
+}
+
+type CustomFieldValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type CustomFieldValue_DateValue struct {
+	DateValue string `protobuf:"bytes,4,opt,name=date_value,json=dateValue,proto3,oneof"` // YYYY-MM-DD
+}
+
+func (*CustomFieldValue_StringValue) isCustomFieldValue_Kind() {}
+
+func (*CustomFieldValue_NumberValue) isCustomFieldValue_Kind() {}
+
+func (*CustomFieldValue_BoolValue) isCustomFieldValue_Kind() {}
+
+func (*CustomFieldValue_DateValue) isCustomFieldValue_Kind() {}
+
 type ListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // case-insensitive substring
+	DepartmentId  uint32                 `protobuf:"varint,4,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	PositionId    uint32                 `protobuf:"varint,5,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	JoinedFrom    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_from,json=joinedFrom,proto3" json:"joined_from,omitempty"`
+	JoinedTo      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=joined_to,json=joinedTo,proto3" json:"joined_to,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	SortBy        string                 `protobuf:"bytes,9,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // id (default), name or join_date
+	Descending    bool                   `protobuf:"varint,10,opt,name=descending,proto3" json:"descending,omitempty"`
+	CustomFields  map[string]string      `protobuf:"bytes,11,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // field key -> exact value
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListRequest) Reset() {
 	*x = ListRequest{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[1]
+	mi := &file_api_employee_v1_employee_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -137,59 +360,2413 @@ func (x *ListRequest) String() string {
 func (*ListRequest) ProtoMessage() {}
 
 func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[1]
+	mi := &file_api_employee_v1_employee_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListRequest) GetDepartmentId() uint32 {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return 0
+}
+
+func (x *ListRequest) GetPositionId() uint32 {
+	if x != nil {
+		return x.PositionId
+	}
+	return 0
+}
+
+func (x *ListRequest) GetJoinedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedFrom
+	}
+	return nil
+}
+
+func (x *ListRequest) GetJoinedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedTo
+	}
+	return nil
+}
+
+func (x *ListRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListRequest) GetCustomFields() map[string]string {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type ListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*EmployeeItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // employees matching the filters across all pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReply) Reset() {
+	*x = ListReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReply) ProtoMessage() {}
+
+func (x *ListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReply.ProtoReflect.Descriptor instead.
+func (*ListReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListReply) GetItems() []*EmployeeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListReply) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *EmployeeItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReply) Reset() {
+	*x = GetReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReply) ProtoMessage() {}
+
+func (x *GetReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReply.ProtoReflect.Descriptor instead.
+func (*GetReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetReply) GetItem() *EmployeeItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CreateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Position    string                 `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	BaseSalary  float64                `protobuf:"fixed64,3,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
+	BankAccount string                 `protobuf:"bytes,4,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
+	JoinDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=join_date,json=joinDate,proto3" json:"join_date,omitempty"`
+	// Deprecated: Marked as deprecated in api/employee/v1/employee.proto.
+	Dependents     int32             `protobuf:"varint,6,opt,name=dependents,proto3" json:"dependents,omitempty"` // must be 0; register dependents instead
+	Status         string            `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`          // defaults to official
+	WorkEmail      string            `protobuf:"bytes,8,opt,name=work_email,json=workEmail,proto3" json:"work_email,omitempty"`
+	PersonalEmail  string            `protobuf:"bytes,9,opt,name=personal_email,json=personalEmail,proto3" json:"personal_email,omitempty"`
+	Phone          string            `protobuf:"bytes,10,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address        string            `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	PayslipChannel string            `protobuf:"bytes,12,opt,name=payslip_channel,json=payslipChannel,proto3" json:"payslip_channel,omitempty"`                                                                     // work_email (default), personal_email or paper
+	CustomFields   map[string]string `protobuf:"bytes,13,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // field key -> value; required fields must be set
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequest) ProtoMessage() {}
+
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetBaseSalary() float64 {
+	if x != nil {
+		return x.BaseSalary
+	}
+	return 0
+}
+
+func (x *CreateRequest) GetBankAccount() string {
+	if x != nil {
+		return x.BankAccount
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetJoinDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinDate
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in api/employee/v1/employee.proto.
+func (x *CreateRequest) GetDependents() int32 {
+	if x != nil {
+		return x.Dependents
+	}
+	return 0
+}
+
+func (x *CreateRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetWorkEmail() string {
+	if x != nil {
+		return x.WorkEmail
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetPersonalEmail() string {
+	if x != nil {
+		return x.PersonalEmail
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetPayslipChannel() string {
+	if x != nil {
+		return x.PayslipChannel
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetCustomFields() map[string]string {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type CreateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *EmployeeItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReply) Reset() {
+	*x = CreateReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReply) ProtoMessage() {}
+
+func (x *CreateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReply.ProtoReflect.Descriptor instead.
+func (*CreateReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateReply) GetItem() *EmployeeItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type UpdateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position    string                 `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	BaseSalary  float64                `protobuf:"fixed64,4,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
+	BankAccount string                 `protobuf:"bytes,5,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
+	JoinDate    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=join_date,json=joinDate,proto3" json:"join_date,omitempty"`
+	// Deprecated: Marked as deprecated in api/employee/v1/employee.proto.
+	Dependents     int32             `protobuf:"varint,7,opt,name=dependents,proto3" json:"dependents,omitempty"` // must be 0; register dependents instead
+	WorkEmail      string            `protobuf:"bytes,8,opt,name=work_email,json=workEmail,proto3" json:"work_email,omitempty"`
+	PersonalEmail  string            `protobuf:"bytes,9,opt,name=personal_email,json=personalEmail,proto3" json:"personal_email,omitempty"`
+	Phone          string            `protobuf:"bytes,10,opt,name=phone,proto3" json:"phone,omitempty"`
+	Address        string            `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	PayslipChannel string            `protobuf:"bytes,12,opt,name=payslip_channel,json=payslipChannel,proto3" json:"payslip_channel,omitempty"`
+	CustomFields   map[string]string `protobuf:"bytes,13,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // only the keys given are changed; "" clears a field
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRequest) ProtoMessage() {}
+
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetBaseSalary() float64 {
+	if x != nil {
+		return x.BaseSalary
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetBankAccount() string {
+	if x != nil {
+		return x.BankAccount
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetJoinDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinDate
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in api/employee/v1/employee.proto.
+func (x *UpdateRequest) GetDependents() int32 {
+	if x != nil {
+		return x.Dependents
+	}
+	return 0
+}
+
+func (x *UpdateRequest) GetWorkEmail() string {
+	if x != nil {
+		return x.WorkEmail
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetPersonalEmail() string {
+	if x != nil {
+		return x.PersonalEmail
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetPayslipChannel() string {
+	if x != nil {
+		return x.PayslipChannel
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetCustomFields() map[string]string {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type UpdateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *EmployeeItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReply) Reset() {
+	*x = UpdateReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReply) ProtoMessage() {}
+
+func (x *UpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReply.ProtoReflect.Descriptor instead.
+func (*UpdateReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateReply) GetItem() *EmployeeItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReply) Reset() {
+	*x = DeleteReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReply) ProtoMessage() {}
+
+func (x *DeleteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReply.ProtoReflect.Descriptor instead.
+func (*DeleteReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{11}
+}
+
+type ContractItem struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId             uint32                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Type                   string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // probation, fixed_term or indefinite
+	StartDate              *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"` // unset for indefinite contracts
+	ProbationSalaryPercent float64                `protobuf:"fixed64,6,opt,name=probation_salary_percent,json=probationSalaryPercent,proto3" json:"probation_salary_percent,omitempty"`
+	Note                   string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ContractItem) Reset() {
+	*x = ContractItem{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractItem) ProtoMessage() {}
+
+func (x *ContractItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractItem.ProtoReflect.Descriptor instead.
+func (*ContractItem) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ContractItem) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ContractItem) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *ContractItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContractItem) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ContractItem) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ContractItem) GetProbationSalaryPercent() float64 {
+	if x != nil {
+		return x.ProbationSalaryPercent
+	}
+	return 0
+}
+
+func (x *ContractItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CreateContractRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId             uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Type                   string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	StartDate              *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ProbationSalaryPercent float64                `protobuf:"fixed64,5,opt,name=probation_salary_percent,json=probationSalaryPercent,proto3" json:"probation_salary_percent,omitempty"` // probation only, 85-100, defaults to 100
+	Note                   string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateContractRequest) Reset() {
+	*x = CreateContractRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContractRequest) ProtoMessage() {}
+
+func (x *CreateContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContractRequest.ProtoReflect.Descriptor instead.
+func (*CreateContractRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateContractRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *CreateContractRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateContractRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateContractRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateContractRequest) GetProbationSalaryPercent() float64 {
+	if x != nil {
+		return x.ProbationSalaryPercent
+	}
+	return 0
+}
+
+func (x *CreateContractRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CreateContractReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *ContractItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContractReply) Reset() {
+	*x = CreateContractReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContractReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContractReply) ProtoMessage() {}
+
+func (x *CreateContractReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContractReply.ProtoReflect.Descriptor instead.
+func (*CreateContractReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateContractReply) GetItem() *ContractItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ListContractsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContractsRequest) Reset() {
+	*x = ListContractsRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContractsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContractsRequest) ProtoMessage() {}
+
+func (x *ListContractsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContractsRequest.ProtoReflect.Descriptor instead.
+func (*ListContractsRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListContractsRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+type ListContractsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ContractItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContractsReply) Reset() {
+	*x = ListContractsReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContractsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContractsReply) ProtoMessage() {}
+
+func (x *ListContractsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContractsReply.ProtoReflect.Descriptor instead.
+func (*ListContractsReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListContractsReply) GetItems() []*ContractItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ChangeStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // probation, official or on_leave; use Terminate to end employment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeStatusRequest) Reset() {
+	*x = ChangeStatusRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeStatusRequest) ProtoMessage() {}
+
+func (x *ChangeStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChangeStatusRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChangeStatusReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *EmployeeItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeStatusReply) Reset() {
+	*x = ChangeStatusReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeStatusReply) ProtoMessage() {}
+
+func (x *ChangeStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeStatusReply.ProtoReflect.Descriptor instead.
+func (*ChangeStatusReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChangeStatusReply) GetItem() *EmployeeItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type SettlementItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId      uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	LastWorkingDay  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_working_day,json=lastWorkingDay,proto3" json:"last_working_day,omitempty"`
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Note            string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	WorkingDays     int32                  `protobuf:"varint,5,opt,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"` // approved working days in the final month
+	ProratedSalary  float64                `protobuf:"fixed64,6,opt,name=prorated_salary,json=proratedSalary,proto3" json:"prorated_salary,omitempty"`
+	UnusedLeaveDays float64                `protobuf:"fixed64,7,opt,name=unused_leave_days,json=unusedLeaveDays,proto3" json:"unused_leave_days,omitempty"`
+	LeavePayout     float64                `protobuf:"fixed64,8,opt,name=leave_payout,json=leavePayout,proto3" json:"leave_payout,omitempty"`
+	ServiceYears    float64                `protobuf:"fixed64,9,opt,name=service_years,json=serviceYears,proto3" json:"service_years,omitempty"`
+	Severance       float64                `protobuf:"fixed64,10,opt,name=severance,proto3" json:"severance,omitempty"`
+	Total           float64                `protobuf:"fixed64,11,opt,name=total,proto3" json:"total,omitempty"`
+	PiiMasked       bool                   `protobuf:"varint,12,opt,name=pii_masked,json=piiMasked,proto3" json:"pii_masked,omitempty"` // salary, payout, severance and total are 0
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SettlementItem) Reset() {
+	*x = SettlementItem{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementItem) ProtoMessage() {}
+
+func (x *SettlementItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementItem.ProtoReflect.Descriptor instead.
+func (*SettlementItem) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SettlementItem) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *SettlementItem) GetLastWorkingDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastWorkingDay
+	}
+	return nil
+}
+
+func (x *SettlementItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetWorkingDays() int32 {
+	if x != nil {
+		return x.WorkingDays
+	}
+	return 0
+}
+
+func (x *SettlementItem) GetProratedSalary() float64 {
+	if x != nil {
+		return x.ProratedSalary
+	}
+	return 0
+}
+
+func (x *SettlementItem) GetUnusedLeaveDays() float64 {
+	if x != nil {
+		return x.UnusedLeaveDays
+	}
+	return 0
+}
+
+func (x *SettlementItem) GetLeavePayout() float64 {
+	if x != nil {
+		return x.LeavePayout
+	}
+	return 0
+}
+
+func (x *SettlementItem) GetServiceYears() float64 {
+	if x != nil {
+		return x.ServiceYears
+	}
+	return 0
+}
+
+func (x *SettlementItem) GetSeverance() float64 {
+	if x != nil {
+		return x.Severance
+	}
+	return 0
+}
+
+func (x *SettlementItem) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SettlementItem) GetPiiMasked() bool {
+	if x != nil {
+		return x.PiiMasked
+	}
+	return false
+}
+
+type TerminateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LastWorkingDay *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_working_day,json=lastWorkingDay,proto3" json:"last_working_day,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // resignation, contract_end, mutual_agreement, layoff or dismissal
+	Note           string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Preview        bool                   `protobuf:"varint,5,opt,name=preview,proto3" json:"preview,omitempty"` // compute the settlement without terminating
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TerminateRequest) Reset() {
+	*x = TerminateRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateRequest) ProtoMessage() {}
+
+func (x *TerminateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateRequest.ProtoReflect.Descriptor instead.
+func (*TerminateRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TerminateRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TerminateRequest) GetLastWorkingDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastWorkingDay
+	}
+	return nil
+}
+
+func (x *TerminateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TerminateRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TerminateRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+type TerminateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlement    *SettlementItem        `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateReply) Reset() {
+	*x = TerminateReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateReply) ProtoMessage() {}
+
+func (x *TerminateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateReply.ProtoReflect.Descriptor instead.
+func (*TerminateReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TerminateReply) GetSettlement() *SettlementItem {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+type DependentItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId    uint32                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Relationship  string                 `protobuf:"bytes,4,opt,name=relationship,proto3" json:"relationship,omitempty"` // child, spouse, parent, sibling or other
+	TaxId         string                 `protobuf:"bytes,5,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`  // last 4 digits unless the caller may see personal data
+	DateOfBirth   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	FromMonth     string                 `protobuf:"bytes,7,opt,name=from_month,json=fromMonth,proto3" json:"from_month,omitempty"` // YYYY-MM
+	ToMonth       string                 `protobuf:"bytes,8,opt,name=to_month,json=toMonth,proto3" json:"to_month,omitempty"`       // YYYY-MM, empty while still registered
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependentItem) Reset() {
+	*x = DependentItem{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependentItem) ProtoMessage() {}
+
+func (x *DependentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependentItem.ProtoReflect.Descriptor instead.
+func (*DependentItem) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DependentItem) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DependentItem) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *DependentItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DependentItem) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *DependentItem) GetTaxId() string {
+	if x != nil {
+		return x.TaxId
+	}
+	return ""
+}
+
+func (x *DependentItem) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *DependentItem) GetFromMonth() string {
+	if x != nil {
+		return x.FromMonth
+	}
+	return ""
+}
+
+func (x *DependentItem) GetToMonth() string {
+	if x != nil {
+		return x.ToMonth
+	}
+	return ""
+}
+
+type AddDependentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Relationship  string                 `protobuf:"bytes,3,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	TaxId         string                 `protobuf:"bytes,4,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+	DateOfBirth   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	FromMonth     string                 `protobuf:"bytes,6,opt,name=from_month,json=fromMonth,proto3" json:"from_month,omitempty"` // YYYY-MM
+	ToMonth       string                 `protobuf:"bytes,7,opt,name=to_month,json=toMonth,proto3" json:"to_month,omitempty"`       // optional, YYYY-MM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDependentRequest) Reset() {
+	*x = AddDependentRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDependentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDependentRequest) ProtoMessage() {}
+
+func (x *AddDependentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDependentRequest.ProtoReflect.Descriptor instead.
+func (*AddDependentRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddDependentRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *AddDependentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddDependentRequest) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *AddDependentRequest) GetTaxId() string {
+	if x != nil {
+		return x.TaxId
+	}
+	return ""
+}
+
+func (x *AddDependentRequest) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *AddDependentRequest) GetFromMonth() string {
+	if x != nil {
+		return x.FromMonth
+	}
+	return ""
+}
+
+func (x *AddDependentRequest) GetToMonth() string {
+	if x != nil {
+		return x.ToMonth
+	}
+	return ""
+}
+
+type AddDependentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *DependentItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDependentReply) Reset() {
+	*x = AddDependentReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDependentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDependentReply) ProtoMessage() {}
+
+func (x *AddDependentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDependentReply.ProtoReflect.Descriptor instead.
+func (*AddDependentReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddDependentReply) GetItem() *DependentItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ListDependentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDependentsRequest) Reset() {
+	*x = ListDependentsRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDependentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDependentsRequest) ProtoMessage() {}
+
+func (x *ListDependentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDependentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDependentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDependentsRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+type ListDependentsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DependentItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDependentsReply) Reset() {
+	*x = ListDependentsReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDependentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDependentsReply) ProtoMessage() {}
+
+func (x *ListDependentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDependentsReply.ProtoReflect.Descriptor instead.
+func (*ListDependentsReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDependentsReply) GetItems() []*DependentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type EndDependentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ToMonth       string                 `protobuf:"bytes,3,opt,name=to_month,json=toMonth,proto3" json:"to_month,omitempty"` // YYYY-MM, last month the deduction applies
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndDependentRequest) Reset() {
+	*x = EndDependentRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndDependentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDependentRequest) ProtoMessage() {}
+
+func (x *EndDependentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDependentRequest.ProtoReflect.Descriptor instead.
+func (*EndDependentRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EndDependentRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *EndDependentRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EndDependentRequest) GetToMonth() string {
+	if x != nil {
+		return x.ToMonth
+	}
+	return ""
+}
+
+type EndDependentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *DependentItem         `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndDependentReply) Reset() {
+	*x = EndDependentReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndDependentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDependentReply) ProtoMessage() {}
+
+func (x *EndDependentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDependentReply.ProtoReflect.Descriptor instead.
+func (*EndDependentReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EndDependentReply) GetItem() *DependentItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type RotateEncryptionKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeysRequest) Reset() {
+	*x = RotateEncryptionKeysRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeysRequest) ProtoMessage() {}
+
+func (x *RotateEncryptionKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeysRequest.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeysRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{29}
+}
+
+type RotateEncryptionKeysReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveKey     string                 `protobuf:"bytes,1,opt,name=active_key,json=activeKey,proto3" json:"active_key,omitempty"`
+	Employees     int32                  `protobuf:"varint,2,opt,name=employees,proto3" json:"employees,omitempty"`   // employee rows re-encrypted
+	Dependents    int32                  `protobuf:"varint,3,opt,name=dependents,proto3" json:"dependents,omitempty"` // dependent rows re-encrypted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeysReply) Reset() {
+	*x = RotateEncryptionKeysReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeysReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeysReply) ProtoMessage() {}
+
+func (x *RotateEncryptionKeysReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeysReply.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeysReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RotateEncryptionKeysReply) GetActiveKey() string {
+	if x != nil {
+		return x.ActiveKey
+	}
+	return ""
+}
+
+func (x *RotateEncryptionKeysReply) GetEmployees() int32 {
+	if x != nil {
+		return x.Employees
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeysReply) GetDependents() int32 {
+	if x != nil {
+		return x.Dependents
+	}
+	return 0
+}
+
+type ImportEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // csv or xlsx
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ColumnMap     map[string]string      `protobuf:"bytes,3,rep,name=column_map,json=columnMap,proto3" json:"column_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // file header -> column name used by the export
+	Mode          string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`                                                                                                      // all_or_nothing (default) or partial
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmployeesRequest) Reset() {
+	*x = ImportEmployeesRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmployeesRequest) ProtoMessage() {}
+
+func (x *ImportEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ImportEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportEmployeesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportEmployeesRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportEmployeesRequest) GetColumnMap() map[string]string {
+	if x != nil {
+		return x.ColumnMap
+	}
+	return nil
+}
+
+func (x *ImportEmployeesRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportEmployeesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type EmployeeImportRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeImportRow) Reset() {
+	*x = EmployeeImportRow{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeImportRow) ProtoMessage() {}
+
+func (x *EmployeeImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeImportRow.ProtoReflect.Descriptor instead.
+func (*EmployeeImportRow) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EmployeeImportRow) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *EmployeeImportRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmployeeImportRow) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportEmployeesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows     int32                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ValidRows     int32                  `protobuf:"varint,2,opt,name=valid_rows,json=validRows,proto3" json:"valid_rows,omitempty"`
+	ImportedRows  int32                  `protobuf:"varint,3,opt,name=imported_rows,json=importedRows,proto3" json:"imported_rows,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Mode          string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	Rows          []*EmployeeImportRow   `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmployeesReply) Reset() {
+	*x = ImportEmployeesReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmployeesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmployeesReply) ProtoMessage() {}
+
+func (x *ImportEmployeesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmployeesReply.ProtoReflect.Descriptor instead.
+func (*ImportEmployeesReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportEmployeesReply) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportEmployeesReply) GetValidRows() int32 {
+	if x != nil {
+		return x.ValidRows
+	}
+	return 0
+}
+
+func (x *ImportEmployeesReply) GetImportedRows() int32 {
+	if x != nil {
+		return x.ImportedRows
+	}
+	return 0
+}
+
+func (x *ImportEmployeesReply) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportEmployeesReply) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportEmployeesReply) GetRows() []*EmployeeImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// ExportEmployeesRequest takes the filters of ListRequest.
+type ExportEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // csv (default) or xlsx
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DepartmentId  uint32                 `protobuf:"varint,3,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	PositionId    uint32                 `protobuf:"varint,4,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	JoinedFrom    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=joined_from,json=joinedFrom,proto3" json:"joined_from,omitempty"`
+	JoinedTo      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_to,json=joinedTo,proto3" json:"joined_to,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	SortBy        string                 `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Descending    bool                   `protobuf:"varint,9,opt,name=descending,proto3" json:"descending,omitempty"`
+	CustomFields  map[string]string      `protobuf:"bytes,10,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmployeesRequest) Reset() {
+	*x = ExportEmployeesRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmployeesRequest) ProtoMessage() {}
+
+func (x *ExportEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ExportEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExportEmployeesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetDepartmentId() uint32 {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return 0
+}
+
+func (x *ExportEmployeesRequest) GetPositionId() uint32 {
+	if x != nil {
+		return x.PositionId
+	}
+	return 0
+}
+
+func (x *ExportEmployeesRequest) GetJoinedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedFrom
+	}
+	return nil
+}
+
+func (x *ExportEmployeesRequest) GetJoinedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedTo
+	}
+	return nil
+}
+
+func (x *ExportEmployeesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ExportEmployeesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ExportEmployeesRequest) GetCustomFields() map[string]string {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+type ExportEmployeesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmployeesReply) Reset() {
+	*x = ExportEmployeesReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmployeesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmployeesReply) ProtoMessage() {}
+
+func (x *ExportEmployeesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmployeesReply.ProtoReflect.Descriptor instead.
+func (*ExportEmployeesReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportEmployeesReply) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportEmployeesReply) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type CustomFieldItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // string, number, boolean, date or enum
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	EnumValues    []string               `protobuf:"bytes,6,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	Pattern       string                 `protobuf:"bytes,7,opt,name=pattern,proto3" json:"pattern,omitempty"` // regular expression string values must match
+	Min           *float64               `protobuf:"fixed64,8,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,9,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Active        bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldItem) Reset() {
+	*x = CustomFieldItem{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldItem) ProtoMessage() {}
+
+func (x *CustomFieldItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldItem.ProtoReflect.Descriptor instead.
+func (*CustomFieldItem) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CustomFieldItem) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CustomFieldItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CustomFieldItem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CustomFieldItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CustomFieldItem) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CustomFieldItem) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *CustomFieldItem) GetPattern() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pattern
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
-func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{1}
+func (x *CustomFieldItem) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
 }
 
-func (x *ListRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
+func (x *CustomFieldItem) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
 	}
 	return 0
 }
 
-func (x *ListRequest) GetPageToken() string {
+func (x *CustomFieldItem) GetActive() bool {
 	if x != nil {
-		return x.PageToken
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-type ListReply struct {
+type CreateCustomFieldRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*EmployeeItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // lowercase letters, digits and underscores
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	EnumValues    []string               `protobuf:"bytes,5,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"` // enum only
+	Pattern       string                 `protobuf:"bytes,6,opt,name=pattern,proto3" json:"pattern,omitempty"`                         // string only
+	Min           *float64               `protobuf:"fixed64,7,opt,name=min,proto3,oneof" json:"min,omitempty"`                         // number only
+	Max           *float64               `protobuf:"fixed64,8,opt,name=max,proto3,oneof" json:"max,omitempty"`                         // number only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReply) Reset() {
-	*x = ListReply{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[2]
+func (x *CreateCustomFieldRequest) Reset() {
+	*x = CreateCustomFieldRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReply) String() string {
+func (x *CreateCustomFieldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReply) ProtoMessage() {}
+func (*CreateCustomFieldRequest) ProtoMessage() {}
 
-func (x *ListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[2]
+func (x *CreateCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,91 +2777,89 @@ func (x *ListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReply.ProtoReflect.Descriptor instead.
-func (*ListReply) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreateCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListReply) GetItems() []*EmployeeItem {
+func (x *CreateCustomFieldRequest) GetKey() string {
 	if x != nil {
-		return x.Items
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *ListReply) GetNextPageToken() string {
+func (x *CreateCustomFieldRequest) GetLabel() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Label
 	}
 	return ""
 }
 
-type GetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateCustomFieldRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *GetRequest) Reset() {
-	*x = GetRequest{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateCustomFieldRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
 }
 
-func (x *GetRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateCustomFieldRequest) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
 }
 
-func (*GetRequest) ProtoMessage() {}
-
-func (x *GetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[3]
+func (x *CreateCustomFieldRequest) GetPattern() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pattern
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
-func (*GetRequest) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+func (x *CreateCustomFieldRequest) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
 }
 
-func (x *GetRequest) GetId() uint32 {
-	if x != nil {
-		return x.Id
+func (x *CreateCustomFieldRequest) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
 	}
 	return 0
 }
 
-type GetReply struct {
+type CreateCustomFieldReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *EmployeeItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Item          *CustomFieldItem       `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReply) Reset() {
-	*x = GetReply{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[4]
+func (x *CreateCustomFieldReply) Reset() {
+	*x = CreateCustomFieldReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReply) String() string {
+func (x *CreateCustomFieldReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReply) ProtoMessage() {}
+func (*CreateCustomFieldReply) ProtoMessage() {}
 
-func (x *GetReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[4]
+func (x *CreateCustomFieldReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,45 +2870,40 @@ func (x *GetReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReply.ProtoReflect.Descriptor instead.
-func (*GetReply) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateCustomFieldReply.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetReply) GetItem() *EmployeeItem {
+func (x *CreateCustomFieldReply) GetItem() *CustomFieldItem {
 	if x != nil {
 		return x.Item
 	}
 	return nil
 }
 
-type CreateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Position      string                 `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-	BaseSalary    float64                `protobuf:"fixed64,3,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
-	BankAccount   string                 `protobuf:"bytes,4,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
-	JoinDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=join_date,json=joinDate,proto3" json:"join_date,omitempty"`
-	Dependents    int32                  `protobuf:"varint,6,opt,name=dependents,proto3" json:"dependents,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListCustomFieldsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateRequest) Reset() {
-	*x = CreateRequest{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[5]
+func (x *ListCustomFieldsRequest) Reset() {
+	*x = ListCustomFieldsRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRequest) String() string {
+func (x *ListCustomFieldsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRequest) ProtoMessage() {}
+func (*ListCustomFieldsRequest) ProtoMessage() {}
 
-func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[5]
+func (x *ListCustomFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,75 +2914,40 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
-func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *CreateRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateRequest) GetPosition() string {
-	if x != nil {
-		return x.Position
-	}
-	return ""
-}
-
-func (x *CreateRequest) GetBaseSalary() float64 {
-	if x != nil {
-		return x.BaseSalary
-	}
-	return 0
-}
-
-func (x *CreateRequest) GetBankAccount() string {
-	if x != nil {
-		return x.BankAccount
-	}
-	return ""
-}
-
-func (x *CreateRequest) GetJoinDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.JoinDate
-	}
-	return nil
+// Deprecated: Use ListCustomFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CreateRequest) GetDependents() int32 {
+func (x *ListCustomFieldsRequest) GetIncludeInactive() bool {
 	if x != nil {
-		return x.Dependents
+		return x.IncludeInactive
 	}
-	return 0
+	return false
 }
 
-type CreateReply struct {
+type ListCustomFieldsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *EmployeeItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Items         []*CustomFieldItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateReply) Reset() {
-	*x = CreateReply{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[6]
+func (x *ListCustomFieldsReply) Reset() {
+	*x = ListCustomFieldsReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateReply) String() string {
+func (x *ListCustomFieldsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReply) ProtoMessage() {}
+func (*ListCustomFieldsReply) ProtoMessage() {}
 
-func (x *CreateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[6]
+func (x *ListCustomFieldsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,46 +2958,48 @@ func (x *CreateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReply.ProtoReflect.Descriptor instead.
-func (*CreateReply) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListCustomFieldsReply.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CreateReply) GetItem() *EmployeeItem {
+func (x *ListCustomFieldsReply) GetItems() []*CustomFieldItem {
 	if x != nil {
-		return x.Item
+		return x.Items
 	}
 	return nil
 }
 
-type UpdateRequest struct {
+// UpdateCustomFieldRequest replaces the definition; key and type cannot change.
+type UpdateCustomFieldRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Position      string                 `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
-	BaseSalary    float64                `protobuf:"fixed64,4,opt,name=base_salary,json=baseSalary,proto3" json:"base_salary,omitempty"`
-	BankAccount   string                 `protobuf:"bytes,5,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
-	JoinDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=join_date,json=joinDate,proto3" json:"join_date,omitempty"`
-	Dependents    int32                  `protobuf:"varint,7,opt,name=dependents,proto3" json:"dependents,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	EnumValues    []string               `protobuf:"bytes,4,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`
+	Pattern       string                 `protobuf:"bytes,5,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Min           *float64               `protobuf:"fixed64,6,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,7,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Active        bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"` // inactive fields are hidden and cannot be set
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRequest) Reset() {
-	*x = UpdateRequest{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[7]
+func (x *UpdateCustomFieldRequest) Reset() {
+	*x = UpdateCustomFieldRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRequest) String() string {
+func (x *UpdateCustomFieldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRequest) ProtoMessage() {}
+func (*UpdateCustomFieldRequest) ProtoMessage() {}
 
-func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[7]
+func (x *UpdateCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,82 +3010,89 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdateCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *UpdateRequest) GetId() uint32 {
+func (x *UpdateCustomFieldRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateRequest) GetName() string {
+func (x *UpdateCustomFieldRequest) GetLabel() string {
 	if x != nil {
-		return x.Name
+		return x.Label
 	}
 	return ""
 }
 
-func (x *UpdateRequest) GetPosition() string {
+func (x *UpdateCustomFieldRequest) GetRequired() bool {
 	if x != nil {
-		return x.Position
+		return x.Required
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateRequest) GetBaseSalary() float64 {
+func (x *UpdateCustomFieldRequest) GetEnumValues() []string {
 	if x != nil {
-		return x.BaseSalary
+		return x.EnumValues
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateRequest) GetBankAccount() string {
+func (x *UpdateCustomFieldRequest) GetPattern() string {
 	if x != nil {
-		return x.BankAccount
+		return x.Pattern
 	}
 	return ""
 }
 
-func (x *UpdateRequest) GetJoinDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.JoinDate
+func (x *UpdateCustomFieldRequest) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateRequest) GetDependents() int32 {
-	if x != nil {
-		return x.Dependents
+func (x *UpdateCustomFieldRequest) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
 	}
 	return 0
 }
 
-type UpdateReply struct {
+func (x *UpdateCustomFieldRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type UpdateCustomFieldReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Item          *EmployeeItem          `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Item          *CustomFieldItem       `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateReply) Reset() {
-	*x = UpdateReply{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[8]
+func (x *UpdateCustomFieldReply) Reset() {
+	*x = UpdateCustomFieldReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReply) String() string {
+func (x *UpdateCustomFieldReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReply) ProtoMessage() {}
+func (*UpdateCustomFieldReply) ProtoMessage() {}
 
-func (x *UpdateReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[8]
+func (x *UpdateCustomFieldReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,40 +3103,40 @@ func (x *UpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReply.ProtoReflect.Descriptor instead.
-func (*UpdateReply) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UpdateCustomFieldReply.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdateReply) GetItem() *EmployeeItem {
+func (x *UpdateCustomFieldReply) GetItem() *CustomFieldItem {
 	if x != nil {
 		return x.Item
 	}
 	return nil
 }
 
-type DeleteRequest struct {
+type GetTerminationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRequest) Reset() {
-	*x = DeleteRequest{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[9]
+func (x *GetTerminationRequest) Reset() {
+	*x = GetTerminationRequest{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRequest) String() string {
+func (x *GetTerminationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRequest) ProtoMessage() {}
+func (*GetTerminationRequest) ProtoMessage() {}
 
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[9]
+func (x *GetTerminationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,39 +3147,40 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetTerminationRequest.ProtoReflect.Descriptor instead.
+func (*GetTerminationRequest) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DeleteRequest) GetId() uint32 {
+func (x *GetTerminationRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteReply struct {
+type GetTerminationReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlement    *SettlementItem        `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteReply) Reset() {
-	*x = DeleteReply{}
-	mi := &file_api_employee_v1_employee_proto_msgTypes[10]
+func (x *GetTerminationReply) Reset() {
+	*x = GetTerminationReply{}
+	mi := &file_api_employee_v1_employee_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteReply) String() string {
+func (x *GetTerminationReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReply) ProtoMessage() {}
+func (*GetTerminationReply) ProtoMessage() {}
 
-func (x *DeleteReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_employee_v1_employee_proto_msgTypes[10]
+func (x *GetTerminationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_employee_v1_employee_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,16 +3191,23 @@ func (x *DeleteReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReply.ProtoReflect.Descriptor instead.
-func (*DeleteReply) Descriptor() ([]byte, []int) {
-	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetTerminationReply.ProtoReflect.Descriptor instead.
+func (*GetTerminationReply) Descriptor() ([]byte, []int) {
+	return file_api_employee_v1_employee_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTerminationReply) GetSettlement() *SettlementItem {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
 }
 
 var File_api_employee_v1_employee_proto protoreflect.FileDescriptor
 
 const file_api_employee_v1_employee_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/employee/v1/employee.proto\x12\vemployee.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xeb\x01\n" +
+	"\x1eapi/employee/v1/employee.proto\x12\vemployee.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9d\x06\n" +
 	"\fEmployeeItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -663,34 +3215,93 @@ const file_api_employee_v1_employee_proto_rawDesc = "" +
 	"\vbase_salary\x18\x04 \x01(\x01R\n" +
 	"baseSalary\x12!\n" +
 	"\fbank_account\x18\x05 \x01(\tR\vbankAccount\x127\n" +
-	"\tjoin_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinDate\x12\x1e\n" +
+	"\tjoin_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinDate\x12\"\n" +
+	"\n" +
+	"dependents\x18\a \x01(\x05B\x02\x18\x01R\n" +
+	"dependents\x12#\n" +
+	"\rdepartment_id\x18\b \x01(\rR\fdepartmentId\x12\x1f\n" +
+	"\vposition_id\x18\t \x01(\rR\n" +
+	"positionId\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\n" +
+	" \x01(\rR\tmanagerId\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12?\n" +
+	"\rterminated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\fterminatedAt\x12\x1d\n" +
 	"\n" +
-	"dependents\x18\a \x01(\x05R\n" +
-	"dependents\"I\n" +
+	"pii_masked\x18\r \x01(\bR\tpiiMasked\x12\x1d\n" +
+	"\n" +
+	"work_email\x18\x0e \x01(\tR\tworkEmail\x12%\n" +
+	"\x0epersonal_email\x18\x0f \x01(\tR\rpersonalEmail\x12\x14\n" +
+	"\x05phone\x18\x10 \x01(\tR\x05phone\x12\x18\n" +
+	"\aaddress\x18\x11 \x01(\tR\aaddress\x12'\n" +
+	"\x0fpayslip_channel\x18\x12 \x01(\tR\x0epayslipChannel\x12P\n" +
+	"\rcustom_fields\x18\x13 \x03(\v2+.employee.v1.EmployeeItem.CustomFieldsEntryR\fcustomFields\x1a^\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.employee.v1.CustomFieldValueR\x05value:\x028\x01\"\xa6\x01\n" +
+	"\x10CustomFieldValue\x12#\n" +
+	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12#\n" +
+	"\fnumber_value\x18\x02 \x01(\x01H\x00R\vnumberValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x03 \x01(\bH\x00R\tboolValue\x12\x1f\n" +
+	"\n" +
+	"date_value\x18\x04 \x01(\tH\x00R\tdateValueB\x06\n" +
+	"\x04kind\"\xfc\x03\n" +
 	"\vListRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"d\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rdepartment_id\x18\x04 \x01(\rR\fdepartmentId\x12\x1f\n" +
+	"\vposition_id\x18\x05 \x01(\rR\n" +
+	"positionId\x12;\n" +
+	"\vjoined_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"joinedFrom\x127\n" +
+	"\tjoined_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedTo\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x17\n" +
+	"\asort_by\x18\t \x01(\tR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\n" +
+	" \x01(\bR\n" +
+	"descending\x12O\n" +
+	"\rcustom_fields\x18\v \x03(\v2*.employee.v1.ListRequest.CustomFieldsEntryR\fcustomFields\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x85\x01\n" +
 	"\tListReply\x12/\n" +
 	"\x05items\x18\x01 \x03(\v2\x19.employee.v1.EmployeeItemR\x05items\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x1c\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"\x1c\n" +
 	"\n" +
 	"GetRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\"9\n" +
 	"\bGetReply\x12-\n" +
-	"\x04item\x18\x01 \x01(\v2\x19.employee.v1.EmployeeItemR\x04item\"\xdc\x01\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.employee.v1.EmployeeItemR\x04item\"\xab\x04\n" +
 	"\rCreateRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bposition\x18\x02 \x01(\tR\bposition\x12\x1f\n" +
 	"\vbase_salary\x18\x03 \x01(\x01R\n" +
 	"baseSalary\x12!\n" +
 	"\fbank_account\x18\x04 \x01(\tR\vbankAccount\x127\n" +
-	"\tjoin_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinDate\x12\x1e\n" +
+	"\tjoin_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinDate\x12\"\n" +
 	"\n" +
-	"dependents\x18\x06 \x01(\x05R\n" +
-	"dependents\"<\n" +
+	"dependents\x18\x06 \x01(\x05B\x02\x18\x01R\n" +
+	"dependents\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"work_email\x18\b \x01(\tR\tworkEmail\x12%\n" +
+	"\x0epersonal_email\x18\t \x01(\tR\rpersonalEmail\x12\x14\n" +
+	"\x05phone\x18\n" +
+	" \x01(\tR\x05phone\x12\x18\n" +
+	"\aaddress\x18\v \x01(\tR\aaddress\x12'\n" +
+	"\x0fpayslip_channel\x18\f \x01(\tR\x0epayslipChannel\x12Q\n" +
+	"\rcustom_fields\x18\r \x03(\v2,.employee.v1.CreateRequest.CustomFieldsEntryR\fcustomFields\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
 	"\vCreateReply\x12-\n" +
-	"\x04item\x18\x01 \x01(\v2\x19.employee.v1.EmployeeItemR\x04item\"\xec\x01\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.employee.v1.EmployeeItemR\x04item\"\xa3\x04\n" +
 	"\rUpdateRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -698,23 +3309,246 @@ const file_api_employee_v1_employee_proto_rawDesc = "" +
 	"\vbase_salary\x18\x04 \x01(\x01R\n" +
 	"baseSalary\x12!\n" +
 	"\fbank_account\x18\x05 \x01(\tR\vbankAccount\x127\n" +
-	"\tjoin_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinDate\x12\x1e\n" +
+	"\tjoin_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinDate\x12\"\n" +
+	"\n" +
+	"dependents\x18\a \x01(\x05B\x02\x18\x01R\n" +
+	"dependents\x12\x1d\n" +
 	"\n" +
-	"dependents\x18\a \x01(\x05R\n" +
-	"dependents\"<\n" +
+	"work_email\x18\b \x01(\tR\tworkEmail\x12%\n" +
+	"\x0epersonal_email\x18\t \x01(\tR\rpersonalEmail\x12\x14\n" +
+	"\x05phone\x18\n" +
+	" \x01(\tR\x05phone\x12\x18\n" +
+	"\aaddress\x18\v \x01(\tR\aaddress\x12'\n" +
+	"\x0fpayslip_channel\x18\f \x01(\tR\x0epayslipChannel\x12Q\n" +
+	"\rcustom_fields\x18\r \x03(\v2,.employee.v1.UpdateRequest.CustomFieldsEntryR\fcustomFields\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
 	"\vUpdateReply\x12-\n" +
 	"\x04item\x18\x01 \x01(\v2\x19.employee.v1.EmployeeItemR\x04item\"\x1f\n" +
 	"\rDeleteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\"\r\n" +
-	"\vDeleteReply2\xb4\x03\n" +
+	"\vDeleteReply\"\x93\x02\n" +
+	"\fContractItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\rR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x128\n" +
+	"\x18probation_salary_percent\x18\x06 \x01(\x01R\x16probationSalaryPercent\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\"\x8c\x02\n" +
+	"\x15CreateContractRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x128\n" +
+	"\x18probation_salary_percent\x18\x05 \x01(\x01R\x16probationSalaryPercent\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\"D\n" +
+	"\x13CreateContractReply\x12-\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.employee.v1.ContractItemR\x04item\"7\n" +
+	"\x14ListContractsRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\"E\n" +
+	"\x12ListContractsReply\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.employee.v1.ContractItemR\x05items\"=\n" +
+	"\x13ChangeStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"B\n" +
+	"\x11ChangeStatusReply\x12-\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.employee.v1.EmployeeItemR\x04item\"\xb6\x03\n" +
+	"\x0eSettlementItem\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12D\n" +
+	"\x10last_working_day\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastWorkingDay\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12!\n" +
+	"\fworking_days\x18\x05 \x01(\x05R\vworkingDays\x12'\n" +
+	"\x0fprorated_salary\x18\x06 \x01(\x01R\x0eproratedSalary\x12*\n" +
+	"\x11unused_leave_days\x18\a \x01(\x01R\x0funusedLeaveDays\x12!\n" +
+	"\fleave_payout\x18\b \x01(\x01R\vleavePayout\x12#\n" +
+	"\rservice_years\x18\t \x01(\x01R\fserviceYears\x12\x1c\n" +
+	"\tseverance\x18\n" +
+	" \x01(\x01R\tseverance\x12\x14\n" +
+	"\x05total\x18\v \x01(\x01R\x05total\x12\x1d\n" +
+	"\n" +
+	"pii_masked\x18\f \x01(\bR\tpiiMasked\"\xae\x01\n" +
+	"\x10TerminateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12D\n" +
+	"\x10last_working_day\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastWorkingDay\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x18\n" +
+	"\apreview\x18\x05 \x01(\bR\apreview\"M\n" +
+	"\x0eTerminateReply\x12;\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x1b.employee.v1.SettlementItemR\n" +
+	"settlement\"\x89\x02\n" +
+	"\rDependentItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\rR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\"\n" +
+	"\frelationship\x18\x04 \x01(\tR\frelationship\x12\x15\n" +
+	"\x06tax_id\x18\x05 \x01(\tR\x05taxId\x12>\n" +
+	"\rdate_of_birth\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vdateOfBirth\x12\x1d\n" +
+	"\n" +
+	"from_month\x18\a \x01(\tR\tfromMonth\x12\x19\n" +
+	"\bto_month\x18\b \x01(\tR\atoMonth\"\xff\x01\n" +
+	"\x13AddDependentRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\frelationship\x18\x03 \x01(\tR\frelationship\x12\x15\n" +
+	"\x06tax_id\x18\x04 \x01(\tR\x05taxId\x12>\n" +
+	"\rdate_of_birth\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vdateOfBirth\x12\x1d\n" +
+	"\n" +
+	"from_month\x18\x06 \x01(\tR\tfromMonth\x12\x19\n" +
+	"\bto_month\x18\a \x01(\tR\atoMonth\"C\n" +
+	"\x11AddDependentReply\x12.\n" +
+	"\x04item\x18\x01 \x01(\v2\x1a.employee.v1.DependentItemR\x04item\"8\n" +
+	"\x15ListDependentsRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\"G\n" +
+	"\x13ListDependentsReply\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.employee.v1.DependentItemR\x05items\"a\n" +
+	"\x13EndDependentRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\x12\x19\n" +
+	"\bto_month\x18\x03 \x01(\tR\atoMonth\"C\n" +
+	"\x11EndDependentReply\x12.\n" +
+	"\x04item\x18\x01 \x01(\v2\x1a.employee.v1.DependentItemR\x04item\"\x1d\n" +
+	"\x1bRotateEncryptionKeysRequest\"x\n" +
+	"\x19RotateEncryptionKeysReply\x12\x1d\n" +
+	"\n" +
+	"active_key\x18\x01 \x01(\tR\tactiveKey\x12\x1c\n" +
+	"\temployees\x18\x02 \x01(\x05R\temployees\x12\x1e\n" +
+	"\n" +
+	"dependents\x18\x03 \x01(\x05R\n" +
+	"dependents\"\x88\x02\n" +
+	"\x16ImportEmployeesRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12Q\n" +
+	"\n" +
+	"column_map\x18\x03 \x03(\v22.employee.v1.ImportEmployeesRequest.ColumnMapEntryR\tcolumnMap\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x1a<\n" +
+	"\x0eColumnMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Q\n" +
+	"\x11EmployeeImportRow\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\"\xda\x01\n" +
+	"\x14ImportEmployeesReply\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12\x1d\n" +
+	"\n" +
+	"valid_rows\x18\x02 \x01(\x05R\tvalidRows\x12#\n" +
+	"\rimported_rows\x18\x03 \x01(\x05R\fimportedRows\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x122\n" +
+	"\x04rows\x18\x06 \x03(\v2\x1e.employee.v1.EmployeeImportRowR\x04rows\"\xee\x03\n" +
+	"\x16ExportEmployeesRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rdepartment_id\x18\x03 \x01(\rR\fdepartmentId\x12\x1f\n" +
+	"\vposition_id\x18\x04 \x01(\rR\n" +
+	"positionId\x12;\n" +
+	"\vjoined_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"joinedFrom\x127\n" +
+	"\tjoined_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedTo\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\t \x01(\bR\n" +
+	"descending\x12Z\n" +
+	"\rcustom_fields\x18\n" +
+	" \x03(\v25.employee.v1.ExportEmployeesRequest.CustomFieldsEntryR\fcustomFields\x1a?\n" +
+	"\x11CustomFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"L\n" +
+	"\x14ExportEmployeesReply\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"\x8a\x02\n" +
+	"\x0fCustomFieldItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1f\n" +
+	"\venum_values\x18\x06 \x03(\tR\n" +
+	"enumValues\x12\x18\n" +
+	"\apattern\x18\a \x01(\tR\apattern\x12\x15\n" +
+	"\x03min\x18\b \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\t \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x16\n" +
+	"\x06active\x18\n" +
+	" \x01(\bR\x06activeB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xeb\x01\n" +
+	"\x18CreateCustomFieldRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x1f\n" +
+	"\venum_values\x18\x05 \x03(\tR\n" +
+	"enumValues\x12\x18\n" +
+	"\apattern\x18\x06 \x01(\tR\apattern\x12\x15\n" +
+	"\x03min\x18\a \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\b \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"J\n" +
+	"\x16CreateCustomFieldReply\x120\n" +
+	"\x04item\x18\x01 \x01(\v2\x1c.employee.v1.CustomFieldItemR\x04item\"D\n" +
+	"\x17ListCustomFieldsRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"K\n" +
+	"\x15ListCustomFieldsReply\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.employee.v1.CustomFieldItemR\x05items\"\xed\x01\n" +
+	"\x18UpdateCustomFieldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12\x1f\n" +
+	"\venum_values\x18\x04 \x03(\tR\n" +
+	"enumValues\x12\x18\n" +
+	"\apattern\x18\x05 \x01(\tR\apattern\x12\x15\n" +
+	"\x03min\x18\x06 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\a \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06activeB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"J\n" +
+	"\x16UpdateCustomFieldReply\x120\n" +
+	"\x04item\x18\x01 \x01(\v2\x1c.employee.v1.CustomFieldItemR\x04item\"'\n" +
+	"\x15GetTerminationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"R\n" +
+	"\x13GetTerminationReply\x12;\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x1b.employee.v1.SettlementItemR\n" +
+	"settlement2\xa6\x11\n" +
 	"\bEmployee\x12L\n" +
 	"\x04List\x12\x18.employee.v1.ListRequest\x1a\x16.employee.v1.ListReply\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
-	"/employees\x12N\n" +
+	"/employees\x12w\n" +
+	"\x0fExportEmployees\x12#.employee.v1.ExportEmployeesRequest\x1a!.employee.v1.ExportEmployeesReply\"\x1c\x82\xd3\xe4\x93\x02\x16b\x01*\x12\x11/employees/export\x12w\n" +
+	"\x0fImportEmployees\x12#.employee.v1.ImportEmployeesRequest\x1a!.employee.v1.ImportEmployeesReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/employees/import\x12N\n" +
 	"\x03Get\x12\x17.employee.v1.GetRequest\x1a\x15.employee.v1.GetReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/employees/{id}\x12U\n" +
 	"\x06Create\x12\x1a.employee.v1.CreateRequest\x1a\x18.employee.v1.CreateReply\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/employees\x12Z\n" +
 	"\x06Update\x12\x1a.employee.v1.UpdateRequest\x1a\x18.employee.v1.UpdateReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/employees/{id}\x12W\n" +
-	"\x06Delete\x12\x1a.employee.v1.DeleteRequest\x1a\x18.employee.v1.DeleteReply\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/employees/{id}B\x1aZ\x18myapp/api/employee/v1;v1b\x06proto3"
+	"\x06Delete\x12\x1a.employee.v1.DeleteRequest\x1a\x18.employee.v1.DeleteReply\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/employees/{id}\x12\x85\x01\n" +
+	"\x0eCreateContract\x12\".employee.v1.CreateContractRequest\x1a .employee.v1.CreateContractReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/employees/{employee_id}/contracts\x12\x7f\n" +
+	"\rListContracts\x12!.employee.v1.ListContractsRequest\x1a\x1f.employee.v1.ListContractsReply\"*\x82\xd3\xe4\x93\x02$\x12\"/employees/{employee_id}/contracts\x12s\n" +
+	"\fChangeStatus\x12 .employee.v1.ChangeStatusRequest\x1a\x1e.employee.v1.ChangeStatusReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/employees/{id}/status\x12m\n" +
+	"\tTerminate\x12\x1d.employee.v1.TerminateRequest\x1a\x1b.employee.v1.TerminateReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/employees/{id}/terminate\x12\x80\x01\n" +
+	"\fAddDependent\x12 .employee.v1.AddDependentRequest\x1a\x1e.employee.v1.AddDependentReply\".\x82\xd3\xe4\x93\x02(:\x01*\"#/employees/{employee_id}/dependents\x12\x83\x01\n" +
+	"\x0eListDependents\x12\".employee.v1.ListDependentsRequest\x1a .employee.v1.ListDependentsReply\"+\x82\xd3\xe4\x93\x02%\x12#/employees/{employee_id}/dependents\x12\x89\x01\n" +
+	"\fEndDependent\x12 .employee.v1.EndDependentRequest\x1a\x1e.employee.v1.EndDependentReply\"7\x82\xd3\xe4\x93\x021:\x01*\",/employees/{employee_id}/dependents/{id}/end\x12\x8b\x01\n" +
+	"\x14RotateEncryptionKeys\x12(.employee.v1.RotateEncryptionKeysRequest\x1a&.employee.v1.RotateEncryptionKeysReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/employees/rotate-keys\x12{\n" +
+	"\x0eGetTermination\x12\".employee.v1.GetTerminationRequest\x1a .employee.v1.GetTerminationReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/employees/{id}/termination\x12z\n" +
+	"\x11CreateCustomField\x12%.employee.v1.CreateCustomFieldRequest\x1a#.employee.v1.CreateCustomFieldReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/custom-fields\x12t\n" +
+	"\x10ListCustomFields\x12$.employee.v1.ListCustomFieldsRequest\x1a\".employee.v1.ListCustomFieldsReply\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/custom-fields\x12\x7f\n" +
+	"\x11UpdateCustomField\x12%.employee.v1.UpdateCustomFieldRequest\x1a#.employee.v1.UpdateCustomFieldReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/custom-fields/{id}B\x1aZ\x18myapp/api/employee/v1;v1b\x06proto3"
 
 var (
 	file_api_employee_v1_employee_proto_rawDescOnce sync.Once
@@ -728,44 +3562,144 @@ func file_api_employee_v1_employee_proto_rawDescGZIP() []byte {
 	return file_api_employee_v1_employee_proto_rawDescData
 }
 
-var file_api_employee_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_employee_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_api_employee_v1_employee_proto_goTypes = []any{
-	(*EmployeeItem)(nil),          // 0: employee.v1.EmployeeItem
-	(*ListRequest)(nil),           // 1: employee.v1.ListRequest
-	(*ListReply)(nil),             // 2: employee.v1.ListReply
-	(*GetRequest)(nil),            // 3: employee.v1.GetRequest
-	(*GetReply)(nil),              // 4: employee.v1.GetReply
-	(*CreateRequest)(nil),         // 5: employee.v1.CreateRequest
-	(*CreateReply)(nil),           // 6: employee.v1.CreateReply
-	(*UpdateRequest)(nil),         // 7: employee.v1.UpdateRequest
-	(*UpdateReply)(nil),           // 8: employee.v1.UpdateReply
-	(*DeleteRequest)(nil),         // 9: employee.v1.DeleteRequest
-	(*DeleteReply)(nil),           // 10: employee.v1.DeleteReply
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*EmployeeItem)(nil),                // 0: employee.v1.EmployeeItem
+	(*CustomFieldValue)(nil),            // 1: employee.v1.CustomFieldValue
+	(*ListRequest)(nil),                 // 2: employee.v1.ListRequest
+	(*ListReply)(nil),                   // 3: employee.v1.ListReply
+	(*GetRequest)(nil),                  // 4: employee.v1.GetRequest
+	(*GetReply)(nil),                    // 5: employee.v1.GetReply
+	(*CreateRequest)(nil),               // 6: employee.v1.CreateRequest
+	(*CreateReply)(nil),                 // 7: employee.v1.CreateReply
+	(*UpdateRequest)(nil),               // 8: employee.v1.UpdateRequest
+	(*UpdateReply)(nil),                 // 9: employee.v1.UpdateReply
+	(*DeleteRequest)(nil),               // 10: employee.v1.DeleteRequest
+	(*DeleteReply)(nil),                 // 11: employee.v1.DeleteReply
+	(*ContractItem)(nil),                // 12: employee.v1.ContractItem
+	(*CreateContractRequest)(nil),       // 13: employee.v1.CreateContractRequest
+	(*CreateContractReply)(nil),         // 14: employee.v1.CreateContractReply
+	(*ListContractsRequest)(nil),        // 15: employee.v1.ListContractsRequest
+	(*ListContractsReply)(nil),          // 16: employee.v1.ListContractsReply
+	(*ChangeStatusRequest)(nil),         // 17: employee.v1.ChangeStatusRequest
+	(*ChangeStatusReply)(nil),           // 18: employee.v1.ChangeStatusReply
+	(*SettlementItem)(nil),              // 19: employee.v1.SettlementItem
+	(*TerminateRequest)(nil),            // 20: employee.v1.TerminateRequest
+	(*TerminateReply)(nil),              // 21: employee.v1.TerminateReply
+	(*DependentItem)(nil),               // 22: employee.v1.DependentItem
+	(*AddDependentRequest)(nil),         // 23: employee.v1.AddDependentRequest
+	(*AddDependentReply)(nil),           // 24: employee.v1.AddDependentReply
+	(*ListDependentsRequest)(nil),       // 25: employee.v1.ListDependentsRequest
+	(*ListDependentsReply)(nil),         // 26: employee.v1.ListDependentsReply
+	(*EndDependentRequest)(nil),         // 27: employee.v1.EndDependentRequest
+	(*EndDependentReply)(nil),           // 28: employee.v1.EndDependentReply
+	(*RotateEncryptionKeysRequest)(nil), // 29: employee.v1.RotateEncryptionKeysRequest
+	(*RotateEncryptionKeysReply)(nil),   // 30: employee.v1.RotateEncryptionKeysReply
+	(*ImportEmployeesRequest)(nil),      // 31: employee.v1.ImportEmployeesRequest
+	(*EmployeeImportRow)(nil),           // 32: employee.v1.EmployeeImportRow
+	(*ImportEmployeesReply)(nil),        // 33: employee.v1.ImportEmployeesReply
+	(*ExportEmployeesRequest)(nil),      // 34: employee.v1.ExportEmployeesRequest
+	(*ExportEmployeesReply)(nil),        // 35: employee.v1.ExportEmployeesReply
+	(*CustomFieldItem)(nil),             // 36: employee.v1.CustomFieldItem
+	(*CreateCustomFieldRequest)(nil),    // 37: employee.v1.CreateCustomFieldRequest
+	(*CreateCustomFieldReply)(nil),      // 38: employee.v1.CreateCustomFieldReply
+	(*ListCustomFieldsRequest)(nil),     // 39: employee.v1.ListCustomFieldsRequest
+	(*ListCustomFieldsReply)(nil),       // 40: employee.v1.ListCustomFieldsReply
+	(*UpdateCustomFieldRequest)(nil),    // 41: employee.v1.UpdateCustomFieldRequest
+	(*UpdateCustomFieldReply)(nil),      // 42: employee.v1.UpdateCustomFieldReply
+	(*GetTerminationRequest)(nil),       // 43: employee.v1.GetTerminationRequest
+	(*GetTerminationReply)(nil),         // 44: employee.v1.GetTerminationReply
+	nil,                                 // 45: employee.v1.EmployeeItem.CustomFieldsEntry
+	nil,                                 // 46: employee.v1.ListRequest.CustomFieldsEntry
+	nil,                                 // 47: employee.v1.CreateRequest.CustomFieldsEntry
+	nil,                                 // 48: employee.v1.UpdateRequest.CustomFieldsEntry
+	nil,                                 // 49: employee.v1.ImportEmployeesRequest.ColumnMapEntry
+	nil,                                 // 50: employee.v1.ExportEmployeesRequest.CustomFieldsEntry
+	(*timestamppb.Timestamp)(nil),       // 51: google.protobuf.Timestamp
 }
 var file_api_employee_v1_employee_proto_depIdxs = []int32{
-	11, // 0: employee.v1.EmployeeItem.join_date:type_name -> google.protobuf.Timestamp
-	0,  // 1: employee.v1.ListReply.items:type_name -> employee.v1.EmployeeItem
-	0,  // 2: employee.v1.GetReply.item:type_name -> employee.v1.EmployeeItem
-	11, // 3: employee.v1.CreateRequest.join_date:type_name -> google.protobuf.Timestamp
-	0,  // 4: employee.v1.CreateReply.item:type_name -> employee.v1.EmployeeItem
-	11, // 5: employee.v1.UpdateRequest.join_date:type_name -> google.protobuf.Timestamp
-	0,  // 6: employee.v1.UpdateReply.item:type_name -> employee.v1.EmployeeItem
-	1,  // 7: employee.v1.Employee.List:input_type -> employee.v1.ListRequest
-	3,  // 8: employee.v1.Employee.Get:input_type -> employee.v1.GetRequest
-	5,  // 9: employee.v1.Employee.Create:input_type -> employee.v1.CreateRequest
-	7,  // 10: employee.v1.Employee.Update:input_type -> employee.v1.UpdateRequest
-	9,  // 11: employee.v1.Employee.Delete:input_type -> employee.v1.DeleteRequest
-	2,  // 12: employee.v1.Employee.List:output_type -> employee.v1.ListReply
-	4,  // 13: employee.v1.Employee.Get:output_type -> employee.v1.GetReply
-	6,  // 14: employee.v1.Employee.Create:output_type -> employee.v1.CreateReply
-	8,  // 15: employee.v1.Employee.Update:output_type -> employee.v1.UpdateReply
-	10, // 16: employee.v1.Employee.Delete:output_type -> employee.v1.DeleteReply
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	51, // 0: employee.v1.EmployeeItem.join_date:type_name -> google.protobuf.Timestamp
+	51, // 1: employee.v1.EmployeeItem.terminated_at:type_name -> google.protobuf.Timestamp
+	45, // 2: employee.v1.EmployeeItem.custom_fields:type_name -> employee.v1.EmployeeItem.CustomFieldsEntry
+	51, // 3: employee.v1.ListRequest.joined_from:type_name -> google.protobuf.Timestamp
+	51, // 4: employee.v1.ListRequest.joined_to:type_name -> google.protobuf.Timestamp
+	46, // 5: employee.v1.ListRequest.custom_fields:type_name -> employee.v1.ListRequest.CustomFieldsEntry
+	0,  // 6: employee.v1.ListReply.items:type_name -> employee.v1.EmployeeItem
+	0,  // 7: employee.v1.GetReply.item:type_name -> employee.v1.EmployeeItem
+	51, // 8: employee.v1.CreateRequest.join_date:type_name -> google.protobuf.Timestamp
+	47, // 9: employee.v1.CreateRequest.custom_fields:type_name -> employee.v1.CreateRequest.CustomFieldsEntry
+	0,  // 10: employee.v1.CreateReply.item:type_name -> employee.v1.EmployeeItem
+	51, // 11: employee.v1.UpdateRequest.join_date:type_name -> google.protobuf.Timestamp
+	48, // 12: employee.v1.UpdateRequest.custom_fields:type_name -> employee.v1.UpdateRequest.CustomFieldsEntry
+	0,  // 13: employee.v1.UpdateReply.item:type_name -> employee.v1.EmployeeItem
+	51, // 14: employee.v1.ContractItem.start_date:type_name -> google.protobuf.Timestamp
+	51, // 15: employee.v1.ContractItem.end_date:type_name -> google.protobuf.Timestamp
+	51, // 16: employee.v1.CreateContractRequest.start_date:type_name -> google.protobuf.Timestamp
+	51, // 17: employee.v1.CreateContractRequest.end_date:type_name -> google.protobuf.Timestamp
+	12, // 18: employee.v1.CreateContractReply.item:type_name -> employee.v1.ContractItem
+	12, // 19: employee.v1.ListContractsReply.items:type_name -> employee.v1.ContractItem
+	0,  // 20: employee.v1.ChangeStatusReply.item:type_name -> employee.v1.EmployeeItem
+	51, // 21: employee.v1.SettlementItem.last_working_day:type_name -> google.protobuf.Timestamp
+	51, // 22: employee.v1.TerminateRequest.last_working_day:type_name -> google.protobuf.Timestamp
+	19, // 23: employee.v1.TerminateReply.settlement:type_name -> employee.v1.SettlementItem
+	51, // 24: employee.v1.DependentItem.date_of_birth:type_name -> google.protobuf.Timestamp
+	51, // 25: employee.v1.AddDependentRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	22, // 26: employee.v1.AddDependentReply.item:type_name -> employee.v1.DependentItem
+	22, // 27: employee.v1.ListDependentsReply.items:type_name -> employee.v1.DependentItem
+	22, // 28: employee.v1.EndDependentReply.item:type_name -> employee.v1.DependentItem
+	49, // 29: employee.v1.ImportEmployeesRequest.column_map:type_name -> employee.v1.ImportEmployeesRequest.ColumnMapEntry
+	32, // 30: employee.v1.ImportEmployeesReply.rows:type_name -> employee.v1.EmployeeImportRow
+	51, // 31: employee.v1.ExportEmployeesRequest.joined_from:type_name -> google.protobuf.Timestamp
+	51, // 32: employee.v1.ExportEmployeesRequest.joined_to:type_name -> google.protobuf.Timestamp
+	50, // 33: employee.v1.ExportEmployeesRequest.custom_fields:type_name -> employee.v1.ExportEmployeesRequest.CustomFieldsEntry
+	36, // 34: employee.v1.CreateCustomFieldReply.item:type_name -> employee.v1.CustomFieldItem
+	36, // 35: employee.v1.ListCustomFieldsReply.items:type_name -> employee.v1.CustomFieldItem
+	36, // 36: employee.v1.UpdateCustomFieldReply.item:type_name -> employee.v1.CustomFieldItem
+	19, // 37: employee.v1.GetTerminationReply.settlement:type_name -> employee.v1.SettlementItem
+	1,  // 38: employee.v1.EmployeeItem.CustomFieldsEntry.value:type_name -> employee.v1.CustomFieldValue
+	2,  // 39: employee.v1.Employee.List:input_type -> employee.v1.ListRequest
+	34, // 40: employee.v1.Employee.ExportEmployees:input_type -> employee.v1.ExportEmployeesRequest
+	31, // 41: employee.v1.Employee.ImportEmployees:input_type -> employee.v1.ImportEmployeesRequest
+	4,  // 42: employee.v1.Employee.Get:input_type -> employee.v1.GetRequest
+	6,  // 43: employee.v1.Employee.Create:input_type -> employee.v1.CreateRequest
+	8,  // 44: employee.v1.Employee.Update:input_type -> employee.v1.UpdateRequest
+	10, // 45: employee.v1.Employee.Delete:input_type -> employee.v1.DeleteRequest
+	13, // 46: employee.v1.Employee.CreateContract:input_type -> employee.v1.CreateContractRequest
+	15, // 47: employee.v1.Employee.ListContracts:input_type -> employee.v1.ListContractsRequest
+	17, // 48: employee.v1.Employee.ChangeStatus:input_type -> employee.v1.ChangeStatusRequest
+	20, // 49: employee.v1.Employee.Terminate:input_type -> employee.v1.TerminateRequest
+	23, // 50: employee.v1.Employee.AddDependent:input_type -> employee.v1.AddDependentRequest
+	25, // 51: employee.v1.Employee.ListDependents:input_type -> employee.v1.ListDependentsRequest
+	27, // 52: employee.v1.Employee.EndDependent:input_type -> employee.v1.EndDependentRequest
+	29, // 53: employee.v1.Employee.RotateEncryptionKeys:input_type -> employee.v1.RotateEncryptionKeysRequest
+	43, // 54: employee.v1.Employee.GetTermination:input_type -> employee.v1.GetTerminationRequest
+	37, // 55: employee.v1.Employee.CreateCustomField:input_type -> employee.v1.CreateCustomFieldRequest
+	39, // 56: employee.v1.Employee.ListCustomFields:input_type -> employee.v1.ListCustomFieldsRequest
+	41, // 57: employee.v1.Employee.UpdateCustomField:input_type -> employee.v1.UpdateCustomFieldRequest
+	3,  // 58: employee.v1.Employee.List:output_type -> employee.v1.ListReply
+	35, // 59: employee.v1.Employee.ExportEmployees:output_type -> employee.v1.ExportEmployeesReply
+	33, // 60: employee.v1.Employee.ImportEmployees:output_type -> employee.v1.ImportEmployeesReply
+	5,  // 61: employee.v1.Employee.Get:output_type -> employee.v1.GetReply
+	7,  // 62: employee.v1.Employee.Create:output_type -> employee.v1.CreateReply
+	9,  // 63: employee.v1.Employee.Update:output_type -> employee.v1.UpdateReply
+	11, // 64: employee.v1.Employee.Delete:output_type -> employee.v1.DeleteReply
+	14, // 65: employee.v1.Employee.CreateContract:output_type -> employee.v1.CreateContractReply
+	16, // 66: employee.v1.Employee.ListContracts:output_type -> employee.v1.ListContractsReply
+	18, // 67: employee.v1.Employee.ChangeStatus:output_type -> employee.v1.ChangeStatusReply
+	21, // 68: employee.v1.Employee.Terminate:output_type -> employee.v1.TerminateReply
+	24, // 69: employee.v1.Employee.AddDependent:output_type -> employee.v1.AddDependentReply
+	26, // 70: employee.v1.Employee.ListDependents:output_type -> employee.v1.ListDependentsReply
+	28, // 71: employee.v1.Employee.EndDependent:output_type -> employee.v1.EndDependentReply
+	30, // 72: employee.v1.Employee.RotateEncryptionKeys:output_type -> employee.v1.RotateEncryptionKeysReply
+	44, // 73: employee.v1.Employee.GetTermination:output_type -> employee.v1.GetTerminationReply
+	38, // 74: employee.v1.Employee.CreateCustomField:output_type -> employee.v1.CreateCustomFieldReply
+	40, // 75: employee.v1.Employee.ListCustomFields:output_type -> employee.v1.ListCustomFieldsReply
+	42, // 76: employee.v1.Employee.UpdateCustomField:output_type -> employee.v1.UpdateCustomFieldReply
+	58, // [58:77] is the sub-list for method output_type
+	39, // [39:58] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_api_employee_v1_employee_proto_init() }
@@ -773,13 +3707,22 @@ func file_api_employee_v1_employee_proto_init() {
 	if File_api_employee_v1_employee_proto != nil {
 		return
 	}
+	file_api_employee_v1_employee_proto_msgTypes[1].OneofWrappers = []any{
+		(*CustomFieldValue_StringValue)(nil),
+		(*CustomFieldValue_NumberValue)(nil),
+		(*CustomFieldValue_BoolValue)(nil),
+		(*CustomFieldValue_DateValue)(nil),
+	}
+	file_api_employee_v1_employee_proto_msgTypes[36].OneofWrappers = []any{}
+	file_api_employee_v1_employee_proto_msgTypes[37].OneofWrappers = []any{}
+	file_api_employee_v1_employee_proto_msgTypes[41].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_employee_v1_employee_proto_rawDesc), len(file_api_employee_v1_employee_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Employee_List_FullMethodName   = "/employee.v1.Employee/List"
-	Employee_Get_FullMethodName    = "/employee.v1.Employee/Get"
-	Employee_Create_FullMethodName = "/employee.v1.Employee/Create"
-	Employee_Update_FullMethodName = "/employee.v1.Employee/Update"
-	Employee_Delete_FullMethodName = "/employee.v1.Employee/Delete"
+	Employee_List_FullMethodName                 = "/employee.v1.Employee/List"
+	Employee_ExportEmployees_FullMethodName      = "/employee.v1.Employee/ExportEmployees"
+	Employee_ImportEmployees_FullMethodName      = "/employee.v1.Employee/ImportEmployees"
+	Employee_Get_FullMethodName                  = "/employee.v1.Employee/Get"
+	Employee_Create_FullMethodName               = "/employee.v1.Employee/Create"
+	Employee_Update_FullMethodName               = "/employee.v1.Employee/Update"
+	Employee_Delete_FullMethodName               = "/employee.v1.Employee/Delete"
+	Employee_CreateContract_FullMethodName       = "/employee.v1.Employee/CreateContract"
+	Employee_ListContracts_FullMethodName        = "/employee.v1.Employee/ListContracts"
+	Employee_ChangeStatus_FullMethodName         = "/employee.v1.Employee/ChangeStatus"
+	Employee_Terminate_FullMethodName            = "/employee.v1.Employee/Terminate"
+	Employee_AddDependent_FullMethodName         = "/employee.v1.Employee/AddDependent"
+	Employee_ListDependents_FullMethodName       = "/employee.v1.Employee/ListDependents"
+	Employee_EndDependent_FullMethodName         = "/employee.v1.Employee/EndDependent"
+	Employee_RotateEncryptionKeys_FullMethodName = "/employee.v1.Employee/RotateEncryptionKeys"
+	Employee_GetTermination_FullMethodName       = "/employee.v1.Employee/GetTermination"
+	Employee_CreateCustomField_FullMethodName    = "/employee.v1.Employee/CreateCustomField"
+	Employee_ListCustomFields_FullMethodName     = "/employee.v1.Employee/ListCustomFields"
+	Employee_UpdateCustomField_FullMethodName    = "/employee.v1.Employee/UpdateCustomField"
 )
 
 // EmployeeClient is the client API for Employee service.
@@ -31,10 +45,28 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EmployeeClient interface {
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListReply, error)
+	// ExportEmployees is declared before Get so /employees/export is not
+	// routed as an employee ID.
+	ExportEmployees(ctx context.Context, in *ExportEmployeesRequest, opts ...grpc.CallOption) (*ExportEmployeesReply, error)
+	ImportEmployees(ctx context.Context, in *ImportEmployeesRequest, opts ...grpc.CallOption) (*ImportEmployeesReply, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetReply, error)
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateReply, error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateReply, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteReply, error)
+	CreateContract(ctx context.Context, in *CreateContractRequest, opts ...grpc.CallOption) (*CreateContractReply, error)
+	ListContracts(ctx context.Context, in *ListContractsRequest, opts ...grpc.CallOption) (*ListContractsReply, error)
+	ChangeStatus(ctx context.Context, in *ChangeStatusRequest, opts ...grpc.CallOption) (*ChangeStatusReply, error)
+	Terminate(ctx context.Context, in *TerminateRequest, opts ...grpc.CallOption) (*TerminateReply, error)
+	AddDependent(ctx context.Context, in *AddDependentRequest, opts ...grpc.CallOption) (*AddDependentReply, error)
+	ListDependents(ctx context.Context, in *ListDependentsRequest, opts ...grpc.CallOption) (*ListDependentsReply, error)
+	EndDependent(ctx context.Context, in *EndDependentRequest, opts ...grpc.CallOption) (*EndDependentReply, error)
+	// RotateEncryptionKeys re-encrypts personal data with the active key so
+	// retired keys can be removed from the configuration.
+	RotateEncryptionKeys(ctx context.Context, in *RotateEncryptionKeysRequest, opts ...grpc.CallOption) (*RotateEncryptionKeysReply, error)
+	GetTermination(ctx context.Context, in *GetTerminationRequest, opts ...grpc.CallOption) (*GetTerminationReply, error)
+	CreateCustomField(ctx context.Context, in *CreateCustomFieldRequest, opts ...grpc.CallOption) (*CreateCustomFieldReply, error)
+	ListCustomFields(ctx context.Context, in *ListCustomFieldsRequest, opts ...grpc.CallOption) (*ListCustomFieldsReply, error)
+	UpdateCustomField(ctx context.Context, in *UpdateCustomFieldRequest, opts ...grpc.CallOption) (*UpdateCustomFieldReply, error)
 }
 
 type employeeClient struct {
@@ -55,6 +87,26 @@ func (c *employeeClient) List(ctx context.Context, in *ListRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *employeeClient) ExportEmployees(ctx context.Context, in *ExportEmployeesRequest, opts ...grpc.CallOption) (*ExportEmployeesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportEmployeesReply)
+	err := c.cc.Invoke(ctx, Employee_ExportEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) ImportEmployees(ctx context.Context, in *ImportEmployeesRequest, opts ...grpc.CallOption) (*ImportEmployeesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportEmployeesReply)
+	err := c.cc.Invoke(ctx, Employee_ImportEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *employeeClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetReply)
@@ -95,15 +147,153 @@ func (c *employeeClient) Delete(ctx context.Context, in *DeleteRequest, opts ...
 	return out, nil
 }
 
+func (c *employeeClient) CreateContract(ctx context.Context, in *CreateContractRequest, opts ...grpc.CallOption) (*CreateContractReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateContractReply)
+	err := c.cc.Invoke(ctx, Employee_CreateContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) ListContracts(ctx context.Context, in *ListContractsRequest, opts ...grpc.CallOption) (*ListContractsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContractsReply)
+	err := c.cc.Invoke(ctx, Employee_ListContracts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) ChangeStatus(ctx context.Context, in *ChangeStatusRequest, opts ...grpc.CallOption) (*ChangeStatusReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeStatusReply)
+	err := c.cc.Invoke(ctx, Employee_ChangeStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) Terminate(ctx context.Context, in *TerminateRequest, opts ...grpc.CallOption) (*TerminateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TerminateReply)
+	err := c.cc.Invoke(ctx, Employee_Terminate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) AddDependent(ctx context.Context, in *AddDependentRequest, opts ...grpc.CallOption) (*AddDependentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddDependentReply)
+	err := c.cc.Invoke(ctx, Employee_AddDependent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) ListDependents(ctx context.Context, in *ListDependentsRequest, opts ...grpc.CallOption) (*ListDependentsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDependentsReply)
+	err := c.cc.Invoke(ctx, Employee_ListDependents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) EndDependent(ctx context.Context, in *EndDependentRequest, opts ...grpc.CallOption) (*EndDependentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndDependentReply)
+	err := c.cc.Invoke(ctx, Employee_EndDependent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) RotateEncryptionKeys(ctx context.Context, in *RotateEncryptionKeysRequest, opts ...grpc.CallOption) (*RotateEncryptionKeysReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateEncryptionKeysReply)
+	err := c.cc.Invoke(ctx, Employee_RotateEncryptionKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) GetTermination(ctx context.Context, in *GetTerminationRequest, opts ...grpc.CallOption) (*GetTerminationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTerminationReply)
+	err := c.cc.Invoke(ctx, Employee_GetTermination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) CreateCustomField(ctx context.Context, in *CreateCustomFieldRequest, opts ...grpc.CallOption) (*CreateCustomFieldReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCustomFieldReply)
+	err := c.cc.Invoke(ctx, Employee_CreateCustomField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) ListCustomFields(ctx context.Context, in *ListCustomFieldsRequest, opts ...grpc.CallOption) (*ListCustomFieldsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCustomFieldsReply)
+	err := c.cc.Invoke(ctx, Employee_ListCustomFields_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeClient) UpdateCustomField(ctx context.Context, in *UpdateCustomFieldRequest, opts ...grpc.CallOption) (*UpdateCustomFieldReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCustomFieldReply)
+	err := c.cc.Invoke(ctx, Employee_UpdateCustomField_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EmployeeServer is the server API for Employee service.
 // All implementations must embed UnimplementedEmployeeServer
 // for forward compatibility.
 type EmployeeServer interface {
 	List(context.Context, *ListRequest) (*ListReply, error)
+	// ExportEmployees is declared before Get so /employees/export is not
+	// routed as an employee ID.
+	ExportEmployees(context.Context, *ExportEmployeesRequest) (*ExportEmployeesReply, error)
+	ImportEmployees(context.Context, *ImportEmployeesRequest) (*ImportEmployeesReply, error)
 	Get(context.Context, *GetRequest) (*GetReply, error)
 	Create(context.Context, *CreateRequest) (*CreateReply, error)
 	Update(context.Context, *UpdateRequest) (*UpdateReply, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteReply, error)
+	CreateContract(context.Context, *CreateContractRequest) (*CreateContractReply, error)
+	ListContracts(context.Context, *ListContractsRequest) (*ListContractsReply, error)
+	ChangeStatus(context.Context, *ChangeStatusRequest) (*ChangeStatusReply, error)
+	Terminate(context.Context, *TerminateRequest) (*TerminateReply, error)
+	AddDependent(context.Context, *AddDependentRequest) (*AddDependentReply, error)
+	ListDependents(context.Context, *ListDependentsRequest) (*ListDependentsReply, error)
+	EndDependent(context.Context, *EndDependentRequest) (*EndDependentReply, error)
+	// RotateEncryptionKeys re-encrypts personal data with the active key so
+	// retired keys can be removed from the configuration.
+	RotateEncryptionKeys(context.Context, *RotateEncryptionKeysRequest) (*RotateEncryptionKeysReply, error)
+	GetTermination(context.Context, *GetTerminationRequest) (*GetTerminationReply, error)
+	CreateCustomField(context.Context, *CreateCustomFieldRequest) (*CreateCustomFieldReply, error)
+	ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsReply, error)
+	UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldReply, error)
 	mustEmbedUnimplementedEmployeeServer()
 }
 
@@ -117,6 +307,12 @@ type UnimplementedEmployeeServer struct{}
 func (UnimplementedEmployeeServer) List(context.Context, *ListRequest) (*ListReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedEmployeeServer) ExportEmployees(context.Context, *ExportEmployeesRequest) (*ExportEmployeesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportEmployees not implemented")
+}
+func (UnimplementedEmployeeServer) ImportEmployees(context.Context, *ImportEmployeesRequest) (*ImportEmployeesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportEmployees not implemented")
+}
 func (UnimplementedEmployeeServer) Get(context.Context, *GetRequest) (*GetReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method Get not implemented")
 }
@@ -129,6 +325,42 @@ func (UnimplementedEmployeeServer) Update(context.Context, *UpdateRequest) (*Upd
 func (UnimplementedEmployeeServer) Delete(context.Context, *DeleteRequest) (*DeleteReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedEmployeeServer) CreateContract(context.Context, *CreateContractRequest) (*CreateContractReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateContract not implemented")
+}
+func (UnimplementedEmployeeServer) ListContracts(context.Context, *ListContractsRequest) (*ListContractsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListContracts not implemented")
+}
+func (UnimplementedEmployeeServer) ChangeStatus(context.Context, *ChangeStatusRequest) (*ChangeStatusReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeStatus not implemented")
+}
+func (UnimplementedEmployeeServer) Terminate(context.Context, *TerminateRequest) (*TerminateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method Terminate not implemented")
+}
+func (UnimplementedEmployeeServer) AddDependent(context.Context, *AddDependentRequest) (*AddDependentReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddDependent not implemented")
+}
+func (UnimplementedEmployeeServer) ListDependents(context.Context, *ListDependentsRequest) (*ListDependentsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDependents not implemented")
+}
+func (UnimplementedEmployeeServer) EndDependent(context.Context, *EndDependentRequest) (*EndDependentReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndDependent not implemented")
+}
+func (UnimplementedEmployeeServer) RotateEncryptionKeys(context.Context, *RotateEncryptionKeysRequest) (*RotateEncryptionKeysReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateEncryptionKeys not implemented")
+}
+func (UnimplementedEmployeeServer) GetTermination(context.Context, *GetTerminationRequest) (*GetTerminationReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTermination not implemented")
+}
+func (UnimplementedEmployeeServer) CreateCustomField(context.Context, *CreateCustomFieldRequest) (*CreateCustomFieldReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCustomField not implemented")
+}
+func (UnimplementedEmployeeServer) ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCustomFields not implemented")
+}
+func (UnimplementedEmployeeServer) UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCustomField not implemented")
+}
 func (UnimplementedEmployeeServer) mustEmbedUnimplementedEmployeeServer() {}
 func (UnimplementedEmployeeServer) testEmbeddedByValue()                  {}
 
@@ -168,6 +400,42 @@ func _Employee_List_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Employee_ExportEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).ExportEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_ExportEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).ExportEmployees(ctx, req.(*ExportEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_ImportEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).ImportEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_ImportEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).ImportEmployees(ctx, req.(*ImportEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Employee_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +508,222 @@ func _Employee_Delete_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Employee_CreateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).CreateContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_CreateContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).CreateContract(ctx, req.(*CreateContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_ListContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).ListContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_ListContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).ListContracts(ctx, req.(*ListContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_ChangeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).ChangeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_ChangeStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).ChangeStatus(ctx, req.(*ChangeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_Terminate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).Terminate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_Terminate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).Terminate(ctx, req.(*TerminateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_AddDependent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDependentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).AddDependent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_AddDependent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).AddDependent(ctx, req.(*AddDependentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_ListDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).ListDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_ListDependents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).ListDependents(ctx, req.(*ListDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_EndDependent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDependentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).EndDependent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_EndDependent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).EndDependent(ctx, req.(*EndDependentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_RotateEncryptionKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateEncryptionKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).RotateEncryptionKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_RotateEncryptionKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).RotateEncryptionKeys(ctx, req.(*RotateEncryptionKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_GetTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTerminationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).GetTermination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_GetTermination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).GetTermination(ctx, req.(*GetTerminationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_CreateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).CreateCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_CreateCustomField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).CreateCustomField(ctx, req.(*CreateCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_ListCustomFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCustomFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).ListCustomFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_ListCustomFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).ListCustomFields(ctx, req.(*ListCustomFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employee_UpdateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServer).UpdateCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Employee_UpdateCustomField_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServer).UpdateCustomField(ctx, req.(*UpdateCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Employee_ServiceDesc is the grpc.ServiceDesc for Employee service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -251,6 +735,14 @@ var Employee_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Employee_List_Handler,
 		},
+		{
+			MethodName: "ExportEmployees",
+			Handler:    _Employee_ExportEmployees_Handler,
+		},
+		{
+			MethodName: "ImportEmployees",
+			Handler:    _Employee_ImportEmployees_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _Employee_Get_Handler,
@@ -267,6 +759,54 @@ var Employee_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _Employee_Delete_Handler,
 		},
+		{
+			MethodName: "CreateContract",
+			Handler:    _Employee_CreateContract_Handler,
+		},
+		{
+			MethodName: "ListContracts",
+			Handler:    _Employee_ListContracts_Handler,
+		},
+		{
+			MethodName: "ChangeStatus",
+			Handler:    _Employee_ChangeStatus_Handler,
+		},
+		{
+			MethodName: "Terminate",
+			Handler:    _Employee_Terminate_Handler,
+		},
+		{
+			MethodName: "AddDependent",
+			Handler:    _Employee_AddDependent_Handler,
+		},
+		{
+			MethodName: "ListDependents",
+			Handler:    _Employee_ListDependents_Handler,
+		},
+		{
+			MethodName: "EndDependent",
+			Handler:    _Employee_EndDependent_Handler,
+		},
+		{
+			MethodName: "RotateEncryptionKeys",
+			Handler:    _Employee_RotateEncryptionKeys_Handler,
+		},
+		{
+			MethodName: "GetTermination",
+			Handler:    _Employee_GetTermination_Handler,
+		},
+		{
+			MethodName: "CreateCustomField",
+			Handler:    _Employee_CreateCustomField_Handler,
+		},
+		{
+			MethodName: "ListCustomFields",
+			Handler:    _Employee_ListCustomFields_Handler,
+		},
+		{
+			MethodName: "UpdateCustomField",
+			Handler:    _Employee_UpdateCustomField_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/employee/v1/employee.proto",
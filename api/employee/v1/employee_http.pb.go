@@ -19,27 +19,73 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationEmployeeAddDependent = "/employee.v1.Employee/AddDependent"
+const OperationEmployeeChangeStatus = "/employee.v1.Employee/ChangeStatus"
 const OperationEmployeeCreate = "/employee.v1.Employee/Create"
+const OperationEmployeeCreateContract = "/employee.v1.Employee/CreateContract"
+const OperationEmployeeCreateCustomField = "/employee.v1.Employee/CreateCustomField"
 const OperationEmployeeDelete = "/employee.v1.Employee/Delete"
+const OperationEmployeeEndDependent = "/employee.v1.Employee/EndDependent"
+const OperationEmployeeExportEmployees = "/employee.v1.Employee/ExportEmployees"
 const OperationEmployeeGet = "/employee.v1.Employee/Get"
+const OperationEmployeeGetTermination = "/employee.v1.Employee/GetTermination"
+const OperationEmployeeImportEmployees = "/employee.v1.Employee/ImportEmployees"
 const OperationEmployeeList = "/employee.v1.Employee/List"
+const OperationEmployeeListContracts = "/employee.v1.Employee/ListContracts"
+const OperationEmployeeListCustomFields = "/employee.v1.Employee/ListCustomFields"
+const OperationEmployeeListDependents = "/employee.v1.Employee/ListDependents"
+const OperationEmployeeRotateEncryptionKeys = "/employee.v1.Employee/RotateEncryptionKeys"
+const OperationEmployeeTerminate = "/employee.v1.Employee/Terminate"
 const OperationEmployeeUpdate = "/employee.v1.Employee/Update"
+const OperationEmployeeUpdateCustomField = "/employee.v1.Employee/UpdateCustomField"
 
 type EmployeeHTTPServer interface {
+	AddDependent(context.Context, *AddDependentRequest) (*AddDependentReply, error)
+	ChangeStatus(context.Context, *ChangeStatusRequest) (*ChangeStatusReply, error)
 	Create(context.Context, *CreateRequest) (*CreateReply, error)
+	CreateContract(context.Context, *CreateContractRequest) (*CreateContractReply, error)
+	CreateCustomField(context.Context, *CreateCustomFieldRequest) (*CreateCustomFieldReply, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteReply, error)
+	EndDependent(context.Context, *EndDependentRequest) (*EndDependentReply, error)
+	// ExportEmployees ExportEmployees is declared before Get so /employees/export is not
+	// routed as an employee ID.
+	ExportEmployees(context.Context, *ExportEmployeesRequest) (*ExportEmployeesReply, error)
 	Get(context.Context, *GetRequest) (*GetReply, error)
+	GetTermination(context.Context, *GetTerminationRequest) (*GetTerminationReply, error)
+	ImportEmployees(context.Context, *ImportEmployeesRequest) (*ImportEmployeesReply, error)
 	List(context.Context, *ListRequest) (*ListReply, error)
+	ListContracts(context.Context, *ListContractsRequest) (*ListContractsReply, error)
+	ListCustomFields(context.Context, *ListCustomFieldsRequest) (*ListCustomFieldsReply, error)
+	ListDependents(context.Context, *ListDependentsRequest) (*ListDependentsReply, error)
+	// RotateEncryptionKeys RotateEncryptionKeys re-encrypts personal data with the active key so
+	// retired keys can be removed from the configuration.
+	RotateEncryptionKeys(context.Context, *RotateEncryptionKeysRequest) (*RotateEncryptionKeysReply, error)
+	Terminate(context.Context, *TerminateRequest) (*TerminateReply, error)
 	Update(context.Context, *UpdateRequest) (*UpdateReply, error)
+	UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldReply, error)
 }
 
 func RegisterEmployeeHTTPServer(s *http.Server, srv EmployeeHTTPServer) {
 	r := s.Route("/")
 	r.GET("/employees", _Employee_List0_HTTP_Handler(srv))
+	r.GET("/employees/export", _Employee_ExportEmployees0_HTTP_Handler(srv))
+	r.POST("/employees/import", _Employee_ImportEmployees0_HTTP_Handler(srv))
 	r.GET("/employees/{id}", _Employee_Get0_HTTP_Handler(srv))
 	r.POST("/employees", _Employee_Create1_HTTP_Handler(srv))
-	r.PUT("/employees/{id}", _Employee_Update0_HTTP_Handler(srv))
+	r.PUT("/employees/{id}", _Employee_Update1_HTTP_Handler(srv))
 	r.DELETE("/employees/{id}", _Employee_Delete0_HTTP_Handler(srv))
+	r.POST("/employees/{employee_id}/contracts", _Employee_CreateContract0_HTTP_Handler(srv))
+	r.GET("/employees/{employee_id}/contracts", _Employee_ListContracts0_HTTP_Handler(srv))
+	r.POST("/employees/{id}/status", _Employee_ChangeStatus0_HTTP_Handler(srv))
+	r.POST("/employees/{id}/terminate", _Employee_Terminate0_HTTP_Handler(srv))
+	r.POST("/employees/{employee_id}/dependents", _Employee_AddDependent0_HTTP_Handler(srv))
+	r.GET("/employees/{employee_id}/dependents", _Employee_ListDependents0_HTTP_Handler(srv))
+	r.POST("/employees/{employee_id}/dependents/{id}/end", _Employee_EndDependent0_HTTP_Handler(srv))
+	r.POST("/employees/rotate-keys", _Employee_RotateEncryptionKeys0_HTTP_Handler(srv))
+	r.GET("/employees/{id}/termination", _Employee_GetTermination0_HTTP_Handler(srv))
+	r.POST("/custom-fields", _Employee_CreateCustomField0_HTTP_Handler(srv))
+	r.GET("/custom-fields", _Employee_ListCustomFields0_HTTP_Handler(srv))
+	r.PUT("/custom-fields/{id}", _Employee_UpdateCustomField0_HTTP_Handler(srv))
 }
 
 func _Employee_List0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
@@ -61,6 +107,47 @@ func _Employee_List0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _Employee_ExportEmployees0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportEmployeesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeExportEmployees)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportEmployees(ctx, req.(*ExportEmployeesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportEmployeesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_ImportEmployees0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportEmployeesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeImportEmployees)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportEmployees(ctx, req.(*ImportEmployeesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportEmployeesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Employee_Get0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetRequest
@@ -105,7 +192,7 @@ func _Employee_Create1_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Contex
 	}
 }
 
-func _Employee_Update0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+func _Employee_Update1_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in UpdateRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -152,12 +239,305 @@ func _Employee_Delete0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Contex
 	}
 }
 
+func _Employee_CreateContract0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateContractRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeCreateContract)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateContract(ctx, req.(*CreateContractRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateContractReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_ListContracts0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListContractsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeListContracts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListContracts(ctx, req.(*ListContractsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListContractsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_ChangeStatus0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeChangeStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangeStatus(ctx, req.(*ChangeStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangeStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_Terminate0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TerminateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeTerminate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Terminate(ctx, req.(*TerminateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TerminateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_AddDependent0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddDependentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeAddDependent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddDependent(ctx, req.(*AddDependentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddDependentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_ListDependents0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDependentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeListDependents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDependents(ctx, req.(*ListDependentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDependentsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_EndDependent0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EndDependentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeEndDependent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EndDependent(ctx, req.(*EndDependentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EndDependentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_RotateEncryptionKeys0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RotateEncryptionKeysRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeRotateEncryptionKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RotateEncryptionKeys(ctx, req.(*RotateEncryptionKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RotateEncryptionKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_GetTermination0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTerminationRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeGetTermination)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTermination(ctx, req.(*GetTerminationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTerminationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_CreateCustomField0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateCustomFieldRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeCreateCustomField)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCustomField(ctx, req.(*CreateCustomFieldRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateCustomFieldReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_ListCustomFields0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListCustomFieldsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeListCustomFields)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCustomFields(ctx, req.(*ListCustomFieldsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListCustomFieldsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Employee_UpdateCustomField0_HTTP_Handler(srv EmployeeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateCustomFieldRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEmployeeUpdateCustomField)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCustomField(ctx, req.(*UpdateCustomFieldRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateCustomFieldReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type EmployeeHTTPClient interface {
+	AddDependent(ctx context.Context, req *AddDependentRequest, opts ...http.CallOption) (rsp *AddDependentReply, err error)
+	ChangeStatus(ctx context.Context, req *ChangeStatusRequest, opts ...http.CallOption) (rsp *ChangeStatusReply, err error)
 	Create(ctx context.Context, req *CreateRequest, opts ...http.CallOption) (rsp *CreateReply, err error)
+	CreateContract(ctx context.Context, req *CreateContractRequest, opts ...http.CallOption) (rsp *CreateContractReply, err error)
+	CreateCustomField(ctx context.Context, req *CreateCustomFieldRequest, opts ...http.CallOption) (rsp *CreateCustomFieldReply, err error)
 	Delete(ctx context.Context, req *DeleteRequest, opts ...http.CallOption) (rsp *DeleteReply, err error)
+	EndDependent(ctx context.Context, req *EndDependentRequest, opts ...http.CallOption) (rsp *EndDependentReply, err error)
+	ExportEmployees(ctx context.Context, req *ExportEmployeesRequest, opts ...http.CallOption) (rsp *ExportEmployeesReply, err error)
 	Get(ctx context.Context, req *GetRequest, opts ...http.CallOption) (rsp *GetReply, err error)
+	GetTermination(ctx context.Context, req *GetTerminationRequest, opts ...http.CallOption) (rsp *GetTerminationReply, err error)
+	ImportEmployees(ctx context.Context, req *ImportEmployeesRequest, opts ...http.CallOption) (rsp *ImportEmployeesReply, err error)
 	List(ctx context.Context, req *ListRequest, opts ...http.CallOption) (rsp *ListReply, err error)
+	ListContracts(ctx context.Context, req *ListContractsRequest, opts ...http.CallOption) (rsp *ListContractsReply, err error)
+	ListCustomFields(ctx context.Context, req *ListCustomFieldsRequest, opts ...http.CallOption) (rsp *ListCustomFieldsReply, err error)
+	ListDependents(ctx context.Context, req *ListDependentsRequest, opts ...http.CallOption) (rsp *ListDependentsReply, err error)
+	RotateEncryptionKeys(ctx context.Context, req *RotateEncryptionKeysRequest, opts ...http.CallOption) (rsp *RotateEncryptionKeysReply, err error)
+	Terminate(ctx context.Context, req *TerminateRequest, opts ...http.CallOption) (rsp *TerminateReply, err error)
 	Update(ctx context.Context, req *UpdateRequest, opts ...http.CallOption) (rsp *UpdateReply, err error)
+	UpdateCustomField(ctx context.Context, req *UpdateCustomFieldRequest, opts ...http.CallOption) (rsp *UpdateCustomFieldReply, err error)
 }
 
 type EmployeeHTTPClientImpl struct {
@@ -168,6 +548,32 @@ func NewEmployeeHTTPClient(client *http.Client) EmployeeHTTPClient {
 	return &EmployeeHTTPClientImpl{client}
 }
 
+func (c *EmployeeHTTPClientImpl) AddDependent(ctx context.Context, in *AddDependentRequest, opts ...http.CallOption) (*AddDependentReply, error) {
+	var out AddDependentReply
+	pattern := "/employees/{employee_id}/dependents"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeAddDependent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EmployeeHTTPClientImpl) ChangeStatus(ctx context.Context, in *ChangeStatusRequest, opts ...http.CallOption) (*ChangeStatusReply, error) {
+	var out ChangeStatusReply
+	pattern := "/employees/{id}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeChangeStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *EmployeeHTTPClientImpl) Create(ctx context.Context, in *CreateRequest, opts ...http.CallOption) (*CreateReply, error) {
 	var out CreateReply
 	pattern := "/employees"
@@ -181,6 +587,32 @@ func (c *EmployeeHTTPClientImpl) Create(ctx context.Context, in *CreateRequest,
 	return &out, nil
 }
 
+func (c *EmployeeHTTPClientImpl) CreateContract(ctx context.Context, in *CreateContractRequest, opts ...http.CallOption) (*CreateContractReply, error) {
+	var out CreateContractReply
+	pattern := "/employees/{employee_id}/contracts"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeCreateContract))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EmployeeHTTPClientImpl) CreateCustomField(ctx context.Context, in *CreateCustomFieldRequest, opts ...http.CallOption) (*CreateCustomFieldReply, error) {
+	var out CreateCustomFieldReply
+	pattern := "/custom-fields"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeCreateCustomField))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *EmployeeHTTPClientImpl) Delete(ctx context.Context, in *DeleteRequest, opts ...http.CallOption) (*DeleteReply, error) {
 	var out DeleteReply
 	pattern := "/employees/{id}"
@@ -194,6 +626,32 @@ func (c *EmployeeHTTPClientImpl) Delete(ctx context.Context, in *DeleteRequest,
 	return &out, nil
 }
 
+func (c *EmployeeHTTPClientImpl) EndDependent(ctx context.Context, in *EndDependentRequest, opts ...http.CallOption) (*EndDependentReply, error) {
+	var out EndDependentReply
+	pattern := "/employees/{employee_id}/dependents/{id}/end"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeEndDependent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EmployeeHTTPClientImpl) ExportEmployees(ctx context.Context, in *ExportEmployeesRequest, opts ...http.CallOption) (*ExportEmployeesReply, error) {
+	var out ExportEmployeesReply
+	pattern := "/employees/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeExportEmployees))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *EmployeeHTTPClientImpl) Get(ctx context.Context, in *GetRequest, opts ...http.CallOption) (*GetReply, error) {
 	var out GetReply
 	pattern := "/employees/{id}"
@@ -207,6 +665,32 @@ func (c *EmployeeHTTPClientImpl) Get(ctx context.Context, in *GetRequest, opts .
 	return &out, nil
 }
 
+func (c *EmployeeHTTPClientImpl) GetTermination(ctx context.Context, in *GetTerminationRequest, opts ...http.CallOption) (*GetTerminationReply, error) {
+	var out GetTerminationReply
+	pattern := "/employees/{id}/termination"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeGetTermination))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EmployeeHTTPClientImpl) ImportEmployees(ctx context.Context, in *ImportEmployeesRequest, opts ...http.CallOption) (*ImportEmployeesReply, error) {
+	var out ImportEmployeesReply
+	pattern := "/employees/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeImportEmployees))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *EmployeeHTTPClientImpl) List(ctx context.Context, in *ListRequest, opts ...http.CallOption) (*ListReply, error) {
 	var out ListReply
 	pattern := "/employees"
@@ -220,6 +704,71 @@ func (c *EmployeeHTTPClientImpl) List(ctx context.Context, in *ListRequest, opts
 	return &out, nil
 }
 
+func (c *EmployeeHTTPClientImpl) ListContracts(ctx context.Context, in *ListContractsRequest, opts ...http.CallOption) (*ListContractsReply, error) {
+	var out ListContractsReply
+	pattern := "/employees/{employee_id}/contracts"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeListContracts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EmployeeHTTPClientImpl) ListCustomFields(ctx context.Context, in *ListCustomFieldsRequest, opts ...http.CallOption) (*ListCustomFieldsReply, error) {
+	var out ListCustomFieldsReply
+	pattern := "/custom-fields"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeListCustomFields))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EmployeeHTTPClientImpl) ListDependents(ctx context.Context, in *ListDependentsRequest, opts ...http.CallOption) (*ListDependentsReply, error) {
+	var out ListDependentsReply
+	pattern := "/employees/{employee_id}/dependents"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationEmployeeListDependents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EmployeeHTTPClientImpl) RotateEncryptionKeys(ctx context.Context, in *RotateEncryptionKeysRequest, opts ...http.CallOption) (*RotateEncryptionKeysReply, error) {
+	var out RotateEncryptionKeysReply
+	pattern := "/employees/rotate-keys"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeRotateEncryptionKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *EmployeeHTTPClientImpl) Terminate(ctx context.Context, in *TerminateRequest, opts ...http.CallOption) (*TerminateReply, error) {
+	var out TerminateReply
+	pattern := "/employees/{id}/terminate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeTerminate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *EmployeeHTTPClientImpl) Update(ctx context.Context, in *UpdateRequest, opts ...http.CallOption) (*UpdateReply, error) {
 	var out UpdateReply
 	pattern := "/employees/{id}"
@@ -232,3 +781,16 @@ func (c *EmployeeHTTPClientImpl) Update(ctx context.Context, in *UpdateRequest,
 	}
 	return &out, nil
 }
+
+func (c *EmployeeHTTPClientImpl) UpdateCustomField(ctx context.Context, in *UpdateCustomFieldRequest, opts ...http.CallOption) (*UpdateCustomFieldReply, error) {
+	var out UpdateCustomFieldReply
+	pattern := "/custom-fields/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEmployeeUpdateCustomField))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
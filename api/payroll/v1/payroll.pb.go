@@ -126,6 +126,102 @@ func (x *ExportPayrollPDFReply) GetFilename() string {
 	return ""
 }
 
+type ExportBankTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonthYear     string                 `protobuf:"bytes,1,opt,name=month_year,json=monthYear,proto3" json:"month_year,omitempty"` // YYYY-MM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBankTransferRequest) Reset() {
+	*x = ExportBankTransferRequest{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBankTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBankTransferRequest) ProtoMessage() {}
+
+func (x *ExportBankTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBankTransferRequest.ProtoReflect.Descriptor instead.
+func (*ExportBankTransferRequest) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportBankTransferRequest) GetMonthYear() string {
+	if x != nil {
+		return x.MonthYear
+	}
+	return ""
+}
+
+type ExportBankTransferReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CsvData       []byte                 `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportBankTransferReply) Reset() {
+	*x = ExportBankTransferReply{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBankTransferReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBankTransferReply) ProtoMessage() {}
+
+func (x *ExportBankTransferReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBankTransferReply.ProtoReflect.Descriptor instead.
+func (*ExportBankTransferReply) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportBankTransferReply) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+func (x *ExportBankTransferReply) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
 type CalculatePayrollRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
@@ -137,7 +233,7 @@ type CalculatePayrollRequest struct {
 
 func (x *CalculatePayrollRequest) Reset() {
 	*x = CalculatePayrollRequest{}
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[2]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -149,7 +245,7 @@ func (x *CalculatePayrollRequest) String() string {
 func (*CalculatePayrollRequest) ProtoMessage() {}
 
 func (x *CalculatePayrollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[2]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -162,7 +258,7 @@ func (x *CalculatePayrollRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculatePayrollRequest.ProtoReflect.Descriptor instead.
 func (*CalculatePayrollRequest) Descriptor() ([]byte, []int) {
-	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{2}
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CalculatePayrollRequest) GetEmployeeId() uint32 {
@@ -187,20 +283,22 @@ func (x *CalculatePayrollRequest) GetMonthYear() string {
 }
 
 type CalculatePayrollReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GrossSalary   float64                `protobuf:"fixed64,1,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
-	NetSalary     float64                `protobuf:"fixed64,2,opt,name=net_salary,json=netSalary,proto3" json:"net_salary,omitempty"`
-	Deductions    float64                `protobuf:"fixed64,3,opt,name=deductions,proto3" json:"deductions,omitempty"`
-	WorkingDays   int32                  `protobuf:"varint,4,opt,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"`
-	OvertimeHours float64                `protobuf:"fixed64,5,opt,name=overtime_hours,json=overtimeHours,proto3" json:"overtime_hours,omitempty"`
-	LeaveDays     int32                  `protobuf:"varint,6,opt,name=leave_days,json=leaveDays,proto3" json:"leave_days,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GrossSalary       float64                `protobuf:"fixed64,1,opt,name=gross_salary,json=grossSalary,proto3" json:"gross_salary,omitempty"`
+	NetSalary         float64                `protobuf:"fixed64,2,opt,name=net_salary,json=netSalary,proto3" json:"net_salary,omitempty"`
+	Deductions        float64                `protobuf:"fixed64,3,opt,name=deductions,proto3" json:"deductions,omitempty"`
+	WorkingDays       int32                  `protobuf:"varint,4,opt,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"`
+	OvertimeHours     float64                `protobuf:"fixed64,5,opt,name=overtime_hours,json=overtimeHours,proto3" json:"overtime_hours,omitempty"`
+	LeaveDays         int32                  `protobuf:"varint,6,opt,name=leave_days,json=leaveDays,proto3" json:"leave_days,omitempty"`
+	UnapprovedEntries int32                  `protobuf:"varint,7,opt,name=unapproved_entries,json=unapprovedEntries,proto3" json:"unapproved_entries,omitempty"` // timesheets left out because they are not approved
+	Dependents        int32                  `protobuf:"varint,8,opt,name=dependents,proto3" json:"dependents,omitempty"`                                        // registered dependents deducted this month
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CalculatePayrollReply) Reset() {
 	*x = CalculatePayrollReply{}
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[3]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -212,7 +310,7 @@ func (x *CalculatePayrollReply) String() string {
 func (*CalculatePayrollReply) ProtoMessage() {}
 
 func (x *CalculatePayrollReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[3]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -225,7 +323,7 @@ func (x *CalculatePayrollReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculatePayrollReply.ProtoReflect.Descriptor instead.
 func (*CalculatePayrollReply) Descriptor() ([]byte, []int) {
-	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{3}
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CalculatePayrollReply) GetGrossSalary() float64 {
@@ -270,6 +368,20 @@ func (x *CalculatePayrollReply) GetLeaveDays() int32 {
 	return 0
 }
 
+func (x *CalculatePayrollReply) GetUnapprovedEntries() int32 {
+	if x != nil {
+		return x.UnapprovedEntries
+	}
+	return 0
+}
+
+func (x *CalculatePayrollReply) GetDependents() int32 {
+	if x != nil {
+		return x.Dependents
+	}
+	return 0
+}
+
 type GetPayrollsByMonthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
@@ -281,7 +393,7 @@ type GetPayrollsByMonthRequest struct {
 
 func (x *GetPayrollsByMonthRequest) Reset() {
 	*x = GetPayrollsByMonthRequest{}
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[4]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -293,7 +405,7 @@ func (x *GetPayrollsByMonthRequest) String() string {
 func (*GetPayrollsByMonthRequest) ProtoMessage() {}
 
 func (x *GetPayrollsByMonthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[4]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +418,7 @@ func (x *GetPayrollsByMonthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPayrollsByMonthRequest.ProtoReflect.Descriptor instead.
 func (*GetPayrollsByMonthRequest) Descriptor() ([]byte, []int) {
-	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{4}
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetPayrollsByMonthRequest) GetEmployeeId() uint32 {
@@ -338,13 +450,20 @@ type PayrollItem struct {
 	WorkingDays   int32                  `protobuf:"varint,4,opt,name=working_days,json=workingDays,proto3" json:"working_days,omitempty"`
 	OvertimeHours float64                `protobuf:"fixed64,5,opt,name=overtime_hours,json=overtimeHours,proto3" json:"overtime_hours,omitempty"`
 	LeaveDays     int32                  `protobuf:"varint,6,opt,name=leave_days,json=leaveDays,proto3" json:"leave_days,omitempty"`
+	Id            uint32                 `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId    uint32                 `protobuf:"varint,8,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	MonthYear     string                 `protobuf:"bytes,9,opt,name=month_year,json=monthYear,proto3" json:"month_year,omitempty"` // YYYY-MM
+	BasicSalary   float64                `protobuf:"fixed64,10,opt,name=basic_salary,json=basicSalary,proto3" json:"basic_salary,omitempty"`
+	Allowances    float64                `protobuf:"fixed64,11,opt,name=allowances,proto3" json:"allowances,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	Dependents    int32                  `protobuf:"varint,13,opt,name=dependents,proto3" json:"dependents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PayrollItem) Reset() {
 	*x = PayrollItem{}
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[5]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -356,7 +475,7 @@ func (x *PayrollItem) String() string {
 func (*PayrollItem) ProtoMessage() {}
 
 func (x *PayrollItem) ProtoReflect() protoreflect.Message {
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[5]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +488,7 @@ func (x *PayrollItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayrollItem.ProtoReflect.Descriptor instead.
 func (*PayrollItem) Descriptor() ([]byte, []int) {
-	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{5}
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PayrollItem) GetGrossSalary() float64 {
@@ -414,6 +533,55 @@ func (x *PayrollItem) GetLeaveDays() int32 {
 	return 0
 }
 
+func (x *PayrollItem) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PayrollItem) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *PayrollItem) GetMonthYear() string {
+	if x != nil {
+		return x.MonthYear
+	}
+	return ""
+}
+
+func (x *PayrollItem) GetBasicSalary() float64 {
+	if x != nil {
+		return x.BasicSalary
+	}
+	return 0
+}
+
+func (x *PayrollItem) GetAllowances() float64 {
+	if x != nil {
+		return x.Allowances
+	}
+	return 0
+}
+
+func (x *PayrollItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PayrollItem) GetDependents() int32 {
+	if x != nil {
+		return x.Dependents
+	}
+	return 0
+}
+
 type GetPayrollsByMonthReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*PayrollItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -423,7 +591,7 @@ type GetPayrollsByMonthReply struct {
 
 func (x *GetPayrollsByMonthReply) Reset() {
 	*x = GetPayrollsByMonthReply{}
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[6]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -435,7 +603,7 @@ func (x *GetPayrollsByMonthReply) String() string {
 func (*GetPayrollsByMonthReply) ProtoMessage() {}
 
 func (x *GetPayrollsByMonthReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[6]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,7 +616,7 @@ func (x *GetPayrollsByMonthReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPayrollsByMonthReply.ProtoReflect.Descriptor instead.
 func (*GetPayrollsByMonthReply) Descriptor() ([]byte, []int) {
-	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{6}
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPayrollsByMonthReply) GetItems() []*PayrollItem {
@@ -462,14 +630,14 @@ type SendPayslipEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
 	MonthYear     string                 `protobuf:"bytes,2,opt,name=month_year,json=monthYear,proto3" json:"month_year,omitempty"`
-	ToEmail       string                 `protobuf:"bytes,3,opt,name=to_email,json=toEmail,proto3" json:"to_email,omitempty"`
+	ToEmail       string                 `protobuf:"bytes,3,opt,name=to_email,json=toEmail,proto3" json:"to_email,omitempty"` // optional, defaults to the employee's payslip channel
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendPayslipEmailRequest) Reset() {
 	*x = SendPayslipEmailRequest{}
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[7]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -481,7 +649,7 @@ func (x *SendPayslipEmailRequest) String() string {
 func (*SendPayslipEmailRequest) ProtoMessage() {}
 
 func (x *SendPayslipEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[7]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -494,7 +662,7 @@ func (x *SendPayslipEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendPayslipEmailRequest.ProtoReflect.Descriptor instead.
 func (*SendPayslipEmailRequest) Descriptor() ([]byte, []int) {
-	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{7}
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SendPayslipEmailRequest) GetEmployeeId() uint32 {
@@ -521,13 +689,14 @@ func (x *SendPayslipEmailRequest) GetToEmail() string {
 type SendPayslipEmailReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	SentTo        string                 `protobuf:"bytes,2,opt,name=sent_to,json=sentTo,proto3" json:"sent_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendPayslipEmailReply) Reset() {
 	*x = SendPayslipEmailReply{}
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[8]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +708,7 @@ func (x *SendPayslipEmailReply) String() string {
 func (*SendPayslipEmailReply) ProtoMessage() {}
 
 func (x *SendPayslipEmailReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_payroll_v1_payroll_proto_msgTypes[8]
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +721,7 @@ func (x *SendPayslipEmailReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendPayslipEmailReply.ProtoReflect.Descriptor instead.
 func (*SendPayslipEmailReply) Descriptor() ([]byte, []int) {
-	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{8}
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SendPayslipEmailReply) GetMessage() string {
@@ -562,71 +731,637 @@ func (x *SendPayslipEmailReply) GetMessage() string {
 	return ""
 }
 
-var File_api_payroll_v1_payroll_proto protoreflect.FileDescriptor
+func (x *SendPayslipEmailReply) GetSentTo() string {
+	if x != nil {
+		return x.SentTo
+	}
+	return ""
+}
 
-const file_api_payroll_v1_payroll_proto_rawDesc = "" +
-	"\n" +
-	"\x1capi/payroll/v1/payroll.proto\x12\n" +
-	"payroll.v1\x1a\x1cgoogle/api/annotations.proto\"Y\n" +
-	"\x17ExportPayrollPDFRequest\x12\x1f\n" +
-	"\vemployee_id\x18\x01 \x01(\rR\n" +
-	"employeeId\x12\x1d\n" +
-	"\n" +
-	"month_year\x18\x02 \x01(\tR\tmonthYear\"N\n" +
-	"\x15ExportPayrollPDFReply\x12\x19\n" +
-	"\bpdf_data\x18\x01 \x01(\fR\apdfData\x12\x1a\n" +
-	"\bfilename\x18\x02 \x01(\tR\bfilename\"y\n" +
-	"\x17CalculatePayrollRequest\x12\x1f\n" +
-	"\vemployee_id\x18\x01 \x01(\rR\n" +
-	"employeeId\x12\x1e\n" +
-	"\n" +
-	"allowances\x18\x02 \x01(\x01R\n" +
-	"allowances\x12\x1d\n" +
-	"\n" +
-	"month_year\x18\x03 \x01(\tR\tmonthYear\"\xe2\x01\n" +
-	"\x15CalculatePayrollReply\x12!\n" +
-	"\fgross_salary\x18\x01 \x01(\x01R\vgrossSalary\x12\x1d\n" +
-	"\n" +
-	"net_salary\x18\x02 \x01(\x01R\tnetSalary\x12\x1e\n" +
-	"\n" +
-	"deductions\x18\x03 \x01(\x01R\n" +
-	"deductions\x12!\n" +
-	"\fworking_days\x18\x04 \x01(\x05R\vworkingDays\x12%\n" +
-	"\x0eovertime_hours\x18\x05 \x01(\x01R\rovertimeHours\x12\x1d\n" +
-	"\n" +
-	"leave_days\x18\x06 \x01(\x05R\tleaveDays\"{\n" +
-	"\x19GetPayrollsByMonthRequest\x12\x1f\n" +
-	"\vemployee_id\x18\x01 \x01(\rR\n" +
-	"employeeId\x12\x1e\n" +
-	"\n" +
-	"allowances\x18\x02 \x01(\x01R\n" +
-	"allowances\x12\x1d\n" +
-	"\n" +
-	"month_year\x18\x03 \x01(\tR\tmonthYear\"\xd8\x01\n" +
-	"\vPayrollItem\x12!\n" +
-	"\fgross_salary\x18\x01 \x01(\x01R\vgrossSalary\x12\x1d\n" +
-	"\n" +
-	"net_salary\x18\x02 \x01(\x01R\tnetSalary\x12\x1e\n" +
-	"\n" +
-	"deductions\x18\x03 \x01(\x01R\n" +
-	"deductions\x12!\n" +
-	"\fworking_days\x18\x04 \x01(\x05R\vworkingDays\x12%\n" +
-	"\x0eovertime_hours\x18\x05 \x01(\x01R\rovertimeHours\x12\x1d\n" +
-	"\n" +
-	"leave_days\x18\x06 \x01(\x05R\tleaveDays\"H\n" +
-	"\x17GetPayrollsByMonthReply\x12-\n" +
-	"\x05items\x18\x01 \x03(\v2\x17.payroll.v1.PayrollItemR\x05items\"t\n" +
-	"\x17SendPayslipEmailRequest\x12\x1f\n" +
-	"\vemployee_id\x18\x01 \x01(\rR\n" +
-	"employeeId\x12\x1d\n" +
-	"\n" +
-	"month_year\x18\x02 \x01(\tR\tmonthYear\x12\x19\n" +
-	"\bto_email\x18\x03 \x01(\tR\atoEmail\"1\n" +
-	"\x15SendPayslipEmailReply\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xa2\x03\n" +
+type ListPayrollsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonthYear     string                 `protobuf:"bytes,1,opt,name=month_year,json=monthYear,proto3" json:"month_year,omitempty"`     // optional, YYYY-MM
+	EmployeeId    uint32                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"` // optional
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayrollsRequest) Reset() {
+	*x = ListPayrollsRequest{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayrollsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayrollsRequest) ProtoMessage() {}
+
+func (x *ListPayrollsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayrollsRequest.ProtoReflect.Descriptor instead.
+func (*ListPayrollsRequest) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPayrollsRequest) GetMonthYear() string {
+	if x != nil {
+		return x.MonthYear
+	}
+	return ""
+}
+
+func (x *ListPayrollsRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *ListPayrollsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPayrollsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPayrollsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PayrollItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayrollsReply) Reset() {
+	*x = ListPayrollsReply{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayrollsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayrollsReply) ProtoMessage() {}
+
+func (x *ListPayrollsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayrollsReply.ProtoReflect.Descriptor instead.
+func (*ListPayrollsReply) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPayrollsReply) GetItems() []*PayrollItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListPayrollsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DependentReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependentReportRequest) Reset() {
+	*x = DependentReportRequest{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependentReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependentReportRequest) ProtoMessage() {}
+
+func (x *DependentReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependentReportRequest.ProtoReflect.Descriptor instead.
+func (*DependentReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DependentReportRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type DependentReportItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	EmployeeName  string                 `protobuf:"bytes,2,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
+	DependentId   uint32                 `protobuf:"varint,3,opt,name=dependent_id,json=dependentId,proto3" json:"dependent_id,omitempty"`
+	DependentName string                 `protobuf:"bytes,4,opt,name=dependent_name,json=dependentName,proto3" json:"dependent_name,omitempty"`
+	Relationship  string                 `protobuf:"bytes,5,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	TaxId         string                 `protobuf:"bytes,6,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+	DateOfBirth   string                 `protobuf:"bytes,7,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`      // YYYY-MM-DD, empty when unknown
+	FromMonth     string                 `protobuf:"bytes,8,opt,name=from_month,json=fromMonth,proto3" json:"from_month,omitempty"`              // YYYY-MM
+	ToMonth       string                 `protobuf:"bytes,9,opt,name=to_month,json=toMonth,proto3" json:"to_month,omitempty"`                    // YYYY-MM, empty while still registered
+	MonthsInYear  int32                  `protobuf:"varint,10,opt,name=months_in_year,json=monthsInYear,proto3" json:"months_in_year,omitempty"` // months of the year the deduction applies
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependentReportItem) Reset() {
+	*x = DependentReportItem{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependentReportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependentReportItem) ProtoMessage() {}
+
+func (x *DependentReportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependentReportItem.ProtoReflect.Descriptor instead.
+func (*DependentReportItem) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DependentReportItem) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *DependentReportItem) GetEmployeeName() string {
+	if x != nil {
+		return x.EmployeeName
+	}
+	return ""
+}
+
+func (x *DependentReportItem) GetDependentId() uint32 {
+	if x != nil {
+		return x.DependentId
+	}
+	return 0
+}
+
+func (x *DependentReportItem) GetDependentName() string {
+	if x != nil {
+		return x.DependentName
+	}
+	return ""
+}
+
+func (x *DependentReportItem) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *DependentReportItem) GetTaxId() string {
+	if x != nil {
+		return x.TaxId
+	}
+	return ""
+}
+
+func (x *DependentReportItem) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *DependentReportItem) GetFromMonth() string {
+	if x != nil {
+		return x.FromMonth
+	}
+	return ""
+}
+
+func (x *DependentReportItem) GetToMonth() string {
+	if x != nil {
+		return x.ToMonth
+	}
+	return ""
+}
+
+func (x *DependentReportItem) GetMonthsInYear() int32 {
+	if x != nil {
+		return x.MonthsInYear
+	}
+	return 0
+}
+
+type DependentReportReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DependentReportItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependentReportReply) Reset() {
+	*x = DependentReportReply{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependentReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependentReportReply) ProtoMessage() {}
+
+func (x *DependentReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependentReportReply.ProtoReflect.Descriptor instead.
+func (*DependentReportReply) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DependentReportReply) GetItems() []*DependentReportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ListPendingTimesheetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonthYear     string                 `protobuf:"bytes,1,opt,name=month_year,json=monthYear,proto3" json:"month_year,omitempty"`
+	ManagerId     uint32                 `protobuf:"varint,2,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"` // optional, limits the list to this manager's direct and indirect reports
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingTimesheetsRequest) Reset() {
+	*x = ListPendingTimesheetsRequest{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingTimesheetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingTimesheetsRequest) ProtoMessage() {}
+
+func (x *ListPendingTimesheetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingTimesheetsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingTimesheetsRequest) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListPendingTimesheetsRequest) GetMonthYear() string {
+	if x != nil {
+		return x.MonthYear
+	}
+	return ""
+}
+
+func (x *ListPendingTimesheetsRequest) GetManagerId() uint32 {
+	if x != nil {
+		return x.ManagerId
+	}
+	return 0
+}
+
+type PendingTimesheetsItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId       uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	EmployeeName     string                 `protobuf:"bytes,2,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
+	DraftEntries     int32                  `protobuf:"varint,3,opt,name=draft_entries,json=draftEntries,proto3" json:"draft_entries,omitempty"`
+	SubmittedEntries int32                  `protobuf:"varint,4,opt,name=submitted_entries,json=submittedEntries,proto3" json:"submitted_entries,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PendingTimesheetsItem) Reset() {
+	*x = PendingTimesheetsItem{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingTimesheetsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingTimesheetsItem) ProtoMessage() {}
+
+func (x *PendingTimesheetsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingTimesheetsItem.ProtoReflect.Descriptor instead.
+func (*PendingTimesheetsItem) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PendingTimesheetsItem) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *PendingTimesheetsItem) GetEmployeeName() string {
+	if x != nil {
+		return x.EmployeeName
+	}
+	return ""
+}
+
+func (x *PendingTimesheetsItem) GetDraftEntries() int32 {
+	if x != nil {
+		return x.DraftEntries
+	}
+	return 0
+}
+
+func (x *PendingTimesheetsItem) GetSubmittedEntries() int32 {
+	if x != nil {
+		return x.SubmittedEntries
+	}
+	return 0
+}
+
+type ListPendingTimesheetsReply struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*PendingTimesheetsItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingTimesheetsReply) Reset() {
+	*x = ListPendingTimesheetsReply{}
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingTimesheetsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingTimesheetsReply) ProtoMessage() {}
+
+func (x *ListPendingTimesheetsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_payroll_v1_payroll_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingTimesheetsReply.ProtoReflect.Descriptor instead.
+func (*ListPendingTimesheetsReply) Descriptor() ([]byte, []int) {
+	return file_api_payroll_v1_payroll_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListPendingTimesheetsReply) GetItems() []*PendingTimesheetsItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_api_payroll_v1_payroll_proto protoreflect.FileDescriptor
+
+const file_api_payroll_v1_payroll_proto_rawDesc = "" +
+	"\n" +
+	"\x1capi/payroll/v1/payroll.proto\x12\n" +
+	"payroll.v1\x1a\x1cgoogle/api/annotations.proto\"Y\n" +
+	"\x17ExportPayrollPDFRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\x02 \x01(\tR\tmonthYear\"N\n" +
+	"\x15ExportPayrollPDFReply\x12\x19\n" +
+	"\bpdf_data\x18\x01 \x01(\fR\apdfData\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\":\n" +
+	"\x19ExportBankTransferRequest\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\x01 \x01(\tR\tmonthYear\"P\n" +
+	"\x17ExportBankTransferReply\x12\x19\n" +
+	"\bcsv_data\x18\x01 \x01(\fR\acsvData\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"y\n" +
+	"\x17CalculatePayrollRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12\x1e\n" +
+	"\n" +
+	"allowances\x18\x02 \x01(\x01R\n" +
+	"allowances\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\x03 \x01(\tR\tmonthYear\"\xb1\x02\n" +
+	"\x15CalculatePayrollReply\x12!\n" +
+	"\fgross_salary\x18\x01 \x01(\x01R\vgrossSalary\x12\x1d\n" +
+	"\n" +
+	"net_salary\x18\x02 \x01(\x01R\tnetSalary\x12\x1e\n" +
+	"\n" +
+	"deductions\x18\x03 \x01(\x01R\n" +
+	"deductions\x12!\n" +
+	"\fworking_days\x18\x04 \x01(\x05R\vworkingDays\x12%\n" +
+	"\x0eovertime_hours\x18\x05 \x01(\x01R\rovertimeHours\x12\x1d\n" +
+	"\n" +
+	"leave_days\x18\x06 \x01(\x05R\tleaveDays\x12-\n" +
+	"\x12unapproved_entries\x18\a \x01(\x05R\x11unapprovedEntries\x12\x1e\n" +
+	"\n" +
+	"dependents\x18\b \x01(\x05R\n" +
+	"dependents\"{\n" +
+	"\x19GetPayrollsByMonthRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12\x1e\n" +
+	"\n" +
+	"allowances\x18\x02 \x01(\x01R\n" +
+	"allowances\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\x03 \x01(\tR\tmonthYear\"\xa3\x03\n" +
+	"\vPayrollItem\x12!\n" +
+	"\fgross_salary\x18\x01 \x01(\x01R\vgrossSalary\x12\x1d\n" +
+	"\n" +
+	"net_salary\x18\x02 \x01(\x01R\tnetSalary\x12\x1e\n" +
+	"\n" +
+	"deductions\x18\x03 \x01(\x01R\n" +
+	"deductions\x12!\n" +
+	"\fworking_days\x18\x04 \x01(\x05R\vworkingDays\x12%\n" +
+	"\x0eovertime_hours\x18\x05 \x01(\x01R\rovertimeHours\x12\x1d\n" +
+	"\n" +
+	"leave_days\x18\x06 \x01(\x05R\tleaveDays\x12\x0e\n" +
+	"\x02id\x18\a \x01(\rR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\b \x01(\rR\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\t \x01(\tR\tmonthYear\x12!\n" +
+	"\fbasic_salary\x18\n" +
+	" \x01(\x01R\vbasicSalary\x12\x1e\n" +
+	"\n" +
+	"allowances\x18\v \x01(\x01R\n" +
+	"allowances\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"dependents\x18\r \x01(\x05R\n" +
+	"dependents\"H\n" +
+	"\x17GetPayrollsByMonthReply\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.payroll.v1.PayrollItemR\x05items\"t\n" +
+	"\x17SendPayslipEmailRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\x02 \x01(\tR\tmonthYear\x12\x19\n" +
+	"\bto_email\x18\x03 \x01(\tR\atoEmail\"J\n" +
+	"\x15SendPayslipEmailReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x17\n" +
+	"\asent_to\x18\x02 \x01(\tR\x06sentTo\"\x91\x01\n" +
+	"\x13ListPayrollsRequest\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\x01 \x01(\tR\tmonthYear\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\rR\n" +
+	"employeeId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"j\n" +
+	"\x11ListPayrollsReply\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.payroll.v1.PayrollItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\",\n" +
+	"\x16DependentReportRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\"\xe4\x02\n" +
+	"\x13DependentReportItem\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12#\n" +
+	"\remployee_name\x18\x02 \x01(\tR\femployeeName\x12!\n" +
+	"\fdependent_id\x18\x03 \x01(\rR\vdependentId\x12%\n" +
+	"\x0edependent_name\x18\x04 \x01(\tR\rdependentName\x12\"\n" +
+	"\frelationship\x18\x05 \x01(\tR\frelationship\x12\x15\n" +
+	"\x06tax_id\x18\x06 \x01(\tR\x05taxId\x12\"\n" +
+	"\rdate_of_birth\x18\a \x01(\tR\vdateOfBirth\x12\x1d\n" +
+	"\n" +
+	"from_month\x18\b \x01(\tR\tfromMonth\x12\x19\n" +
+	"\bto_month\x18\t \x01(\tR\atoMonth\x12$\n" +
+	"\x0emonths_in_year\x18\n" +
+	" \x01(\x05R\fmonthsInYear\"M\n" +
+	"\x14DependentReportReply\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.payroll.v1.DependentReportItemR\x05items\"\\\n" +
+	"\x1cListPendingTimesheetsRequest\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\x01 \x01(\tR\tmonthYear\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x02 \x01(\rR\tmanagerId\"\xaf\x01\n" +
+	"\x15PendingTimesheetsItem\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12#\n" +
+	"\remployee_name\x18\x02 \x01(\tR\femployeeName\x12#\n" +
+	"\rdraft_entries\x18\x03 \x01(\x05R\fdraftEntries\x12+\n" +
+	"\x11submitted_entries\x18\x04 \x01(\x05R\x10submittedEntries\"U\n" +
+	"\x1aListPendingTimesheetsReply\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.payroll.v1.PendingTimesheetsItemR\x05items2\xb6\a\n" +
 	"\aPayroll\x12|\n" +
-	"\x10CalculatePayroll\x12#.payroll.v1.CalculatePayrollRequest\x1a!.payroll.v1.CalculatePayrollReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/payroll/calculate\x12\x99\x01\n" +
-	"\x10ExportPayrollPDF\x12#.payroll.v1.ExportPayrollPDFRequest\x1a!.payroll.v1.ExportPayrollPDFReply\"=\x82\xd3\xe4\x93\x027b\x01*\x122/v1/payroll/{employee_id}/payslip/{month_year}.pdf\x12}\n" +
+	"\x10CalculatePayroll\x12#.payroll.v1.CalculatePayrollRequest\x1a!.payroll.v1.CalculatePayrollReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/payroll/calculate\x12\x97\x01\n" +
+	"\x12ExportBankTransfer\x12%.payroll.v1.ExportBankTransferRequest\x1a#.payroll.v1.ExportBankTransferReply\"5\x82\xd3\xe4\x93\x02/b\x01*\x12*/v1/payroll/bank-transfer/{month_year}.csv\x12\x99\x01\n" +
+	"\x10ExportPayrollPDF\x12#.payroll.v1.ExportPayrollPDFRequest\x1a!.payroll.v1.ExportPayrollPDFReply\"=\x82\xd3\xe4\x93\x027b\x01*\x122/v1/payroll/{employee_id}/payslip/{month_year}.pdf\x12d\n" +
+	"\fListPayrolls\x12\x1f.payroll.v1.ListPayrollsRequest\x1a\x1d.payroll.v1.ListPayrollsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/payrolls\x12~\n" +
+	"\x0fDependentReport\x12\".payroll.v1.DependentReportRequest\x1a .payroll.v1.DependentReportReply\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/payroll/dependents-report\x12\x91\x01\n" +
+	"\x15ListPendingTimesheets\x12(.payroll.v1.ListPendingTimesheetsRequest\x1a&.payroll.v1.ListPendingTimesheetsReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/payroll/pending-timesheets\x12}\n" +
 	"\x10SendPayslipEmail\x12#.payroll.v1.SendPayslipEmailRequest\x1a!.payroll.v1.SendPayslipEmailReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/payroll/send-emailB\x19Z\x17myapp/api/payroll/v1;v1b\x06proto3"
 
 var (
@@ -641,31 +1376,52 @@ func file_api_payroll_v1_payroll_proto_rawDescGZIP() []byte {
 	return file_api_payroll_v1_payroll_proto_rawDescData
 }
 
-var file_api_payroll_v1_payroll_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_payroll_v1_payroll_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_api_payroll_v1_payroll_proto_goTypes = []any{
-	(*ExportPayrollPDFRequest)(nil),   // 0: payroll.v1.ExportPayrollPDFRequest
-	(*ExportPayrollPDFReply)(nil),     // 1: payroll.v1.ExportPayrollPDFReply
-	(*CalculatePayrollRequest)(nil),   // 2: payroll.v1.CalculatePayrollRequest
-	(*CalculatePayrollReply)(nil),     // 3: payroll.v1.CalculatePayrollReply
-	(*GetPayrollsByMonthRequest)(nil), // 4: payroll.v1.GetPayrollsByMonthRequest
-	(*PayrollItem)(nil),               // 5: payroll.v1.PayrollItem
-	(*GetPayrollsByMonthReply)(nil),   // 6: payroll.v1.GetPayrollsByMonthReply
-	(*SendPayslipEmailRequest)(nil),   // 7: payroll.v1.SendPayslipEmailRequest
-	(*SendPayslipEmailReply)(nil),     // 8: payroll.v1.SendPayslipEmailReply
+	(*ExportPayrollPDFRequest)(nil),      // 0: payroll.v1.ExportPayrollPDFRequest
+	(*ExportPayrollPDFReply)(nil),        // 1: payroll.v1.ExportPayrollPDFReply
+	(*ExportBankTransferRequest)(nil),    // 2: payroll.v1.ExportBankTransferRequest
+	(*ExportBankTransferReply)(nil),      // 3: payroll.v1.ExportBankTransferReply
+	(*CalculatePayrollRequest)(nil),      // 4: payroll.v1.CalculatePayrollRequest
+	(*CalculatePayrollReply)(nil),        // 5: payroll.v1.CalculatePayrollReply
+	(*GetPayrollsByMonthRequest)(nil),    // 6: payroll.v1.GetPayrollsByMonthRequest
+	(*PayrollItem)(nil),                  // 7: payroll.v1.PayrollItem
+	(*GetPayrollsByMonthReply)(nil),      // 8: payroll.v1.GetPayrollsByMonthReply
+	(*SendPayslipEmailRequest)(nil),      // 9: payroll.v1.SendPayslipEmailRequest
+	(*SendPayslipEmailReply)(nil),        // 10: payroll.v1.SendPayslipEmailReply
+	(*ListPayrollsRequest)(nil),          // 11: payroll.v1.ListPayrollsRequest
+	(*ListPayrollsReply)(nil),            // 12: payroll.v1.ListPayrollsReply
+	(*DependentReportRequest)(nil),       // 13: payroll.v1.DependentReportRequest
+	(*DependentReportItem)(nil),          // 14: payroll.v1.DependentReportItem
+	(*DependentReportReply)(nil),         // 15: payroll.v1.DependentReportReply
+	(*ListPendingTimesheetsRequest)(nil), // 16: payroll.v1.ListPendingTimesheetsRequest
+	(*PendingTimesheetsItem)(nil),        // 17: payroll.v1.PendingTimesheetsItem
+	(*ListPendingTimesheetsReply)(nil),   // 18: payroll.v1.ListPendingTimesheetsReply
 }
 var file_api_payroll_v1_payroll_proto_depIdxs = []int32{
-	5, // 0: payroll.v1.GetPayrollsByMonthReply.items:type_name -> payroll.v1.PayrollItem
-	2, // 1: payroll.v1.Payroll.CalculatePayroll:input_type -> payroll.v1.CalculatePayrollRequest
-	0, // 2: payroll.v1.Payroll.ExportPayrollPDF:input_type -> payroll.v1.ExportPayrollPDFRequest
-	7, // 3: payroll.v1.Payroll.SendPayslipEmail:input_type -> payroll.v1.SendPayslipEmailRequest
-	3, // 4: payroll.v1.Payroll.CalculatePayroll:output_type -> payroll.v1.CalculatePayrollReply
-	1, // 5: payroll.v1.Payroll.ExportPayrollPDF:output_type -> payroll.v1.ExportPayrollPDFReply
-	8, // 6: payroll.v1.Payroll.SendPayslipEmail:output_type -> payroll.v1.SendPayslipEmailReply
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7,  // 0: payroll.v1.GetPayrollsByMonthReply.items:type_name -> payroll.v1.PayrollItem
+	7,  // 1: payroll.v1.ListPayrollsReply.items:type_name -> payroll.v1.PayrollItem
+	14, // 2: payroll.v1.DependentReportReply.items:type_name -> payroll.v1.DependentReportItem
+	17, // 3: payroll.v1.ListPendingTimesheetsReply.items:type_name -> payroll.v1.PendingTimesheetsItem
+	4,  // 4: payroll.v1.Payroll.CalculatePayroll:input_type -> payroll.v1.CalculatePayrollRequest
+	2,  // 5: payroll.v1.Payroll.ExportBankTransfer:input_type -> payroll.v1.ExportBankTransferRequest
+	0,  // 6: payroll.v1.Payroll.ExportPayrollPDF:input_type -> payroll.v1.ExportPayrollPDFRequest
+	11, // 7: payroll.v1.Payroll.ListPayrolls:input_type -> payroll.v1.ListPayrollsRequest
+	13, // 8: payroll.v1.Payroll.DependentReport:input_type -> payroll.v1.DependentReportRequest
+	16, // 9: payroll.v1.Payroll.ListPendingTimesheets:input_type -> payroll.v1.ListPendingTimesheetsRequest
+	9,  // 10: payroll.v1.Payroll.SendPayslipEmail:input_type -> payroll.v1.SendPayslipEmailRequest
+	5,  // 11: payroll.v1.Payroll.CalculatePayroll:output_type -> payroll.v1.CalculatePayrollReply
+	3,  // 12: payroll.v1.Payroll.ExportBankTransfer:output_type -> payroll.v1.ExportBankTransferReply
+	1,  // 13: payroll.v1.Payroll.ExportPayrollPDF:output_type -> payroll.v1.ExportPayrollPDFReply
+	12, // 14: payroll.v1.Payroll.ListPayrolls:output_type -> payroll.v1.ListPayrollsReply
+	15, // 15: payroll.v1.Payroll.DependentReport:output_type -> payroll.v1.DependentReportReply
+	18, // 16: payroll.v1.Payroll.ListPendingTimesheets:output_type -> payroll.v1.ListPendingTimesheetsReply
+	10, // 17: payroll.v1.Payroll.SendPayslipEmail:output_type -> payroll.v1.SendPayslipEmailReply
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_api_payroll_v1_payroll_proto_init() }
@@ -679,7 +1435,7 @@ func file_api_payroll_v1_payroll_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_payroll_v1_payroll_proto_rawDesc), len(file_api_payroll_v1_payroll_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Payroll_CalculatePayroll_FullMethodName = "/payroll.v1.Payroll/CalculatePayroll"
-	Payroll_ExportPayrollPDF_FullMethodName = "/payroll.v1.Payroll/ExportPayrollPDF"
-	Payroll_SendPayslipEmail_FullMethodName = "/payroll.v1.Payroll/SendPayslipEmail"
+	Payroll_CalculatePayroll_FullMethodName      = "/payroll.v1.Payroll/CalculatePayroll"
+	Payroll_ExportBankTransfer_FullMethodName    = "/payroll.v1.Payroll/ExportBankTransfer"
+	Payroll_ExportPayrollPDF_FullMethodName      = "/payroll.v1.Payroll/ExportPayrollPDF"
+	Payroll_ListPayrolls_FullMethodName          = "/payroll.v1.Payroll/ListPayrolls"
+	Payroll_DependentReport_FullMethodName       = "/payroll.v1.Payroll/DependentReport"
+	Payroll_ListPendingTimesheets_FullMethodName = "/payroll.v1.Payroll/ListPendingTimesheets"
+	Payroll_SendPayslipEmail_FullMethodName      = "/payroll.v1.Payroll/SendPayslipEmail"
 )
 
 // PayrollClient is the client API for Payroll service.
@@ -29,7 +33,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PayrollClient interface {
 	CalculatePayroll(ctx context.Context, in *CalculatePayrollRequest, opts ...grpc.CallOption) (*CalculatePayrollReply, error)
+	// ExportBankTransfer builds the salary transfer file for the bank with the
+	// net salary and bank account of every payroll of the month.
+	ExportBankTransfer(ctx context.Context, in *ExportBankTransferRequest, opts ...grpc.CallOption) (*ExportBankTransferReply, error)
 	ExportPayrollPDF(ctx context.Context, in *ExportPayrollPDFRequest, opts ...grpc.CallOption) (*ExportPayrollPDFReply, error)
+	ListPayrolls(ctx context.Context, in *ListPayrollsRequest, opts ...grpc.CallOption) (*ListPayrollsReply, error)
+	DependentReport(ctx context.Context, in *DependentReportRequest, opts ...grpc.CallOption) (*DependentReportReply, error)
+	ListPendingTimesheets(ctx context.Context, in *ListPendingTimesheetsRequest, opts ...grpc.CallOption) (*ListPendingTimesheetsReply, error)
 	SendPayslipEmail(ctx context.Context, in *SendPayslipEmailRequest, opts ...grpc.CallOption) (*SendPayslipEmailReply, error)
 }
 
@@ -51,6 +61,16 @@ func (c *payrollClient) CalculatePayroll(ctx context.Context, in *CalculatePayro
 	return out, nil
 }
 
+func (c *payrollClient) ExportBankTransfer(ctx context.Context, in *ExportBankTransferRequest, opts ...grpc.CallOption) (*ExportBankTransferReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportBankTransferReply)
+	err := c.cc.Invoke(ctx, Payroll_ExportBankTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *payrollClient) ExportPayrollPDF(ctx context.Context, in *ExportPayrollPDFRequest, opts ...grpc.CallOption) (*ExportPayrollPDFReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExportPayrollPDFReply)
@@ -61,6 +81,36 @@ func (c *payrollClient) ExportPayrollPDF(ctx context.Context, in *ExportPayrollP
 	return out, nil
 }
 
+func (c *payrollClient) ListPayrolls(ctx context.Context, in *ListPayrollsRequest, opts ...grpc.CallOption) (*ListPayrollsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPayrollsReply)
+	err := c.cc.Invoke(ctx, Payroll_ListPayrolls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollClient) DependentReport(ctx context.Context, in *DependentReportRequest, opts ...grpc.CallOption) (*DependentReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DependentReportReply)
+	err := c.cc.Invoke(ctx, Payroll_DependentReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollClient) ListPendingTimesheets(ctx context.Context, in *ListPendingTimesheetsRequest, opts ...grpc.CallOption) (*ListPendingTimesheetsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingTimesheetsReply)
+	err := c.cc.Invoke(ctx, Payroll_ListPendingTimesheets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *payrollClient) SendPayslipEmail(ctx context.Context, in *SendPayslipEmailRequest, opts ...grpc.CallOption) (*SendPayslipEmailReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SendPayslipEmailReply)
@@ -76,7 +126,13 @@ func (c *payrollClient) SendPayslipEmail(ctx context.Context, in *SendPayslipEma
 // for forward compatibility.
 type PayrollServer interface {
 	CalculatePayroll(context.Context, *CalculatePayrollRequest) (*CalculatePayrollReply, error)
+	// ExportBankTransfer builds the salary transfer file for the bank with the
+	// net salary and bank account of every payroll of the month.
+	ExportBankTransfer(context.Context, *ExportBankTransferRequest) (*ExportBankTransferReply, error)
 	ExportPayrollPDF(context.Context, *ExportPayrollPDFRequest) (*ExportPayrollPDFReply, error)
+	ListPayrolls(context.Context, *ListPayrollsRequest) (*ListPayrollsReply, error)
+	DependentReport(context.Context, *DependentReportRequest) (*DependentReportReply, error)
+	ListPendingTimesheets(context.Context, *ListPendingTimesheetsRequest) (*ListPendingTimesheetsReply, error)
 	SendPayslipEmail(context.Context, *SendPayslipEmailRequest) (*SendPayslipEmailReply, error)
 	mustEmbedUnimplementedPayrollServer()
 }
@@ -91,9 +147,21 @@ type UnimplementedPayrollServer struct{}
 func (UnimplementedPayrollServer) CalculatePayroll(context.Context, *CalculatePayrollRequest) (*CalculatePayrollReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method CalculatePayroll not implemented")
 }
+func (UnimplementedPayrollServer) ExportBankTransfer(context.Context, *ExportBankTransferRequest) (*ExportBankTransferReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportBankTransfer not implemented")
+}
 func (UnimplementedPayrollServer) ExportPayrollPDF(context.Context, *ExportPayrollPDFRequest) (*ExportPayrollPDFReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method ExportPayrollPDF not implemented")
 }
+func (UnimplementedPayrollServer) ListPayrolls(context.Context, *ListPayrollsRequest) (*ListPayrollsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPayrolls not implemented")
+}
+func (UnimplementedPayrollServer) DependentReport(context.Context, *DependentReportRequest) (*DependentReportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DependentReport not implemented")
+}
+func (UnimplementedPayrollServer) ListPendingTimesheets(context.Context, *ListPendingTimesheetsRequest) (*ListPendingTimesheetsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPendingTimesheets not implemented")
+}
 func (UnimplementedPayrollServer) SendPayslipEmail(context.Context, *SendPayslipEmailRequest) (*SendPayslipEmailReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendPayslipEmail not implemented")
 }
@@ -136,6 +204,24 @@ func _Payroll_CalculatePayroll_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payroll_ExportBankTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBankTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServer).ExportBankTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payroll_ExportBankTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServer).ExportBankTransfer(ctx, req.(*ExportBankTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Payroll_ExportPayrollPDF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportPayrollPDFRequest)
 	if err := dec(in); err != nil {
@@ -154,6 +240,60 @@ func _Payroll_ExportPayrollPDF_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Payroll_ListPayrolls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayrollsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServer).ListPayrolls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payroll_ListPayrolls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServer).ListPayrolls(ctx, req.(*ListPayrollsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payroll_DependentReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DependentReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServer).DependentReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payroll_DependentReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServer).DependentReport(ctx, req.(*DependentReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Payroll_ListPendingTimesheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingTimesheetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServer).ListPendingTimesheets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Payroll_ListPendingTimesheets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServer).ListPendingTimesheets(ctx, req.(*ListPendingTimesheetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Payroll_SendPayslipEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendPayslipEmailRequest)
 	if err := dec(in); err != nil {
@@ -183,10 +323,26 @@ var Payroll_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalculatePayroll",
 			Handler:    _Payroll_CalculatePayroll_Handler,
 		},
+		{
+			MethodName: "ExportBankTransfer",
+			Handler:    _Payroll_ExportBankTransfer_Handler,
+		},
 		{
 			MethodName: "ExportPayrollPDF",
 			Handler:    _Payroll_ExportPayrollPDF_Handler,
 		},
+		{
+			MethodName: "ListPayrolls",
+			Handler:    _Payroll_ListPayrolls_Handler,
+		},
+		{
+			MethodName: "DependentReport",
+			Handler:    _Payroll_DependentReport_Handler,
+		},
+		{
+			MethodName: "ListPendingTimesheets",
+			Handler:    _Payroll_ListPendingTimesheets_Handler,
+		},
 		{
 			MethodName: "SendPayslipEmail",
 			Handler:    _Payroll_SendPayslipEmail_Handler,
@@ -20,19 +20,33 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationPayrollCalculatePayroll = "/payroll.v1.Payroll/CalculatePayroll"
+const OperationPayrollDependentReport = "/payroll.v1.Payroll/DependentReport"
+const OperationPayrollExportBankTransfer = "/payroll.v1.Payroll/ExportBankTransfer"
 const OperationPayrollExportPayrollPDF = "/payroll.v1.Payroll/ExportPayrollPDF"
+const OperationPayrollListPayrolls = "/payroll.v1.Payroll/ListPayrolls"
+const OperationPayrollListPendingTimesheets = "/payroll.v1.Payroll/ListPendingTimesheets"
 const OperationPayrollSendPayslipEmail = "/payroll.v1.Payroll/SendPayslipEmail"
 
 type PayrollHTTPServer interface {
 	CalculatePayroll(context.Context, *CalculatePayrollRequest) (*CalculatePayrollReply, error)
+	DependentReport(context.Context, *DependentReportRequest) (*DependentReportReply, error)
+	// ExportBankTransfer ExportBankTransfer builds the salary transfer file for the bank with the
+	// net salary and bank account of every payroll of the month.
+	ExportBankTransfer(context.Context, *ExportBankTransferRequest) (*ExportBankTransferReply, error)
 	ExportPayrollPDF(context.Context, *ExportPayrollPDFRequest) (*ExportPayrollPDFReply, error)
+	ListPayrolls(context.Context, *ListPayrollsRequest) (*ListPayrollsReply, error)
+	ListPendingTimesheets(context.Context, *ListPendingTimesheetsRequest) (*ListPendingTimesheetsReply, error)
 	SendPayslipEmail(context.Context, *SendPayslipEmailRequest) (*SendPayslipEmailReply, error)
 }
 
 func RegisterPayrollHTTPServer(s *http.Server, srv PayrollHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/payroll/calculate", _Payroll_CalculatePayroll0_HTTP_Handler(srv))
+	r.GET("/v1/payroll/bank-transfer/{month_year}.csv", _Payroll_ExportBankTransfer0_HTTP_Handler(srv))
 	r.GET("/v1/payroll/{employee_id}/payslip/{month_year}.pdf", _Payroll_ExportPayrollPDF0_HTTP_Handler(srv))
+	r.GET("/v1/payrolls", _Payroll_ListPayrolls0_HTTP_Handler(srv))
+	r.GET("/v1/payroll/dependents-report", _Payroll_DependentReport0_HTTP_Handler(srv))
+	r.GET("/v1/payroll/pending-timesheets", _Payroll_ListPendingTimesheets0_HTTP_Handler(srv))
 	r.POST("/v1/payroll/send-email", _Payroll_SendPayslipEmail0_HTTP_Handler(srv))
 }
 
@@ -58,6 +72,28 @@ func _Payroll_CalculatePayroll0_HTTP_Handler(srv PayrollHTTPServer) func(ctx htt
 	}
 }
 
+func _Payroll_ExportBankTransfer0_HTTP_Handler(srv PayrollHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportBankTransferRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPayrollExportBankTransfer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportBankTransfer(ctx, req.(*ExportBankTransferRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportBankTransferReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Payroll_ExportPayrollPDF0_HTTP_Handler(srv PayrollHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ExportPayrollPDFRequest
@@ -80,6 +116,63 @@ func _Payroll_ExportPayrollPDF0_HTTP_Handler(srv PayrollHTTPServer) func(ctx htt
 	}
 }
 
+func _Payroll_ListPayrolls0_HTTP_Handler(srv PayrollHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPayrollsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPayrollListPayrolls)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPayrolls(ctx, req.(*ListPayrollsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPayrollsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Payroll_DependentReport0_HTTP_Handler(srv PayrollHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DependentReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPayrollDependentReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DependentReport(ctx, req.(*DependentReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DependentReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Payroll_ListPendingTimesheets0_HTTP_Handler(srv PayrollHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPendingTimesheetsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPayrollListPendingTimesheets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPendingTimesheets(ctx, req.(*ListPendingTimesheetsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPendingTimesheetsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Payroll_SendPayslipEmail0_HTTP_Handler(srv PayrollHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SendPayslipEmailRequest
@@ -104,7 +197,11 @@ func _Payroll_SendPayslipEmail0_HTTP_Handler(srv PayrollHTTPServer) func(ctx htt
 
 type PayrollHTTPClient interface {
 	CalculatePayroll(ctx context.Context, req *CalculatePayrollRequest, opts ...http.CallOption) (rsp *CalculatePayrollReply, err error)
+	DependentReport(ctx context.Context, req *DependentReportRequest, opts ...http.CallOption) (rsp *DependentReportReply, err error)
+	ExportBankTransfer(ctx context.Context, req *ExportBankTransferRequest, opts ...http.CallOption) (rsp *ExportBankTransferReply, err error)
 	ExportPayrollPDF(ctx context.Context, req *ExportPayrollPDFRequest, opts ...http.CallOption) (rsp *ExportPayrollPDFReply, err error)
+	ListPayrolls(ctx context.Context, req *ListPayrollsRequest, opts ...http.CallOption) (rsp *ListPayrollsReply, err error)
+	ListPendingTimesheets(ctx context.Context, req *ListPendingTimesheetsRequest, opts ...http.CallOption) (rsp *ListPendingTimesheetsReply, err error)
 	SendPayslipEmail(ctx context.Context, req *SendPayslipEmailRequest, opts ...http.CallOption) (rsp *SendPayslipEmailReply, err error)
 }
 
@@ -129,6 +226,32 @@ func (c *PayrollHTTPClientImpl) CalculatePayroll(ctx context.Context, in *Calcul
 	return &out, nil
 }
 
+func (c *PayrollHTTPClientImpl) DependentReport(ctx context.Context, in *DependentReportRequest, opts ...http.CallOption) (*DependentReportReply, error) {
+	var out DependentReportReply
+	pattern := "/v1/payroll/dependents-report"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPayrollDependentReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PayrollHTTPClientImpl) ExportBankTransfer(ctx context.Context, in *ExportBankTransferRequest, opts ...http.CallOption) (*ExportBankTransferReply, error) {
+	var out ExportBankTransferReply
+	pattern := "/v1/payroll/bank-transfer/{month_year}.csv"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPayrollExportBankTransfer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *PayrollHTTPClientImpl) ExportPayrollPDF(ctx context.Context, in *ExportPayrollPDFRequest, opts ...http.CallOption) (*ExportPayrollPDFReply, error) {
 	var out ExportPayrollPDFReply
 	pattern := "/v1/payroll/{employee_id}/payslip/{month_year}.pdf"
@@ -142,6 +265,32 @@ func (c *PayrollHTTPClientImpl) ExportPayrollPDF(ctx context.Context, in *Export
 	return &out, nil
 }
 
+func (c *PayrollHTTPClientImpl) ListPayrolls(ctx context.Context, in *ListPayrollsRequest, opts ...http.CallOption) (*ListPayrollsReply, error) {
+	var out ListPayrollsReply
+	pattern := "/v1/payrolls"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPayrollListPayrolls))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PayrollHTTPClientImpl) ListPendingTimesheets(ctx context.Context, in *ListPendingTimesheetsRequest, opts ...http.CallOption) (*ListPendingTimesheetsReply, error) {
+	var out ListPendingTimesheetsReply
+	pattern := "/v1/payroll/pending-timesheets"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPayrollListPendingTimesheets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *PayrollHTTPClientImpl) SendPayslipEmail(ctx context.Context, in *SendPayslipEmailRequest, opts ...http.CallOption) (*SendPayslipEmailReply, error) {
 	var out SendPayslipEmailReply
 	pattern := "/v1/payroll/send-email"
@@ -34,6 +34,8 @@ type CreateTimesheetRequest struct {
 	IsLeave       bool                   `protobuf:"varint,5,opt,name=is_leave,json=isLeave,proto3" json:"is_leave,omitempty"`
 	LeaveType     string                 `protobuf:"bytes,6,opt,name=leave_type,json=leaveType,proto3" json:"leave_type,omitempty"`
 	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`    // optional punch-in, used for lateness
+	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"` // optional punch-out
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,9 +119,24 @@ func (x *CreateTimesheetRequest) GetNote() string {
 	return ""
 }
 
+func (x *CreateTimesheetRequest) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *CreateTimesheetRequest) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
 type CreateTimesheetReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"` // overtime limits exceeded in warn mode
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -161,25 +178,1638 @@ func (x *CreateTimesheetReply) GetMessage() string {
 	return ""
 }
 
-var File_api_timesheet_v1_timesheet_proto protoreflect.FileDescriptor
+func (x *CreateTimesheetReply) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
 
-const file_api_timesheet_v1_timesheet_proto_rawDesc = "" +
-	"\n" +
-	" api/timesheet/v1/timesheet.proto\x12\ftimesheet.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8a\x02\n" +
-	"\x16CreateTimesheetRequest\x12\x1f\n" +
-	"\vemployee_id\x18\x01 \x01(\rR\n" +
-	"employeeId\x127\n" +
-	"\twork_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bworkDate\x12!\n" +
-	"\fhours_worked\x18\x03 \x01(\x01R\vhoursWorked\x12%\n" +
-	"\x0eovertime_hours\x18\x04 \x01(\x01R\rovertimeHours\x12\x19\n" +
-	"\bis_leave\x18\x05 \x01(\bR\aisLeave\x12\x1d\n" +
-	"\n" +
-	"leave_type\x18\x06 \x01(\tR\tleaveType\x12\x12\n" +
-	"\x04note\x18\a \x01(\tR\x04note\"0\n" +
-	"\x14CreateTimesheetReply\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2z\n" +
+type UpdateTimesheetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HoursWorked   float64                `protobuf:"fixed64,2,opt,name=hours_worked,json=hoursWorked,proto3" json:"hours_worked,omitempty"`
+	OvertimeHours float64                `protobuf:"fixed64,3,opt,name=overtime_hours,json=overtimeHours,proto3" json:"overtime_hours,omitempty"`
+	IsLeave       bool                   `protobuf:"varint,4,opt,name=is_leave,json=isLeave,proto3" json:"is_leave,omitempty"`
+	LeaveType     string                 `protobuf:"bytes,5,opt,name=leave_type,json=leaveType,proto3" json:"leave_type,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CheckIn       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimesheetRequest) Reset() {
+	*x = UpdateTimesheetRequest{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimesheetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimesheetRequest) ProtoMessage() {}
+
+func (x *UpdateTimesheetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimesheetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTimesheetRequest) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateTimesheetRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTimesheetRequest) GetHoursWorked() float64 {
+	if x != nil {
+		return x.HoursWorked
+	}
+	return 0
+}
+
+func (x *UpdateTimesheetRequest) GetOvertimeHours() float64 {
+	if x != nil {
+		return x.OvertimeHours
+	}
+	return 0
+}
+
+func (x *UpdateTimesheetRequest) GetIsLeave() bool {
+	if x != nil {
+		return x.IsLeave
+	}
+	return false
+}
+
+func (x *UpdateTimesheetRequest) GetLeaveType() string {
+	if x != nil {
+		return x.LeaveType
+	}
+	return ""
+}
+
+func (x *UpdateTimesheetRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *UpdateTimesheetRequest) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *UpdateTimesheetRequest) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+type UpdateTimesheetReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimesheetReply) Reset() {
+	*x = UpdateTimesheetReply{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimesheetReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimesheetReply) ProtoMessage() {}
+
+func (x *UpdateTimesheetReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimesheetReply.ProtoReflect.Descriptor instead.
+func (*UpdateTimesheetReply) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateTimesheetReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateTimesheetReply) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type TimesheetItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId     uint32                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	WorkDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"`
+	HoursWorked    float64                `protobuf:"fixed64,4,opt,name=hours_worked,json=hoursWorked,proto3" json:"hours_worked,omitempty"`
+	OvertimeHours  float64                `protobuf:"fixed64,5,opt,name=overtime_hours,json=overtimeHours,proto3" json:"overtime_hours,omitempty"`
+	IsLeave        bool                   `protobuf:"varint,6,opt,name=is_leave,json=isLeave,proto3" json:"is_leave,omitempty"`
+	LeaveType      string                 `protobuf:"bytes,7,opt,name=leave_type,json=leaveType,proto3" json:"leave_type,omitempty"`
+	Note           string                 `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	ShiftId        uint32                 `protobuf:"varint,9,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	ScheduledHours float64                `protobuf:"fixed64,10,opt,name=scheduled_hours,json=scheduledHours,proto3" json:"scheduled_hours,omitempty"`
+	CheckIn        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	CheckOut       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=check_out,json=checkOut,proto3" json:"check_out,omitempty"`
+	LateMinutes    int32                  `protobuf:"varint,13,opt,name=late_minutes,json=lateMinutes,proto3" json:"late_minutes,omitempty"`
+	Status         string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TimesheetItem) Reset() {
+	*x = TimesheetItem{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimesheetItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimesheetItem) ProtoMessage() {}
+
+func (x *TimesheetItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimesheetItem.ProtoReflect.Descriptor instead.
+func (*TimesheetItem) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TimesheetItem) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimesheetItem) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *TimesheetItem) GetWorkDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WorkDate
+	}
+	return nil
+}
+
+func (x *TimesheetItem) GetHoursWorked() float64 {
+	if x != nil {
+		return x.HoursWorked
+	}
+	return 0
+}
+
+func (x *TimesheetItem) GetOvertimeHours() float64 {
+	if x != nil {
+		return x.OvertimeHours
+	}
+	return 0
+}
+
+func (x *TimesheetItem) GetIsLeave() bool {
+	if x != nil {
+		return x.IsLeave
+	}
+	return false
+}
+
+func (x *TimesheetItem) GetLeaveType() string {
+	if x != nil {
+		return x.LeaveType
+	}
+	return ""
+}
+
+func (x *TimesheetItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TimesheetItem) GetShiftId() uint32 {
+	if x != nil {
+		return x.ShiftId
+	}
+	return 0
+}
+
+func (x *TimesheetItem) GetScheduledHours() float64 {
+	if x != nil {
+		return x.ScheduledHours
+	}
+	return 0
+}
+
+func (x *TimesheetItem) GetCheckIn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *TimesheetItem) GetCheckOut() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckOut
+	}
+	return nil
+}
+
+func (x *TimesheetItem) GetLateMinutes() int32 {
+	if x != nil {
+		return x.LateMinutes
+	}
+	return 0
+}
+
+func (x *TimesheetItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListTimesheetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"` // optional
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                // optional, inclusive
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                    // optional, inclusive
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                            // optional, draft, submitted or approved
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimesheetsRequest) Reset() {
+	*x = ListTimesheetsRequest{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimesheetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimesheetsRequest) ProtoMessage() {}
+
+func (x *ListTimesheetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimesheetsRequest.ProtoReflect.Descriptor instead.
+func (*ListTimesheetsRequest) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTimesheetsRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *ListTimesheetsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListTimesheetsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListTimesheetsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListTimesheetsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTimesheetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTimesheetsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TimesheetItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimesheetsReply) Reset() {
+	*x = ListTimesheetsReply{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimesheetsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimesheetsReply) ProtoMessage() {}
+
+func (x *ListTimesheetsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimesheetsReply.ProtoReflect.Descriptor instead.
+func (*ListTimesheetsReply) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTimesheetsReply) GetItems() []*TimesheetItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTimesheetsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type OvertimeReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonthYear     string                 `protobuf:"bytes,1,opt,name=month_year,json=monthYear,proto3" json:"month_year,omitempty"` // YYYY-MM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OvertimeReportRequest) Reset() {
+	*x = OvertimeReportRequest{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OvertimeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OvertimeReportRequest) ProtoMessage() {}
+
+func (x *OvertimeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OvertimeReportRequest.ProtoReflect.Descriptor instead.
+func (*OvertimeReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OvertimeReportRequest) GetMonthYear() string {
+	if x != nil {
+		return x.MonthYear
+	}
+	return ""
+}
+
+type OvertimeUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	EmployeeName  string                 `protobuf:"bytes,2,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
+	MonthlyHours  float64                `protobuf:"fixed64,3,opt,name=monthly_hours,json=monthlyHours,proto3" json:"monthly_hours,omitempty"`
+	MonthlyLimit  float64                `protobuf:"fixed64,4,opt,name=monthly_limit,json=monthlyLimit,proto3" json:"monthly_limit,omitempty"`
+	YearlyHours   float64                `protobuf:"fixed64,5,opt,name=yearly_hours,json=yearlyHours,proto3" json:"yearly_hours,omitempty"`
+	YearlyLimit   float64                `protobuf:"fixed64,6,opt,name=yearly_limit,json=yearlyLimit,proto3" json:"yearly_limit,omitempty"`
+	Exceeded      bool                   `protobuf:"varint,7,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OvertimeUsage) Reset() {
+	*x = OvertimeUsage{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OvertimeUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OvertimeUsage) ProtoMessage() {}
+
+func (x *OvertimeUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OvertimeUsage.ProtoReflect.Descriptor instead.
+func (*OvertimeUsage) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OvertimeUsage) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *OvertimeUsage) GetEmployeeName() string {
+	if x != nil {
+		return x.EmployeeName
+	}
+	return ""
+}
+
+func (x *OvertimeUsage) GetMonthlyHours() float64 {
+	if x != nil {
+		return x.MonthlyHours
+	}
+	return 0
+}
+
+func (x *OvertimeUsage) GetMonthlyLimit() float64 {
+	if x != nil {
+		return x.MonthlyLimit
+	}
+	return 0
+}
+
+func (x *OvertimeUsage) GetYearlyHours() float64 {
+	if x != nil {
+		return x.YearlyHours
+	}
+	return 0
+}
+
+func (x *OvertimeUsage) GetYearlyLimit() float64 {
+	if x != nil {
+		return x.YearlyLimit
+	}
+	return 0
+}
+
+func (x *OvertimeUsage) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+type OvertimeReportReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*OvertimeUsage       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OvertimeReportReply) Reset() {
+	*x = OvertimeReportReply{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OvertimeReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OvertimeReportReply) ProtoMessage() {}
+
+func (x *OvertimeReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OvertimeReportReply.ProtoReflect.Descriptor instead.
+func (*OvertimeReportReply) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OvertimeReportReply) GetItems() []*OvertimeUsage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type TimesheetPeriodItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId    uint32                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	PeriodType    string                 `protobuf:"bytes,3,opt,name=period_type,json=periodType,proto3" json:"period_type,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ReviewedBy    uint32                 `protobuf:"varint,9,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimesheetPeriodItem) Reset() {
+	*x = TimesheetPeriodItem{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimesheetPeriodItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimesheetPeriodItem) ProtoMessage() {}
+
+func (x *TimesheetPeriodItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimesheetPeriodItem.ProtoReflect.Descriptor instead.
+func (*TimesheetPeriodItem) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TimesheetPeriodItem) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimesheetPeriodItem) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *TimesheetPeriodItem) GetPeriodType() string {
+	if x != nil {
+		return x.PeriodType
+	}
+	return ""
+}
+
+func (x *TimesheetPeriodItem) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *TimesheetPeriodItem) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *TimesheetPeriodItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TimesheetPeriodItem) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *TimesheetPeriodItem) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *TimesheetPeriodItem) GetReviewedBy() uint32 {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return 0
+}
+
+func (x *TimesheetPeriodItem) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+type SubmitPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	PeriodType    string                 `protobuf:"bytes,2,opt,name=period_type,json=periodType,proto3" json:"period_type,omitempty"` // week or month
+	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                               // any day inside the period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitPeriodRequest) Reset() {
+	*x = SubmitPeriodRequest{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPeriodRequest) ProtoMessage() {}
+
+func (x *SubmitPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPeriodRequest.ProtoReflect.Descriptor instead.
+func (*SubmitPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SubmitPeriodRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *SubmitPeriodRequest) GetPeriodType() string {
+	if x != nil {
+		return x.PeriodType
+	}
+	return ""
+}
+
+func (x *SubmitPeriodRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type SubmitPeriodReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *TimesheetPeriodItem   `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitPeriodReply) Reset() {
+	*x = SubmitPeriodReply{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitPeriodReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPeriodReply) ProtoMessage() {}
+
+func (x *SubmitPeriodReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPeriodReply.ProtoReflect.Descriptor instead.
+func (*SubmitPeriodReply) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubmitPeriodReply) GetItem() *TimesheetPeriodItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ReviewPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewPeriodRequest) Reset() {
+	*x = ReviewPeriodRequest{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPeriodRequest) ProtoMessage() {}
+
+func (x *ReviewPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPeriodRequest.ProtoReflect.Descriptor instead.
+func (*ReviewPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReviewPeriodRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewPeriodRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ReviewPeriodReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *TimesheetPeriodItem   `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewPeriodReply) Reset() {
+	*x = ReviewPeriodReply{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewPeriodReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewPeriodReply) ProtoMessage() {}
+
+func (x *ReviewPeriodReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewPeriodReply.ProtoReflect.Descriptor instead.
+func (*ReviewPeriodReply) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReviewPeriodReply) GetItem() *TimesheetPeriodItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ListPeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	EmployeeId    uint32                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	ManagerId     uint32                 `protobuf:"varint,3,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"` // only periods of this manager's reports
+	Indirect      bool                   `protobuf:"varint,4,opt,name=indirect,proto3" json:"indirect,omitempty"`                    // with manager_id, include reports of reports
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeriodsRequest) Reset() {
+	*x = ListPeriodsRequest{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeriodsRequest) ProtoMessage() {}
+
+func (x *ListPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ListPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListPeriodsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPeriodsRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *ListPeriodsRequest) GetManagerId() uint32 {
+	if x != nil {
+		return x.ManagerId
+	}
+	return 0
+}
+
+func (x *ListPeriodsRequest) GetIndirect() bool {
+	if x != nil {
+		return x.Indirect
+	}
+	return false
+}
+
+type ListPeriodsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TimesheetPeriodItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeriodsReply) Reset() {
+	*x = ListPeriodsReply{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeriodsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeriodsReply) ProtoMessage() {}
+
+func (x *ListPeriodsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeriodsReply.ProtoReflect.Descriptor instead.
+func (*ListPeriodsReply) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListPeriodsReply) GetItems() []*TimesheetPeriodItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AnomalyReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonthYear     string                 `protobuf:"bytes,1,opt,name=month_year,json=monthYear,proto3" json:"month_year,omitempty"`     // YYYY-MM
+	EmployeeId    uint32                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"` // optional
+	ManagerId     uint32                 `protobuf:"varint,3,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`    // optional, limits the report to this manager's direct and indirect reports
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyReportRequest) Reset() {
+	*x = AnomalyReportRequest{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyReportRequest) ProtoMessage() {}
+
+func (x *AnomalyReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyReportRequest.ProtoReflect.Descriptor instead.
+func (*AnomalyReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AnomalyReportRequest) GetMonthYear() string {
+	if x != nil {
+		return x.MonthYear
+	}
+	return ""
+}
+
+func (x *AnomalyReportRequest) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *AnomalyReportRequest) GetManagerId() uint32 {
+	if x != nil {
+		return x.ManagerId
+	}
+	return 0
+}
+
+type Anomaly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint32                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	EmployeeName  string                 `protobuf:"bytes,2,opt,name=employee_name,json=employeeName,proto3" json:"employee_name,omitempty"`
+	WorkDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"` // unset for anomalies spanning the period
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Anomaly) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *Anomaly) GetEmployeeName() string {
+	if x != nil {
+		return x.EmployeeName
+	}
+	return ""
+}
+
+func (x *Anomaly) GetWorkDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WorkDate
+	}
+	return nil
+}
+
+func (x *Anomaly) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Anomaly) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type AnomalyReportReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Anomaly             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyReportReply) Reset() {
+	*x = AnomalyReportReply{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyReportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyReportReply) ProtoMessage() {}
+
+func (x *AnomalyReportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyReportReply.ProtoReflect.Descriptor instead.
+func (*AnomalyReportReply) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AnomalyReportReply) GetItems() []*Anomaly {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ImportTimesheetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // csv, xlsx, zkteco (attlog.dat) or punches (employee_id,timestamp CSV)
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTimesheetsRequest) Reset() {
+	*x = ImportTimesheetsRequest{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTimesheetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTimesheetsRequest) ProtoMessage() {}
+
+func (x *ImportTimesheetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTimesheetsRequest.ProtoReflect.Descriptor instead.
+func (*ImportTimesheetsRequest) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportTimesheetsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportTimesheetsRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportTimesheetsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	EmployeeId    uint32                 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	WorkDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=work_date,json=workDate,proto3" json:"work_date,omitempty"`
+	HoursWorked   float64                `protobuf:"fixed64,4,opt,name=hours_worked,json=hoursWorked,proto3" json:"hours_worked,omitempty"`
+	OvertimeHours float64                `protobuf:"fixed64,5,opt,name=overtime_hours,json=overtimeHours,proto3" json:"overtime_hours,omitempty"`
+	IsLeave       bool                   `protobuf:"varint,6,opt,name=is_leave,json=isLeave,proto3" json:"is_leave,omitempty"`
+	Errors        []string               `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	Warnings      []string               `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetEmployeeId() uint32 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetWorkDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WorkDate
+	}
+	return nil
+}
+
+func (x *ImportRowResult) GetHoursWorked() float64 {
+	if x != nil {
+		return x.HoursWorked
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetOvertimeHours() float64 {
+	if x != nil {
+		return x.OvertimeHours
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetIsLeave() bool {
+	if x != nil {
+		return x.IsLeave
+	}
+	return false
+}
+
+func (x *ImportRowResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportRowResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ImportTimesheetsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows     int32                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ValidRows     int32                  `protobuf:"varint,2,opt,name=valid_rows,json=validRows,proto3" json:"valid_rows,omitempty"`
+	ImportedRows  int32                  `protobuf:"varint,3,opt,name=imported_rows,json=importedRows,proto3" json:"imported_rows,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTimesheetsReply) Reset() {
+	*x = ImportTimesheetsReply{}
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTimesheetsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTimesheetsReply) ProtoMessage() {}
+
+func (x *ImportTimesheetsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_timesheet_v1_timesheet_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTimesheetsReply.ProtoReflect.Descriptor instead.
+func (*ImportTimesheetsReply) Descriptor() ([]byte, []int) {
+	return file_api_timesheet_v1_timesheet_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportTimesheetsReply) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportTimesheetsReply) GetValidRows() int32 {
+	if x != nil {
+		return x.ValidRows
+	}
+	return 0
+}
+
+func (x *ImportTimesheetsReply) GetImportedRows() int32 {
+	if x != nil {
+		return x.ImportedRows
+	}
+	return 0
+}
+
+func (x *ImportTimesheetsReply) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTimesheetsReply) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+var File_api_timesheet_v1_timesheet_proto protoreflect.FileDescriptor
+
+const file_api_timesheet_v1_timesheet_proto_rawDesc = "" +
+	"\n" +
+	" api/timesheet/v1/timesheet.proto\x12\ftimesheet.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x02\n" +
+	"\x16CreateTimesheetRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x127\n" +
+	"\twork_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bworkDate\x12!\n" +
+	"\fhours_worked\x18\x03 \x01(\x01R\vhoursWorked\x12%\n" +
+	"\x0eovertime_hours\x18\x04 \x01(\x01R\rovertimeHours\x12\x19\n" +
+	"\bis_leave\x18\x05 \x01(\bR\aisLeave\x12\x1d\n" +
+	"\n" +
+	"leave_type\x18\x06 \x01(\tR\tleaveType\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x125\n" +
+	"\bcheck_in\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\acheckIn\x127\n" +
+	"\tcheck_out\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bcheckOut\"L\n" +
+	"\x14CreateTimesheetReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\xb0\x02\n" +
+	"\x16UpdateTimesheetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12!\n" +
+	"\fhours_worked\x18\x02 \x01(\x01R\vhoursWorked\x12%\n" +
+	"\x0eovertime_hours\x18\x03 \x01(\x01R\rovertimeHours\x12\x19\n" +
+	"\bis_leave\x18\x04 \x01(\bR\aisLeave\x12\x1d\n" +
+	"\n" +
+	"leave_type\x18\x05 \x01(\tR\tleaveType\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x125\n" +
+	"\bcheck_in\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\acheckIn\x127\n" +
+	"\tcheck_out\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bcheckOut\"L\n" +
+	"\x14UpdateTimesheetReply\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\x80\x04\n" +
+	"\rTimesheetItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\rR\n" +
+	"employeeId\x127\n" +
+	"\twork_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bworkDate\x12!\n" +
+	"\fhours_worked\x18\x04 \x01(\x01R\vhoursWorked\x12%\n" +
+	"\x0eovertime_hours\x18\x05 \x01(\x01R\rovertimeHours\x12\x19\n" +
+	"\bis_leave\x18\x06 \x01(\bR\aisLeave\x12\x1d\n" +
+	"\n" +
+	"leave_type\x18\a \x01(\tR\tleaveType\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04note\x12\x19\n" +
+	"\bshift_id\x18\t \x01(\rR\ashiftId\x12'\n" +
+	"\x0fscheduled_hours\x18\n" +
+	" \x01(\x01R\x0escheduledHours\x125\n" +
+	"\bcheck_in\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\acheckIn\x127\n" +
+	"\tcheck_out\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bcheckOut\x12!\n" +
+	"\flate_minutes\x18\r \x01(\x05R\vlateMinutes\x12\x16\n" +
+	"\x06status\x18\x0e \x01(\tR\x06status\"\xe8\x01\n" +
+	"\x15ListTimesheetsRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"p\n" +
+	"\x13ListTimesheetsReply\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.timesheet.v1.TimesheetItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"6\n" +
+	"\x15OvertimeReportRequest\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\x01 \x01(\tR\tmonthYear\"\x81\x02\n" +
+	"\rOvertimeUsage\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12#\n" +
+	"\remployee_name\x18\x02 \x01(\tR\femployeeName\x12#\n" +
+	"\rmonthly_hours\x18\x03 \x01(\x01R\fmonthlyHours\x12#\n" +
+	"\rmonthly_limit\x18\x04 \x01(\x01R\fmonthlyLimit\x12!\n" +
+	"\fyearly_hours\x18\x05 \x01(\x01R\vyearlyHours\x12!\n" +
+	"\fyearly_limit\x18\x06 \x01(\x01R\vyearlyLimit\x12\x1a\n" +
+	"\bexceeded\x18\a \x01(\bR\bexceeded\"H\n" +
+	"\x13OvertimeReportReply\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.timesheet.v1.OvertimeUsageR\x05items\"\xa8\x03\n" +
+	"\x13TimesheetPeriodItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\rR\n" +
+	"employeeId\x12\x1f\n" +
+	"\vperiod_type\x18\x03 \x01(\tR\n" +
+	"periodType\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x18\n" +
+	"\acomment\x18\a \x01(\tR\acomment\x12=\n" +
+	"\fsubmitted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12\x1f\n" +
+	"\vreviewed_by\x18\t \x01(\rR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\"\x87\x01\n" +
+	"\x13SubmitPeriodRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12\x1f\n" +
+	"\vperiod_type\x18\x02 \x01(\tR\n" +
+	"periodType\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"J\n" +
+	"\x11SubmitPeriodReply\x125\n" +
+	"\x04item\x18\x01 \x01(\v2!.timesheet.v1.TimesheetPeriodItemR\x04item\"?\n" +
+	"\x13ReviewPeriodRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\"J\n" +
+	"\x11ReviewPeriodReply\x125\n" +
+	"\x04item\x18\x01 \x01(\v2!.timesheet.v1.TimesheetPeriodItemR\x04item\"\x88\x01\n" +
+	"\x12ListPeriodsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\rR\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x03 \x01(\rR\tmanagerId\x12\x1a\n" +
+	"\bindirect\x18\x04 \x01(\bR\bindirect\"K\n" +
+	"\x10ListPeriodsReply\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.timesheet.v1.TimesheetPeriodItemR\x05items\"u\n" +
+	"\x14AnomalyReportRequest\x12\x1d\n" +
+	"\n" +
+	"month_year\x18\x01 \x01(\tR\tmonthYear\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\rR\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x03 \x01(\rR\tmanagerId\"\xb4\x01\n" +
+	"\aAnomaly\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\rR\n" +
+	"employeeId\x12#\n" +
+	"\remployee_name\x18\x02 \x01(\tR\femployeeName\x127\n" +
+	"\twork_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bworkDate\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\"A\n" +
+	"\x12AnomalyReportReply\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.timesheet.v1.AnomalyR\x05items\"d\n" +
+	"\x17ImportTimesheetsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\x96\x02\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\rR\n" +
+	"employeeId\x127\n" +
+	"\twork_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bworkDate\x12!\n" +
+	"\fhours_worked\x18\x04 \x01(\x01R\vhoursWorked\x12%\n" +
+	"\x0eovertime_hours\x18\x05 \x01(\x01R\rovertimeHours\x12\x19\n" +
+	"\bis_leave\x18\x06 \x01(\bR\aisLeave\x12\x16\n" +
+	"\x06errors\x18\a \x03(\tR\x06errors\x12\x1a\n" +
+	"\bwarnings\x18\b \x03(\tR\bwarnings\"\xc6\x01\n" +
+	"\x15ImportTimesheetsReply\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12\x1d\n" +
+	"\n" +
+	"valid_rows\x18\x02 \x01(\x05R\tvalidRows\x12#\n" +
+	"\rimported_rows\x18\x03 \x01(\x05R\fimportedRows\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x121\n" +
+	"\x04rows\x18\x05 \x03(\v2\x1d.timesheet.v1.ImportRowResultR\x04rows2\xcd\t\n" +
 	"\tTimesheet\x12m\n" +
-	"\x06Create\x12$.timesheet.v1.CreateTimesheetRequest\x1a\".timesheet.v1.CreateTimesheetReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/timesheetsB\x1bZ\x19myapp/api/timesheet/v1;v1b\x06proto3"
+	"\x06Create\x12$.timesheet.v1.CreateTimesheetRequest\x1a\".timesheet.v1.CreateTimesheetReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/timesheets\x12r\n" +
+	"\x06Update\x12$.timesheet.v1.UpdateTimesheetRequest\x1a\".timesheet.v1.UpdateTimesheetReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/timesheets/{id}\x12p\n" +
+	"\x0eListTimesheets\x12#.timesheet.v1.ListTimesheetsRequest\x1a!.timesheet.v1.ListTimesheetsReply\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/timesheets\x12\x80\x01\n" +
+	"\x0eOvertimeReport\x12#.timesheet.v1.OvertimeReportRequest\x1a!.timesheet.v1.OvertimeReportReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/timesheets/overtime-report\x12t\n" +
+	"\fSubmitPeriod\x12!.timesheet.v1.SubmitPeriodRequest\x1a\x1f.timesheet.v1.SubmitPeriodReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/timesheet-periods\x12\x82\x01\n" +
+	"\rApprovePeriod\x12!.timesheet.v1.ReviewPeriodRequest\x1a\x1f.timesheet.v1.ReviewPeriodReply\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/timesheet-periods/{id}/approve\x12\x80\x01\n" +
+	"\fRejectPeriod\x12!.timesheet.v1.ReviewPeriodRequest\x1a\x1f.timesheet.v1.ReviewPeriodReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/timesheet-periods/{id}/reject\x12n\n" +
+	"\vListPeriods\x12 .timesheet.v1.ListPeriodsRequest\x1a\x1e.timesheet.v1.ListPeriodsReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/timesheet-periods\x12w\n" +
+	"\rAnomalyReport\x12\".timesheet.v1.AnomalyReportRequest\x1a .timesheet.v1.AnomalyReportReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/timesheets/anomalies\x12\x80\x01\n" +
+	"\x10ImportTimesheets\x12%.timesheet.v1.ImportTimesheetsRequest\x1a#.timesheet.v1.ImportTimesheetsReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/timesheets/importB\x1bZ\x19myapp/api/timesheet/v1;v1b\x06proto3"
 
 var (
 	file_api_timesheet_v1_timesheet_proto_rawDescOnce sync.Once
@@ -193,21 +1823,83 @@ func file_api_timesheet_v1_timesheet_proto_rawDescGZIP() []byte {
 	return file_api_timesheet_v1_timesheet_proto_rawDescData
 }
 
-var file_api_timesheet_v1_timesheet_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_timesheet_v1_timesheet_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_api_timesheet_v1_timesheet_proto_goTypes = []any{
-	(*CreateTimesheetRequest)(nil), // 0: timesheet.v1.CreateTimesheetRequest
-	(*CreateTimesheetReply)(nil),   // 1: timesheet.v1.CreateTimesheetReply
-	(*timestamppb.Timestamp)(nil),  // 2: google.protobuf.Timestamp
+	(*CreateTimesheetRequest)(nil),  // 0: timesheet.v1.CreateTimesheetRequest
+	(*CreateTimesheetReply)(nil),    // 1: timesheet.v1.CreateTimesheetReply
+	(*UpdateTimesheetRequest)(nil),  // 2: timesheet.v1.UpdateTimesheetRequest
+	(*UpdateTimesheetReply)(nil),    // 3: timesheet.v1.UpdateTimesheetReply
+	(*TimesheetItem)(nil),           // 4: timesheet.v1.TimesheetItem
+	(*ListTimesheetsRequest)(nil),   // 5: timesheet.v1.ListTimesheetsRequest
+	(*ListTimesheetsReply)(nil),     // 6: timesheet.v1.ListTimesheetsReply
+	(*OvertimeReportRequest)(nil),   // 7: timesheet.v1.OvertimeReportRequest
+	(*OvertimeUsage)(nil),           // 8: timesheet.v1.OvertimeUsage
+	(*OvertimeReportReply)(nil),     // 9: timesheet.v1.OvertimeReportReply
+	(*TimesheetPeriodItem)(nil),     // 10: timesheet.v1.TimesheetPeriodItem
+	(*SubmitPeriodRequest)(nil),     // 11: timesheet.v1.SubmitPeriodRequest
+	(*SubmitPeriodReply)(nil),       // 12: timesheet.v1.SubmitPeriodReply
+	(*ReviewPeriodRequest)(nil),     // 13: timesheet.v1.ReviewPeriodRequest
+	(*ReviewPeriodReply)(nil),       // 14: timesheet.v1.ReviewPeriodReply
+	(*ListPeriodsRequest)(nil),      // 15: timesheet.v1.ListPeriodsRequest
+	(*ListPeriodsReply)(nil),        // 16: timesheet.v1.ListPeriodsReply
+	(*AnomalyReportRequest)(nil),    // 17: timesheet.v1.AnomalyReportRequest
+	(*Anomaly)(nil),                 // 18: timesheet.v1.Anomaly
+	(*AnomalyReportReply)(nil),      // 19: timesheet.v1.AnomalyReportReply
+	(*ImportTimesheetsRequest)(nil), // 20: timesheet.v1.ImportTimesheetsRequest
+	(*ImportRowResult)(nil),         // 21: timesheet.v1.ImportRowResult
+	(*ImportTimesheetsReply)(nil),   // 22: timesheet.v1.ImportTimesheetsReply
+	(*timestamppb.Timestamp)(nil),   // 23: google.protobuf.Timestamp
 }
 var file_api_timesheet_v1_timesheet_proto_depIdxs = []int32{
-	2, // 0: timesheet.v1.CreateTimesheetRequest.work_date:type_name -> google.protobuf.Timestamp
-	0, // 1: timesheet.v1.Timesheet.Create:input_type -> timesheet.v1.CreateTimesheetRequest
-	1, // 2: timesheet.v1.Timesheet.Create:output_type -> timesheet.v1.CreateTimesheetReply
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	23, // 0: timesheet.v1.CreateTimesheetRequest.work_date:type_name -> google.protobuf.Timestamp
+	23, // 1: timesheet.v1.CreateTimesheetRequest.check_in:type_name -> google.protobuf.Timestamp
+	23, // 2: timesheet.v1.CreateTimesheetRequest.check_out:type_name -> google.protobuf.Timestamp
+	23, // 3: timesheet.v1.UpdateTimesheetRequest.check_in:type_name -> google.protobuf.Timestamp
+	23, // 4: timesheet.v1.UpdateTimesheetRequest.check_out:type_name -> google.protobuf.Timestamp
+	23, // 5: timesheet.v1.TimesheetItem.work_date:type_name -> google.protobuf.Timestamp
+	23, // 6: timesheet.v1.TimesheetItem.check_in:type_name -> google.protobuf.Timestamp
+	23, // 7: timesheet.v1.TimesheetItem.check_out:type_name -> google.protobuf.Timestamp
+	23, // 8: timesheet.v1.ListTimesheetsRequest.from:type_name -> google.protobuf.Timestamp
+	23, // 9: timesheet.v1.ListTimesheetsRequest.to:type_name -> google.protobuf.Timestamp
+	4,  // 10: timesheet.v1.ListTimesheetsReply.items:type_name -> timesheet.v1.TimesheetItem
+	8,  // 11: timesheet.v1.OvertimeReportReply.items:type_name -> timesheet.v1.OvertimeUsage
+	23, // 12: timesheet.v1.TimesheetPeriodItem.start_date:type_name -> google.protobuf.Timestamp
+	23, // 13: timesheet.v1.TimesheetPeriodItem.end_date:type_name -> google.protobuf.Timestamp
+	23, // 14: timesheet.v1.TimesheetPeriodItem.submitted_at:type_name -> google.protobuf.Timestamp
+	23, // 15: timesheet.v1.TimesheetPeriodItem.reviewed_at:type_name -> google.protobuf.Timestamp
+	23, // 16: timesheet.v1.SubmitPeriodRequest.date:type_name -> google.protobuf.Timestamp
+	10, // 17: timesheet.v1.SubmitPeriodReply.item:type_name -> timesheet.v1.TimesheetPeriodItem
+	10, // 18: timesheet.v1.ReviewPeriodReply.item:type_name -> timesheet.v1.TimesheetPeriodItem
+	10, // 19: timesheet.v1.ListPeriodsReply.items:type_name -> timesheet.v1.TimesheetPeriodItem
+	23, // 20: timesheet.v1.Anomaly.work_date:type_name -> google.protobuf.Timestamp
+	18, // 21: timesheet.v1.AnomalyReportReply.items:type_name -> timesheet.v1.Anomaly
+	23, // 22: timesheet.v1.ImportRowResult.work_date:type_name -> google.protobuf.Timestamp
+	21, // 23: timesheet.v1.ImportTimesheetsReply.rows:type_name -> timesheet.v1.ImportRowResult
+	0,  // 24: timesheet.v1.Timesheet.Create:input_type -> timesheet.v1.CreateTimesheetRequest
+	2,  // 25: timesheet.v1.Timesheet.Update:input_type -> timesheet.v1.UpdateTimesheetRequest
+	5,  // 26: timesheet.v1.Timesheet.ListTimesheets:input_type -> timesheet.v1.ListTimesheetsRequest
+	7,  // 27: timesheet.v1.Timesheet.OvertimeReport:input_type -> timesheet.v1.OvertimeReportRequest
+	11, // 28: timesheet.v1.Timesheet.SubmitPeriod:input_type -> timesheet.v1.SubmitPeriodRequest
+	13, // 29: timesheet.v1.Timesheet.ApprovePeriod:input_type -> timesheet.v1.ReviewPeriodRequest
+	13, // 30: timesheet.v1.Timesheet.RejectPeriod:input_type -> timesheet.v1.ReviewPeriodRequest
+	15, // 31: timesheet.v1.Timesheet.ListPeriods:input_type -> timesheet.v1.ListPeriodsRequest
+	17, // 32: timesheet.v1.Timesheet.AnomalyReport:input_type -> timesheet.v1.AnomalyReportRequest
+	20, // 33: timesheet.v1.Timesheet.ImportTimesheets:input_type -> timesheet.v1.ImportTimesheetsRequest
+	1,  // 34: timesheet.v1.Timesheet.Create:output_type -> timesheet.v1.CreateTimesheetReply
+	3,  // 35: timesheet.v1.Timesheet.Update:output_type -> timesheet.v1.UpdateTimesheetReply
+	6,  // 36: timesheet.v1.Timesheet.ListTimesheets:output_type -> timesheet.v1.ListTimesheetsReply
+	9,  // 37: timesheet.v1.Timesheet.OvertimeReport:output_type -> timesheet.v1.OvertimeReportReply
+	12, // 38: timesheet.v1.Timesheet.SubmitPeriod:output_type -> timesheet.v1.SubmitPeriodReply
+	14, // 39: timesheet.v1.Timesheet.ApprovePeriod:output_type -> timesheet.v1.ReviewPeriodReply
+	14, // 40: timesheet.v1.Timesheet.RejectPeriod:output_type -> timesheet.v1.ReviewPeriodReply
+	16, // 41: timesheet.v1.Timesheet.ListPeriods:output_type -> timesheet.v1.ListPeriodsReply
+	19, // 42: timesheet.v1.Timesheet.AnomalyReport:output_type -> timesheet.v1.AnomalyReportReply
+	22, // 43: timesheet.v1.Timesheet.ImportTimesheets:output_type -> timesheet.v1.ImportTimesheetsReply
+	34, // [34:44] is the sub-list for method output_type
+	24, // [24:34] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_api_timesheet_v1_timesheet_proto_init() }
@@ -221,7 +1913,7 @@ func file_api_timesheet_v1_timesheet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_timesheet_v1_timesheet_proto_rawDesc), len(file_api_timesheet_v1_timesheet_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
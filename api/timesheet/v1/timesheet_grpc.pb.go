@@ -21,7 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Timesheet_Create_FullMethodName = "/timesheet.v1.Timesheet/Create"
+	Timesheet_Create_FullMethodName           = "/timesheet.v1.Timesheet/Create"
+	Timesheet_Update_FullMethodName           = "/timesheet.v1.Timesheet/Update"
+	Timesheet_ListTimesheets_FullMethodName   = "/timesheet.v1.Timesheet/ListTimesheets"
+	Timesheet_OvertimeReport_FullMethodName   = "/timesheet.v1.Timesheet/OvertimeReport"
+	Timesheet_SubmitPeriod_FullMethodName     = "/timesheet.v1.Timesheet/SubmitPeriod"
+	Timesheet_ApprovePeriod_FullMethodName    = "/timesheet.v1.Timesheet/ApprovePeriod"
+	Timesheet_RejectPeriod_FullMethodName     = "/timesheet.v1.Timesheet/RejectPeriod"
+	Timesheet_ListPeriods_FullMethodName      = "/timesheet.v1.Timesheet/ListPeriods"
+	Timesheet_AnomalyReport_FullMethodName    = "/timesheet.v1.Timesheet/AnomalyReport"
+	Timesheet_ImportTimesheets_FullMethodName = "/timesheet.v1.Timesheet/ImportTimesheets"
 )
 
 // TimesheetClient is the client API for Timesheet service.
@@ -29,6 +38,15 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TimesheetClient interface {
 	Create(ctx context.Context, in *CreateTimesheetRequest, opts ...grpc.CallOption) (*CreateTimesheetReply, error)
+	Update(ctx context.Context, in *UpdateTimesheetRequest, opts ...grpc.CallOption) (*UpdateTimesheetReply, error)
+	ListTimesheets(ctx context.Context, in *ListTimesheetsRequest, opts ...grpc.CallOption) (*ListTimesheetsReply, error)
+	OvertimeReport(ctx context.Context, in *OvertimeReportRequest, opts ...grpc.CallOption) (*OvertimeReportReply, error)
+	SubmitPeriod(ctx context.Context, in *SubmitPeriodRequest, opts ...grpc.CallOption) (*SubmitPeriodReply, error)
+	ApprovePeriod(ctx context.Context, in *ReviewPeriodRequest, opts ...grpc.CallOption) (*ReviewPeriodReply, error)
+	RejectPeriod(ctx context.Context, in *ReviewPeriodRequest, opts ...grpc.CallOption) (*ReviewPeriodReply, error)
+	ListPeriods(ctx context.Context, in *ListPeriodsRequest, opts ...grpc.CallOption) (*ListPeriodsReply, error)
+	AnomalyReport(ctx context.Context, in *AnomalyReportRequest, opts ...grpc.CallOption) (*AnomalyReportReply, error)
+	ImportTimesheets(ctx context.Context, in *ImportTimesheetsRequest, opts ...grpc.CallOption) (*ImportTimesheetsReply, error)
 }
 
 type timesheetClient struct {
@@ -49,11 +67,110 @@ func (c *timesheetClient) Create(ctx context.Context, in *CreateTimesheetRequest
 	return out, nil
 }
 
+func (c *timesheetClient) Update(ctx context.Context, in *UpdateTimesheetRequest, opts ...grpc.CallOption) (*UpdateTimesheetReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTimesheetReply)
+	err := c.cc.Invoke(ctx, Timesheet_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetClient) ListTimesheets(ctx context.Context, in *ListTimesheetsRequest, opts ...grpc.CallOption) (*ListTimesheetsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTimesheetsReply)
+	err := c.cc.Invoke(ctx, Timesheet_ListTimesheets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetClient) OvertimeReport(ctx context.Context, in *OvertimeReportRequest, opts ...grpc.CallOption) (*OvertimeReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OvertimeReportReply)
+	err := c.cc.Invoke(ctx, Timesheet_OvertimeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetClient) SubmitPeriod(ctx context.Context, in *SubmitPeriodRequest, opts ...grpc.CallOption) (*SubmitPeriodReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitPeriodReply)
+	err := c.cc.Invoke(ctx, Timesheet_SubmitPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetClient) ApprovePeriod(ctx context.Context, in *ReviewPeriodRequest, opts ...grpc.CallOption) (*ReviewPeriodReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewPeriodReply)
+	err := c.cc.Invoke(ctx, Timesheet_ApprovePeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetClient) RejectPeriod(ctx context.Context, in *ReviewPeriodRequest, opts ...grpc.CallOption) (*ReviewPeriodReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewPeriodReply)
+	err := c.cc.Invoke(ctx, Timesheet_RejectPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetClient) ListPeriods(ctx context.Context, in *ListPeriodsRequest, opts ...grpc.CallOption) (*ListPeriodsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPeriodsReply)
+	err := c.cc.Invoke(ctx, Timesheet_ListPeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetClient) AnomalyReport(ctx context.Context, in *AnomalyReportRequest, opts ...grpc.CallOption) (*AnomalyReportReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnomalyReportReply)
+	err := c.cc.Invoke(ctx, Timesheet_AnomalyReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timesheetClient) ImportTimesheets(ctx context.Context, in *ImportTimesheetsRequest, opts ...grpc.CallOption) (*ImportTimesheetsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportTimesheetsReply)
+	err := c.cc.Invoke(ctx, Timesheet_ImportTimesheets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TimesheetServer is the server API for Timesheet service.
 // All implementations must embed UnimplementedTimesheetServer
 // for forward compatibility.
 type TimesheetServer interface {
 	Create(context.Context, *CreateTimesheetRequest) (*CreateTimesheetReply, error)
+	Update(context.Context, *UpdateTimesheetRequest) (*UpdateTimesheetReply, error)
+	ListTimesheets(context.Context, *ListTimesheetsRequest) (*ListTimesheetsReply, error)
+	OvertimeReport(context.Context, *OvertimeReportRequest) (*OvertimeReportReply, error)
+	SubmitPeriod(context.Context, *SubmitPeriodRequest) (*SubmitPeriodReply, error)
+	ApprovePeriod(context.Context, *ReviewPeriodRequest) (*ReviewPeriodReply, error)
+	RejectPeriod(context.Context, *ReviewPeriodRequest) (*ReviewPeriodReply, error)
+	ListPeriods(context.Context, *ListPeriodsRequest) (*ListPeriodsReply, error)
+	AnomalyReport(context.Context, *AnomalyReportRequest) (*AnomalyReportReply, error)
+	ImportTimesheets(context.Context, *ImportTimesheetsRequest) (*ImportTimesheetsReply, error)
 	mustEmbedUnimplementedTimesheetServer()
 }
 
@@ -67,6 +184,33 @@ type UnimplementedTimesheetServer struct{}
 func (UnimplementedTimesheetServer) Create(context.Context, *CreateTimesheetRequest) (*CreateTimesheetReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedTimesheetServer) Update(context.Context, *UpdateTimesheetRequest) (*UpdateTimesheetReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedTimesheetServer) ListTimesheets(context.Context, *ListTimesheetsRequest) (*ListTimesheetsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTimesheets not implemented")
+}
+func (UnimplementedTimesheetServer) OvertimeReport(context.Context, *OvertimeReportRequest) (*OvertimeReportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method OvertimeReport not implemented")
+}
+func (UnimplementedTimesheetServer) SubmitPeriod(context.Context, *SubmitPeriodRequest) (*SubmitPeriodReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitPeriod not implemented")
+}
+func (UnimplementedTimesheetServer) ApprovePeriod(context.Context, *ReviewPeriodRequest) (*ReviewPeriodReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApprovePeriod not implemented")
+}
+func (UnimplementedTimesheetServer) RejectPeriod(context.Context, *ReviewPeriodRequest) (*ReviewPeriodReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectPeriod not implemented")
+}
+func (UnimplementedTimesheetServer) ListPeriods(context.Context, *ListPeriodsRequest) (*ListPeriodsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPeriods not implemented")
+}
+func (UnimplementedTimesheetServer) AnomalyReport(context.Context, *AnomalyReportRequest) (*AnomalyReportReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnomalyReport not implemented")
+}
+func (UnimplementedTimesheetServer) ImportTimesheets(context.Context, *ImportTimesheetsRequest) (*ImportTimesheetsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportTimesheets not implemented")
+}
 func (UnimplementedTimesheetServer) mustEmbedUnimplementedTimesheetServer() {}
 func (UnimplementedTimesheetServer) testEmbeddedByValue()                   {}
 
@@ -106,6 +250,168 @@ func _Timesheet_Create_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Timesheet_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTimesheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Timesheet_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetServer).Update(ctx, req.(*UpdateTimesheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Timesheet_ListTimesheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTimesheetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetServer).ListTimesheets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Timesheet_ListTimesheets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetServer).ListTimesheets(ctx, req.(*ListTimesheetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Timesheet_OvertimeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OvertimeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetServer).OvertimeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Timesheet_OvertimeReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetServer).OvertimeReport(ctx, req.(*OvertimeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Timesheet_SubmitPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetServer).SubmitPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Timesheet_SubmitPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetServer).SubmitPeriod(ctx, req.(*SubmitPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Timesheet_ApprovePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetServer).ApprovePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Timesheet_ApprovePeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetServer).ApprovePeriod(ctx, req.(*ReviewPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Timesheet_RejectPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetServer).RejectPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Timesheet_RejectPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetServer).RejectPeriod(ctx, req.(*ReviewPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Timesheet_ListPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetServer).ListPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Timesheet_ListPeriods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetServer).ListPeriods(ctx, req.(*ListPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Timesheet_AnomalyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnomalyReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetServer).AnomalyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Timesheet_AnomalyReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetServer).AnomalyReport(ctx, req.(*AnomalyReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Timesheet_ImportTimesheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTimesheetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetServer).ImportTimesheets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Timesheet_ImportTimesheets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetServer).ImportTimesheets(ctx, req.(*ImportTimesheetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Timesheet_ServiceDesc is the grpc.ServiceDesc for Timesheet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,6 +423,42 @@ var Timesheet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _Timesheet_Create_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _Timesheet_Update_Handler,
+		},
+		{
+			MethodName: "ListTimesheets",
+			Handler:    _Timesheet_ListTimesheets_Handler,
+		},
+		{
+			MethodName: "OvertimeReport",
+			Handler:    _Timesheet_OvertimeReport_Handler,
+		},
+		{
+			MethodName: "SubmitPeriod",
+			Handler:    _Timesheet_SubmitPeriod_Handler,
+		},
+		{
+			MethodName: "ApprovePeriod",
+			Handler:    _Timesheet_ApprovePeriod_Handler,
+		},
+		{
+			MethodName: "RejectPeriod",
+			Handler:    _Timesheet_RejectPeriod_Handler,
+		},
+		{
+			MethodName: "ListPeriods",
+			Handler:    _Timesheet_ListPeriods_Handler,
+		},
+		{
+			MethodName: "AnomalyReport",
+			Handler:    _Timesheet_AnomalyReport_Handler,
+		},
+		{
+			MethodName: "ImportTimesheets",
+			Handler:    _Timesheet_ImportTimesheets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/timesheet/v1/timesheet.proto",
@@ -19,15 +19,42 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationTimesheetAnomalyReport = "/timesheet.v1.Timesheet/AnomalyReport"
+const OperationTimesheetApprovePeriod = "/timesheet.v1.Timesheet/ApprovePeriod"
 const OperationTimesheetCreate = "/timesheet.v1.Timesheet/Create"
+const OperationTimesheetImportTimesheets = "/timesheet.v1.Timesheet/ImportTimesheets"
+const OperationTimesheetListPeriods = "/timesheet.v1.Timesheet/ListPeriods"
+const OperationTimesheetListTimesheets = "/timesheet.v1.Timesheet/ListTimesheets"
+const OperationTimesheetOvertimeReport = "/timesheet.v1.Timesheet/OvertimeReport"
+const OperationTimesheetRejectPeriod = "/timesheet.v1.Timesheet/RejectPeriod"
+const OperationTimesheetSubmitPeriod = "/timesheet.v1.Timesheet/SubmitPeriod"
+const OperationTimesheetUpdate = "/timesheet.v1.Timesheet/Update"
 
 type TimesheetHTTPServer interface {
+	AnomalyReport(context.Context, *AnomalyReportRequest) (*AnomalyReportReply, error)
+	ApprovePeriod(context.Context, *ReviewPeriodRequest) (*ReviewPeriodReply, error)
 	Create(context.Context, *CreateTimesheetRequest) (*CreateTimesheetReply, error)
+	ImportTimesheets(context.Context, *ImportTimesheetsRequest) (*ImportTimesheetsReply, error)
+	ListPeriods(context.Context, *ListPeriodsRequest) (*ListPeriodsReply, error)
+	ListTimesheets(context.Context, *ListTimesheetsRequest) (*ListTimesheetsReply, error)
+	OvertimeReport(context.Context, *OvertimeReportRequest) (*OvertimeReportReply, error)
+	RejectPeriod(context.Context, *ReviewPeriodRequest) (*ReviewPeriodReply, error)
+	SubmitPeriod(context.Context, *SubmitPeriodRequest) (*SubmitPeriodReply, error)
+	Update(context.Context, *UpdateTimesheetRequest) (*UpdateTimesheetReply, error)
 }
 
 func RegisterTimesheetHTTPServer(s *http.Server, srv TimesheetHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/timesheets", _Timesheet_Create0_HTTP_Handler(srv))
+	r.PUT("/v1/timesheets/{id}", _Timesheet_Update0_HTTP_Handler(srv))
+	r.GET("/v1/timesheets", _Timesheet_ListTimesheets0_HTTP_Handler(srv))
+	r.GET("/v1/timesheets/overtime-report", _Timesheet_OvertimeReport0_HTTP_Handler(srv))
+	r.POST("/v1/timesheet-periods", _Timesheet_SubmitPeriod0_HTTP_Handler(srv))
+	r.POST("/v1/timesheet-periods/{id}/approve", _Timesheet_ApprovePeriod0_HTTP_Handler(srv))
+	r.POST("/v1/timesheet-periods/{id}/reject", _Timesheet_RejectPeriod0_HTTP_Handler(srv))
+	r.GET("/v1/timesheet-periods", _Timesheet_ListPeriods0_HTTP_Handler(srv))
+	r.GET("/v1/timesheets/anomalies", _Timesheet_AnomalyReport0_HTTP_Handler(srv))
+	r.POST("/v1/timesheets/import", _Timesheet_ImportTimesheets0_HTTP_Handler(srv))
 }
 
 func _Timesheet_Create0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
@@ -52,8 +79,212 @@ func _Timesheet_Create0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Cont
 	}
 }
 
+func _Timesheet_Update0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateTimesheetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTimesheetUpdate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Update(ctx, req.(*UpdateTimesheetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateTimesheetReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Timesheet_ListTimesheets0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTimesheetsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTimesheetListTimesheets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTimesheets(ctx, req.(*ListTimesheetsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTimesheetsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Timesheet_OvertimeReport0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OvertimeReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTimesheetOvertimeReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OvertimeReport(ctx, req.(*OvertimeReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OvertimeReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Timesheet_SubmitPeriod0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubmitPeriodRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTimesheetSubmitPeriod)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubmitPeriod(ctx, req.(*SubmitPeriodRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SubmitPeriodReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Timesheet_ApprovePeriod0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReviewPeriodRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTimesheetApprovePeriod)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApprovePeriod(ctx, req.(*ReviewPeriodRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReviewPeriodReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Timesheet_RejectPeriod0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReviewPeriodRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTimesheetRejectPeriod)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectPeriod(ctx, req.(*ReviewPeriodRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReviewPeriodReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Timesheet_ListPeriods0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPeriodsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTimesheetListPeriods)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPeriods(ctx, req.(*ListPeriodsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPeriodsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Timesheet_AnomalyReport0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AnomalyReportRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTimesheetAnomalyReport)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AnomalyReport(ctx, req.(*AnomalyReportRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AnomalyReportReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Timesheet_ImportTimesheets0_HTTP_Handler(srv TimesheetHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportTimesheetsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTimesheetImportTimesheets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportTimesheets(ctx, req.(*ImportTimesheetsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportTimesheetsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type TimesheetHTTPClient interface {
+	AnomalyReport(ctx context.Context, req *AnomalyReportRequest, opts ...http.CallOption) (rsp *AnomalyReportReply, err error)
+	ApprovePeriod(ctx context.Context, req *ReviewPeriodRequest, opts ...http.CallOption) (rsp *ReviewPeriodReply, err error)
 	Create(ctx context.Context, req *CreateTimesheetRequest, opts ...http.CallOption) (rsp *CreateTimesheetReply, err error)
+	ImportTimesheets(ctx context.Context, req *ImportTimesheetsRequest, opts ...http.CallOption) (rsp *ImportTimesheetsReply, err error)
+	ListPeriods(ctx context.Context, req *ListPeriodsRequest, opts ...http.CallOption) (rsp *ListPeriodsReply, err error)
+	ListTimesheets(ctx context.Context, req *ListTimesheetsRequest, opts ...http.CallOption) (rsp *ListTimesheetsReply, err error)
+	OvertimeReport(ctx context.Context, req *OvertimeReportRequest, opts ...http.CallOption) (rsp *OvertimeReportReply, err error)
+	RejectPeriod(ctx context.Context, req *ReviewPeriodRequest, opts ...http.CallOption) (rsp *ReviewPeriodReply, err error)
+	SubmitPeriod(ctx context.Context, req *SubmitPeriodRequest, opts ...http.CallOption) (rsp *SubmitPeriodReply, err error)
+	Update(ctx context.Context, req *UpdateTimesheetRequest, opts ...http.CallOption) (rsp *UpdateTimesheetReply, err error)
 }
 
 type TimesheetHTTPClientImpl struct {
@@ -64,6 +295,32 @@ func NewTimesheetHTTPClient(client *http.Client) TimesheetHTTPClient {
 	return &TimesheetHTTPClientImpl{client}
 }
 
+func (c *TimesheetHTTPClientImpl) AnomalyReport(ctx context.Context, in *AnomalyReportRequest, opts ...http.CallOption) (*AnomalyReportReply, error) {
+	var out AnomalyReportReply
+	pattern := "/v1/timesheets/anomalies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTimesheetAnomalyReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TimesheetHTTPClientImpl) ApprovePeriod(ctx context.Context, in *ReviewPeriodRequest, opts ...http.CallOption) (*ReviewPeriodReply, error) {
+	var out ReviewPeriodReply
+	pattern := "/v1/timesheet-periods/{id}/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTimesheetApprovePeriod))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *TimesheetHTTPClientImpl) Create(ctx context.Context, in *CreateTimesheetRequest, opts ...http.CallOption) (*CreateTimesheetReply, error) {
 	var out CreateTimesheetReply
 	pattern := "/v1/timesheets"
@@ -76,3 +333,94 @@ func (c *TimesheetHTTPClientImpl) Create(ctx context.Context, in *CreateTimeshee
 	}
 	return &out, nil
 }
+
+func (c *TimesheetHTTPClientImpl) ImportTimesheets(ctx context.Context, in *ImportTimesheetsRequest, opts ...http.CallOption) (*ImportTimesheetsReply, error) {
+	var out ImportTimesheetsReply
+	pattern := "/v1/timesheets/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTimesheetImportTimesheets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TimesheetHTTPClientImpl) ListPeriods(ctx context.Context, in *ListPeriodsRequest, opts ...http.CallOption) (*ListPeriodsReply, error) {
+	var out ListPeriodsReply
+	pattern := "/v1/timesheet-periods"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTimesheetListPeriods))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TimesheetHTTPClientImpl) ListTimesheets(ctx context.Context, in *ListTimesheetsRequest, opts ...http.CallOption) (*ListTimesheetsReply, error) {
+	var out ListTimesheetsReply
+	pattern := "/v1/timesheets"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTimesheetListTimesheets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TimesheetHTTPClientImpl) OvertimeReport(ctx context.Context, in *OvertimeReportRequest, opts ...http.CallOption) (*OvertimeReportReply, error) {
+	var out OvertimeReportReply
+	pattern := "/v1/timesheets/overtime-report"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTimesheetOvertimeReport))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TimesheetHTTPClientImpl) RejectPeriod(ctx context.Context, in *ReviewPeriodRequest, opts ...http.CallOption) (*ReviewPeriodReply, error) {
+	var out ReviewPeriodReply
+	pattern := "/v1/timesheet-periods/{id}/reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTimesheetRejectPeriod))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TimesheetHTTPClientImpl) SubmitPeriod(ctx context.Context, in *SubmitPeriodRequest, opts ...http.CallOption) (*SubmitPeriodReply, error) {
+	var out SubmitPeriodReply
+	pattern := "/v1/timesheet-periods"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTimesheetSubmitPeriod))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *TimesheetHTTPClientImpl) Update(ctx context.Context, in *UpdateTimesheetRequest, opts ...http.CallOption) (*UpdateTimesheetReply, error) {
+	var out UpdateTimesheetReply
+	pattern := "/v1/timesheets/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTimesheetUpdate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -1,22 +1,32 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
 	"time"
 
-	authv1     "myapp/api/auth/v1"
+	authv1 "myapp/api/auth/v1"
+	documentv1 "myapp/api/document/v1"
 	employeev1 "myapp/api/employee/v1"
-	payrollv1  "myapp/api/payroll/v1"
+	mev1 "myapp/api/me/v1"
+	organizationv1 "myapp/api/organization/v1"
+	payrollv1 "myapp/api/payroll/v1"
+	schedulev1 "myapp/api/schedule/v1"
 	timesheetv1 "myapp/api/timesheet/v1"
 
 	"myapp/internal/biz"
+	"myapp/internal/blob"
+	"myapp/internal/clientip"
 	"myapp/internal/conf"
 	"myapp/internal/data"
+	"myapp/internal/oidc"
+	"myapp/internal/password"
 	"myapp/internal/repository"
 	"myapp/internal/server"
 	"myapp/internal/service"
+	"myapp/internal/signing"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -63,6 +73,19 @@ func main() {
 	}
 	defer cleanup()
 
+	// Access token signing keys
+	signingConf := bc.Auth.GetSigning()
+	var signingKeys *signing.Keyring
+	if len(signingConf.GetKeys()) == 0 {
+		log.NewHelper(logger).Warn("no auth.signing keys configured; using a throwaway key, tokens will not survive a restart")
+		signingKeys, err = signing.NewEphemeralKeyring()
+	} else {
+		signingKeys, err = signing.NewKeyring(signingConf.GetActiveKey(), signingConf.GetKeys())
+	}
+	if err != nil {
+		panic(fmt.Errorf("failed to load signing keys: %w", err))
+	}
+
 	// Initialize Redis
 	redisClient := redis.NewClient(&redis.Options{
 		Addr:     bc.Data.Redis.Addr,
@@ -70,12 +93,49 @@ func main() {
 		DB:       int(bc.Data.Redis.GetDb()),
 	})
 	redisRepo := repository.NewRedisRepo(redisClient)
+	sessionRepo := repository.NewSessionRepo(redisRepo)
+	authTokenRepo := repository.NewAuthTokenRepo(redisRepo)
+	loginAttemptRepo := repository.NewLoginAttemptRepo(redisRepo)
+	oidcStateRepo := repository.NewOIDCStateRepo(redisRepo)
+
+	// Document storage
+	var store blob.Store
+	switch storage := bc.Data.GetStorage(); storage.GetDriver() {
+	case "s3":
+		s3 := storage.GetS3()
+		store, err = blob.NewS3Store(context.Background(), s3.GetEndpoint(), s3.GetAccessKey(), s3.GetSecretKey(), s3.GetBucket(), s3.GetRegion(), s3.GetUseSsl())
+	case "", "local":
+		dir := storage.GetLocalDir()
+		if dir == "" {
+			dir = "./data/documents"
+		}
+		store, err = blob.NewLocalStore(dir)
+	default:
+		err = fmt.Errorf("unknown storage driver %q", storage.GetDriver())
+	}
+	if err != nil {
+		panic(fmt.Errorf("failed to initialize document storage: %w", err))
+	}
 
 	// Repositories
 	employeeRepo := repository.NewEmployeeRepo(d)
 	payrollRepo := repository.NewPayrollRepo(d)
 	timesheetRepo := repository.NewTimesheetRepo(d)
+	scheduleRepo := repository.NewScheduleRepo(d)
+	timesheetPeriodRepo := repository.NewTimesheetPeriodRepo(d)
+	organizationRepo := repository.NewOrganizationRepo(d)
+	contractRepo := repository.NewContractRepo(d)
+	dependentRepo := repository.NewDependentRepo(d)
+	piiRepo := repository.NewPIIRepo(d)
+	documentRepo := repository.NewDocumentRepo(d)
+	customFieldRepo := repository.NewCustomFieldRepo(d)
+	invitationRepo := repository.NewInvitationRepo(d)
+	authEventRepo := repository.NewAuthEventRepo(d)
+	recoveryCodeRepo := repository.NewRecoveryCodeRepo(d)
+	passwordHistoryRepo := repository.NewPasswordHistoryRepo(d)
 	userRepo := repository.NewUserRepo(d)
+	externalIdentityRepo := repository.NewExternalIdentityRepo(d)
+	serviceAccountRepo := repository.NewServiceAccountRepo(d)
 	emailRepo := repository.NewEmailRepo(
 		bc.Data.Email.Host,
 		int(bc.Data.Email.Port),
@@ -86,28 +146,138 @@ func main() {
 	)
 
 	// Usecases (Biz layer)
-	employeeUsecase := biz.NewEmployeeUsecase(employeeRepo)
-	payrollUsecase := biz.NewPayrollUsecase(payrollRepo, employeeRepo, timesheetRepo, emailRepo)
-	timesheetUsecase := biz.NewTimesheetUsecase(timesheetRepo)
+	piiPolicy := biz.NewPIIPolicy(bc.Auth.GetPiiViewers())
+	employeeUsecase := biz.NewEmployeeUsecase(employeeRepo, organizationRepo, piiRepo, customFieldRepo, piiPolicy, bc.Data.GetEncryption().GetActiveKey())
+	employmentUsecase := biz.NewEmploymentUsecase(contractRepo, employeeRepo, timesheetRepo, dependentRepo)
+	payrollUsecase := biz.NewPayrollUsecase(payrollRepo, employeeRepo, timesheetRepo, organizationRepo, contractRepo, dependentRepo, emailRepo, piiPolicy)
+	// A misspelled mode must not quietly leave the caps unenforced.
+	overtimeMode := bc.Overtime.GetMode()
+	if overtimeMode != "warn" && overtimeMode != "reject" {
+		panic(fmt.Errorf("overtime mode must be \"warn\" or \"reject\", got %q", overtimeMode))
+	}
+	timesheetUsecase := biz.NewTimesheetUsecase(timesheetRepo, scheduleRepo, employeeRepo, timesheetPeriodRepo, organizationRepo, biz.OvertimePolicy{
+		DailyLimit:   bc.Overtime.GetDailyLimit(),
+		MonthlyLimit: bc.Overtime.GetMonthlyLimit(),
+		YearlyLimit:  bc.Overtime.GetYearlyLimit(),
+		Reject:       overtimeMode == "reject",
+		WarnRatio:    bc.Overtime.GetWarnRatio(),
+	})
+	scheduleUsecase := biz.NewScheduleUsecase(scheduleRepo, employeeRepo)
+	organizationUsecase := biz.NewOrganizationUsecase(organizationRepo, employeeRepo)
+	documentUsecase := biz.NewDocumentUsecase(documentRepo, employeeRepo, store, piiPolicy)
+	accessPolicy := biz.NewAccessPolicy(employeeRepo, timesheetRepo, timesheetPeriodRepo, documentRepo, organizationRepo)
+	passwordConf := bc.Auth.GetPassword()
+	hasher, err := password.NewHasher(passwordConf.GetHasher(), int(passwordConf.GetBcryptCost()), password.Argon2Params{
+		Memory:      passwordConf.GetArgon2Memory(),
+		Iterations:  passwordConf.GetArgon2Iterations(),
+		Parallelism: uint8(passwordConf.GetArgon2Parallelism()),
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to configure password hashing: %w", err))
+	}
+	passwordRules := &password.Policy{
+		MinLength:     int(passwordConf.GetMinLength()),
+		MaxLength:     int(passwordConf.GetMaxLength()),
+		RequireUpper:  passwordConf.GetRequireUpper(),
+		RequireLower:  passwordConf.GetRequireLower(),
+		RequireDigit:  passwordConf.GetRequireDigit(),
+		RequireSymbol: passwordConf.GetRequireSymbol(),
+	}
+	if file := passwordConf.GetBreachedListFile(); file != "" {
+		if err := passwordRules.LoadBreached(file); err != nil {
+			panic(err)
+		}
+	}
+	lockout := bc.Auth.GetLockout()
+	loginGuard := biz.NewLoginGuard(loginAttemptRepo, authEventRepo, hasher, biz.LockoutPolicy{
+		MaxAttempts:     int(lockout.GetMaxAttempts()),
+		Window:          time.Duration(lockout.GetWindow()) * time.Minute,
+		LockDuration:    time.Duration(lockout.GetLockDuration()) * time.Minute,
+		MaxLockDuration: time.Duration(lockout.GetMaxLockDuration()) * time.Minute,
+		IPMaxAttempts:   int(lockout.GetIpMaxAttempts()),
+	})
+	oidcConf := bc.Auth.GetOidc()
+	oidcPolicy := biz.OIDCPolicy{
+		RoleClaim:     oidcConf.GetRoleClaim(),
+		RoleMapping:   oidcConf.GetRoleMapping(),
+		DefaultRole:   oidcConf.GetDefaultRole(),
+		SyncRole:      oidcConf.GetSyncRole(),
+		TrustEmail:    oidcConf.GetTrustEmail(),
+		SkipTwoFactor: oidcConf.GetSkipTwoFactor(),
+	}
+	if oidcConf.GetIssuer() != "" {
+		provider, err := oidc.NewProvider(oidc.Config{
+			Issuer:       oidcConf.GetIssuer(),
+			ClientID:     oidcConf.GetClientId(),
+			ClientSecret: oidcConf.GetClientSecret(),
+			RedirectURL:  oidcConf.GetRedirectUrl(),
+			Scopes:       oidcConf.GetScopes(),
+		})
+		if err != nil {
+			panic(err)
+		}
+		for value, role := range oidcPolicy.RoleMapping {
+			if !biz.ValidRole(role) {
+				panic(fmt.Errorf("oidc role_mapping: %q maps to unknown role %q", value, role))
+			}
+		}
+		if role := oidcPolicy.DefaultRole; role != "" && !biz.ValidRole(role) {
+			panic(fmt.Errorf("oidc default_role: unknown role %q", role))
+		}
+		oidcPolicy.Provider = provider
+	}
 	authUsecase := biz.NewAuthUsecase(
 		userRepo,
-		redisRepo,
-		bc.Auth.GetJwtSecret(),
+		sessionRepo,
+		authTokenRepo,
+		loginGuard,
+		hasher,
+		biz.PasswordPolicy{Rules: passwordRules, History: int(passwordConf.GetHistory())},
+		passwordHistoryRepo,
+		recoveryCodeRepo,
+		biz.TwoFactorPolicy{
+			Issuer:        bc.Auth.GetTwoFactor().GetIssuer(),
+			RequiredRoles: bc.Auth.GetTwoFactor().GetRequiredRoles(),
+		},
+		oidcPolicy,
+		externalIdentityRepo,
+		oidcStateRepo,
+		signingKeys,
 		int(bc.Auth.GetTokenExp()),
+		int(bc.Auth.GetRefreshTokenExp()),
+		bc.Auth.GetAdmins(),
+		invitationRepo,
+		employeeRepo,
+		emailRepo,
+		bc.Auth.GetInvitationUrl(),
+		bc.Auth.GetVerificationUrl(),
+		bc.Auth.GetPasswordResetUrl(),
 	)
 
 	// Services
-	employeeService := service.NewEmployeeService(employeeUsecase)
+	employeeService := service.NewEmployeeService(employeeUsecase, employmentUsecase)
 	payrollService := service.NewPayrollService(payrollUsecase)
 	timesheetService := service.NewTimesheetService(timesheetUsecase)
-	authService := service.NewAuthService(authUsecase)
+	scheduleService := service.NewScheduleService(scheduleUsecase)
+	organizationService := service.NewOrganizationService(organizationUsecase)
+	documentService := service.NewDocumentService(documentUsecase)
+	serviceAccountUsecase := biz.NewServiceAccountUsecase(serviceAccountRepo)
+	authService := service.NewAuthService(authUsecase, serviceAccountUsecase)
+	meService := service.NewMeService(employeeUsecase, employmentUsecase, timesheetUsecase, payrollUsecase)
+
+	clientIPs, err := clientip.NewResolver(bc.Server.Http.GetTrustedProxies())
+	if err != nil {
+		panic(err)
+	}
 
 	httpSrv := http.NewServer(
 		http.Address(bc.Server.Http.Addr),
 		http.Timeout(time.Duration(bc.Server.Http.Timeout)*time.Second),
 		http.Middleware(
 			recovery.Recovery(),
-			server.AuthMiddleware(bc.Auth.GetJwtSecret(), redisRepo),
+			server.ClientIP(clientIPs),
+			server.AuthMiddleware(signingKeys, sessionRepo, serviceAccountUsecase),
+			server.Authorization(accessPolicy),
 		),
 	)
 
@@ -115,6 +285,11 @@ func main() {
 	employeev1.RegisterEmployeeHTTPServer(httpSrv, employeeService)
 	payrollv1.RegisterPayrollHTTPServer(httpSrv, payrollService)
 	timesheetv1.RegisterTimesheetHTTPServer(httpSrv, timesheetService)
+	schedulev1.RegisterScheduleHTTPServer(httpSrv, scheduleService)
+	organizationv1.RegisterOrganizationHTTPServer(httpSrv, organizationService)
+	documentv1.RegisterDocumentHTTPServer(httpSrv, documentService)
+	mev1.RegisterMeHTTPServer(httpSrv, meService)
+	httpSrv.HandleFunc("/.well-known/jwks.json", server.JWKSHandler(signingKeys))
 
 	// Kratos application
 	app := kratos.New(
@@ -128,4 +303,4 @@ func main() {
 		log.Error(err)
 		os.Exit(1)
 	}
-}
\ No newline at end of file
+}
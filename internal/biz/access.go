@@ -0,0 +1,182 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+)
+
+// Permissions guard groups of RPC operations. Roles grant sets of them; the
+// mapping of operations to permissions lives with the HTTP server.
+const (
+	PermEmployeesRead     = "employees.read"
+	PermEmployeesWrite    = "employees.write"
+	PermRotateKeys        = "keys.rotate"
+	PermOrgRead           = "org.read"
+	PermOrgWrite          = "org.write"
+	PermSchedulesRead     = "schedules.read"
+	PermSchedulesWrite    = "schedules.write"
+	PermTimesheetsRead    = "timesheets.read"
+	PermTimesheetsWrite   = "timesheets.write"
+	PermTimesheetsApprove = "timesheets.approve"
+	PermPayrollRead       = "payroll.read"
+	PermPayrollRun        = "payroll.run"
+	PermDocumentsRead     = "documents.read"
+	PermDocumentsWrite    = "documents.write"
+	PermUsersManage       = "users.manage"
+	PermUsersInvite       = "users.invite"
+	PermSelfService       = "self.read"
+	PermViewPII           = "pii.view"
+)
+
+var rolePermissions = map[string][]string{
+	model.RoleHR: {
+		PermEmployeesRead, PermEmployeesWrite, PermOrgRead, PermOrgWrite,
+		PermSchedulesRead, PermSchedulesWrite, PermTimesheetsRead, PermTimesheetsWrite,
+		PermTimesheetsApprove, PermPayrollRead, PermDocumentsRead, PermDocumentsWrite,
+		PermViewPII, PermUsersInvite, PermSelfService,
+	},
+	model.RolePayroll: {
+		PermEmployeesRead, PermOrgRead, PermSchedulesRead, PermTimesheetsRead,
+		PermPayrollRead, PermPayrollRun, PermDocumentsRead, PermViewPII, PermSelfService,
+	},
+	model.RoleManager: {
+		PermEmployeesRead, PermOrgRead, PermSchedulesRead, PermTimesheetsRead,
+		PermTimesheetsWrite, PermTimesheetsApprove, PermDocumentsRead, PermSelfService,
+	},
+	model.RoleEmployee: {
+		PermEmployeesRead, PermOrgRead, PermSchedulesRead, PermTimesheetsRead,
+		PermTimesheetsWrite, PermPayrollRead, PermDocumentsRead, PermSelfService,
+	},
+}
+
+// ValidScope reports whether perm can be granted to an API key: any
+// permission of a non-admin role except self-service, which needs a linked
+// employee. Admin-only permissions stay with people.
+func ValidScope(perm string) bool {
+	if perm == PermSelfService {
+		return false
+	}
+	for _, perms := range rolePermissions {
+		for _, p := range perms {
+			if p == perm {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func ValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok || role == model.RoleAdmin
+}
+
+// HasPermission reports whether role grants perm. Admins hold every
+// permission.
+func HasPermission(role, perm string) bool {
+	if role == model.RoleAdmin {
+		return true
+	}
+	for _, p := range rolePermissions[role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// ScopedRole reports whether role is limited to the caller's own records and,
+// for managers, those of their reports.
+func ScopedRole(role string) bool {
+	return role == model.RoleManager || role == model.RoleEmployee
+}
+
+// AccessPolicy answers which employee records a caller may act on.
+type AccessPolicy struct {
+	employeeRepo  repository.EmployeeRepo
+	timesheetRepo repository.TimesheetRepo
+	periodRepo    repository.TimesheetPeriodRepo
+	documentRepo  repository.DocumentRepo
+	orgRepo       repository.OrganizationRepo
+}
+
+func NewAccessPolicy(employeeRepo repository.EmployeeRepo, timesheetRepo repository.TimesheetRepo, periodRepo repository.TimesheetPeriodRepo, documentRepo repository.DocumentRepo, orgRepo repository.OrganizationRepo) *AccessPolicy {
+	return &AccessPolicy{employeeRepo: employeeRepo, timesheetRepo: timesheetRepo, periodRepo: periodRepo, documentRepo: documentRepo, orgRepo: orgRepo}
+}
+
+// CanAccessEmployee reports whether the caller may act on employeeID.
+// Unscoped roles may act on anyone, employees only on themselves and managers
+// on themselves and everyone below them. With reportsOnly set a manager's own
+// record is excluded, so nobody approves their own work.
+func (p *AccessPolicy) CanAccessEmployee(ctx context.Context, employeeID uint, reportsOnly bool) (bool, error) {
+	user, ok := UserFromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	if !ScopedRole(user.Role) {
+		return true, nil
+	}
+	if user.EmployeeID == 0 || employeeID == 0 {
+		return false, nil
+	}
+	if employeeID == user.EmployeeID {
+		return !reportsOnly, nil
+	}
+	if user.Role != model.RoleManager {
+		return false, nil
+	}
+
+	// Moves that became due since the last write must count, or a manager
+	// keeps reaching an employee who has moved away.
+	if err := p.orgRepo.ApplyDueAssignments(ctx, today()); err != nil {
+		return false, fmt.Errorf("apply due assignments: %w", err)
+	}
+
+	// Walk up the reporting line of employeeID looking for the caller.
+	seen := map[uint]bool{employeeID: true}
+	id := employeeID
+	for {
+		e, err := p.employeeRepo.GetEmployeeByID(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		if e.ManagerID == nil || seen[*e.ManagerID] {
+			return false, nil
+		}
+		if *e.ManagerID == user.EmployeeID {
+			return true, nil
+		}
+		id = *e.ManagerID
+		seen[id] = true
+	}
+}
+
+// TimesheetOwner returns the employee the timesheet belongs to.
+func (p *AccessPolicy) TimesheetOwner(ctx context.Context, id uint) (uint, error) {
+	ts, err := p.timesheetRepo.Get(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	return ts.EmployeeID, nil
+}
+
+// PeriodOwner returns the employee the timesheet period belongs to.
+func (p *AccessPolicy) PeriodOwner(ctx context.Context, id uint) (uint, error) {
+	period, err := p.periodRepo.Get(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	return period.EmployeeID, nil
+}
+
+// DocumentOwner returns the employee the document belongs to.
+func (p *AccessPolicy) DocumentOwner(ctx context.Context, id uint) (uint, error) {
+	doc, err := p.documentRepo.Get(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	return doc.EmployeeID, nil
+}
@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Purposes of the single-use tokens sent by email.
+const (
+	tokenVerifyEmail   = "verify_email"
+	tokenResetPassword = "reset_password"
+)
+
+const (
+	emailVerificationTTL = 24 * time.Hour
+	passwordResetTTL     = time.Hour
+
+	// Emails sent to one address per flow, and requests from one IP across
+	// registration and both flows, per rateWindow.
+	emailRateLimit = 3
+	ipRateLimit    = 10
+	rateWindow     = time.Hour
+
+	// Lookup and delivery after answering must finish within this.
+	backgroundEmailTimeout = time.Minute
+)
+
+var (
+	ErrEmailNotVerified = errors.New("email address is not verified; follow the link we emailed you or request a new one")
+	ErrAuthTokenInvalid = errors.New("link is invalid, expired or already used")
+	ErrTooManyRequests  = errors.New("too many requests, try again later")
+)
+
+// VerifyEmail marks the email of the account the token was sent to as
+// verified.
+func (uc *AuthUsecase) VerifyEmail(ctx context.Context, token string) error {
+	userID, err := uc.tokens.Consume(ctx, tokenVerifyEmail, hashToken(token))
+	if errors.Is(err, repository.ErrAuthTokenNotFound) {
+		return ErrAuthTokenInvalid
+	}
+	if err != nil {
+		return err
+	}
+	return uc.repo.MarkEmailVerified(ctx, userID, time.Now())
+}
+
+// ResendVerification emails a new verification link. It succeeds silently
+// when no unverified account uses the address, and the email goes out in the
+// background, so it can't be used to find out which addresses are
+// registered.
+func (uc *AuthUsecase) ResendVerification(ctx context.Context, email, ip string) error {
+	email = normalizeEmail(email)
+	if err := uc.limit(ctx, tokenVerifyEmail, email, ip); err != nil {
+		return err
+	}
+	inBackground(ctx, "resend verification", func(ctx context.Context) error {
+		user, err := uc.repo.GetByEmail(ctx, email)
+		if err != nil || user.EmailVerifiedAt != nil {
+			return nil
+		}
+		return uc.sendVerification(ctx, user)
+	})
+	return nil
+}
+
+// ForgotPassword emails a password reset link. Like ResendVerification it
+// gives the same answer, in the same time, whether or not the address is
+// registered.
+func (uc *AuthUsecase) ForgotPassword(ctx context.Context, email, ip string) error {
+	email = normalizeEmail(email)
+	if err := uc.limit(ctx, tokenResetPassword, email, ip); err != nil {
+		return err
+	}
+	inBackground(ctx, "forgot password", func(ctx context.Context) error {
+		user, err := uc.repo.GetByEmail(ctx, email)
+		if err != nil {
+			return nil
+		}
+		token, err := randomToken(32)
+		if err != nil {
+			return err
+		}
+		if err := uc.tokens.Issue(ctx, tokenResetPassword, hashToken(token), user.ID, passwordResetTTL); err != nil {
+			return err
+		}
+		expiresAt := time.Now().Add(passwordResetTTL)
+		if err := uc.emailRepo.SendPasswordReset(ctx, user.Email, user.Username, withToken(uc.passwordResetURL, token), expiresAt); err != nil {
+			return fmt.Errorf("send password reset: %w", err)
+		}
+		return nil
+	})
+	return nil
+}
+
+// ResetPassword sets a new password for the account the token was sent to
+// and ends all of its sessions. Receiving the link proves the email address,
+// so it is marked verified as well.
+func (uc *AuthUsecase) ResetPassword(ctx context.Context, token, password string) error {
+	if password == "" {
+		return ErrCredentialsMissing
+	}
+	// The token is only used up once the new password is accepted.
+	userID, err := uc.tokens.Lookup(ctx, tokenResetPassword, hashToken(token))
+	if errors.Is(err, repository.ErrAuthTokenNotFound) {
+		return ErrAuthTokenInvalid
+	}
+	if err != nil {
+		return err
+	}
+	user, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return ErrAuthTokenInvalid
+	}
+	hash, err := uc.newPasswordHash(ctx, user, user.Username, user.Email, password)
+	if err != nil {
+		return err
+	}
+	if _, err := uc.tokens.Consume(ctx, tokenResetPassword, hashToken(token)); err != nil {
+		return ErrAuthTokenInvalid
+	}
+	if err := uc.repo.UpdatePassword(ctx, userID, hash); err != nil {
+		return err
+	}
+	if err := uc.recordPassword(ctx, userID, hash); err != nil {
+		return err
+	}
+	if err := uc.repo.MarkEmailVerified(ctx, userID, time.Now()); err != nil {
+		return err
+	}
+	return uc.sessions.DeleteByUser(ctx, userID)
+}
+
+func (uc *AuthUsecase) sendVerification(ctx context.Context, user *model.User) error {
+	token, err := randomToken(32)
+	if err != nil {
+		return err
+	}
+	if err := uc.tokens.Issue(ctx, tokenVerifyEmail, hashToken(token), user.ID, emailVerificationTTL); err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(emailVerificationTTL)
+	if err := uc.emailRepo.SendEmailVerification(ctx, user.Email, user.Username, withToken(uc.verificationURL, token), expiresAt); err != nil {
+		return fmt.Errorf("send verification email: %w", err)
+	}
+	return nil
+}
+
+// inBackground runs work after the request has been answered, so neither
+// the response time nor a delivery failure tells the caller whether the
+// address is registered. Errors are logged.
+func inBackground(ctx context.Context, name string, work func(context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), backgroundEmailTimeout)
+	go func() {
+		defer cancel()
+		if err := work(ctx); err != nil {
+			log.Errorf("%s: %v", name, err)
+		}
+	}()
+}
+
+// limit counts a request for flow against the email address and the client
+// IP. Unknown addresses are counted too, so the limits behave the same for
+// registered and unregistered ones.
+func (uc *AuthUsecase) limit(ctx context.Context, flow, email, ip string) error {
+	if ip != "" {
+		ok, err := uc.tokens.Hit(ctx, "ip:"+ip, ipRateLimit, rateWindow)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrTooManyRequests
+		}
+	}
+	if email == "" {
+		return nil
+	}
+	ok, err := uc.tokens.Hit(ctx, flow+":"+email, emailRateLimit, rateWindow)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrTooManyRequests
+	}
+	return nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// withToken adds the token as a query parameter to the page URL.
+func withToken(page, token string) string {
+	if strings.Contains(page, "?") {
+		return page + "&token=" + url.QueryEscape(token)
+	}
+	return page + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,71 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidBankAccount = errors.New("bank_account must be 6 to 20 digits")
+	ErrBankAccountTaken   = errors.New("bank_account already used")
+)
+
+// bankAccounts maps each bank account in use to who holds it, so single
+// writes and imports check accounts the same way.
+type bankAccounts map[string]string
+
+// loadBankAccounts indexes the bank accounts of every stored employee. The
+// column is encrypted, so uniqueness can't be left to the database.
+func (uc *EmployeeUsecase) loadBankAccounts(ctx context.Context) (bankAccounts, error) {
+	existing, err := uc.repo.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load employees: %w", err)
+	}
+	accounts := make(bankAccounts, len(existing))
+	for _, e := range existing {
+		if e.BankAccount != "" {
+			accounts[e.BankAccount] = employeeHolder(e.ID)
+		}
+	}
+	return accounts, nil
+}
+
+// claim checks the format of account and that nobody but holder uses it,
+// then records holder as its owner. An empty account is always accepted.
+func (a bankAccounts) claim(account, holder string) error {
+	if account == "" {
+		return nil
+	}
+	if !validBankAccount(account) {
+		return ErrInvalidBankAccount
+	}
+	if owner, ok := a[account]; ok && owner != holder {
+		return fmt.Errorf("%w by %s", ErrBankAccountTaken, owner)
+	}
+	a[account] = holder
+	return nil
+}
+
+func employeeHolder(id uint) string {
+	return fmt.Sprintf("employee %d", id)
+}
+
+// normalizeBankAccount drops the spaces and dashes accounts are often
+// written with.
+func normalizeBankAccount(account string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(account))
+}
+
+func validBankAccount(account string) bool {
+	if len(account) < 6 || len(account) > 20 {
+		return false
+	}
+	for _, r := range account {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
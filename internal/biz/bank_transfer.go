@@ -0,0 +1,71 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var ErrNoPayrollThisMonth = errors.New("no payroll has been calculated for this month")
+
+// ExportBankTransfer builds the salary transfer CSV for monthYear with one
+// line per payroll: employee, bank account, net salary and a transfer note.
+// It is the only export that carries full bank accounts, so the caller must
+// be allowed to see personal data.
+func (uc *PayrollUsecase) ExportBankTransfer(ctx context.Context, monthYearStr string) ([]byte, error) {
+	if !uc.pii.CanView(ctx) {
+		return nil, ErrPIIForbidden
+	}
+	monthYear, err := time.Parse("2006-01", monthYearStr)
+	if err != nil {
+		return nil, errors.New("invalid month_year format, expected YYYY-MM")
+	}
+	payrolls, err := uc.payrollRepo.ListByMonth(ctx, monthYear)
+	if err != nil {
+		return nil, err
+	}
+	if len(payrolls) == 0 {
+		return nil, ErrNoPayrollThisMonth
+	}
+
+	ids := make([]uint, 0, len(payrolls))
+	for _, p := range payrolls {
+		ids = append(ids, p.EmployeeID)
+	}
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("load employees: %w", err)
+	}
+	byID := make(map[uint]int, len(employees))
+	for i, e := range employees {
+		byID[e.ID] = i
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"employee_id", "employee_name", "bank_account", "amount", "note"})
+	note := "Salary " + monthYear.Format("01/2006")
+	for _, p := range payrolls {
+		i, ok := byID[p.EmployeeID]
+		if !ok {
+			continue
+		}
+		e := employees[i]
+		w.Write([]string{
+			strconv.FormatUint(uint64(e.ID), 10),
+			e.Name,
+			e.BankAccount,
+			strconv.FormatFloat(p.NetSalary, 'f', 0, 64),
+			note,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("write csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
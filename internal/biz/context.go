@@ -0,0 +1,47 @@
+package biz
+
+import (
+	"context"
+
+	"myapp/internal/data/model"
+)
+
+// CurrentUser is the authenticated caller, placed on the request context by
+// the auth middleware.
+type CurrentUser struct {
+	ID         uint
+	Username   string
+	Role       string
+	EmployeeID uint   // zero when the account is not linked to an employee
+	SessionID  string // session the access token belongs to
+
+	// Set for requests authenticated with an API key, whose role is
+	// model.RoleService.
+	ServiceAccountID uint
+	Scopes           []string
+}
+
+// Can reports whether the caller holds perm: through their role, or for
+// service accounts through the API key's scopes.
+func (u *CurrentUser) Can(perm string) bool {
+	if u.Role == model.RoleService {
+		for _, s := range u.Scopes {
+			if s == perm {
+				return true
+			}
+		}
+		return false
+	}
+	return HasPermission(u.Role, perm)
+}
+
+type currentUserKey struct{}
+
+func NewUserContext(ctx context.Context, user *CurrentUser) context.Context {
+	return context.WithValue(ctx, currentUserKey{}, user)
+}
+
+func UserFromContext(ctx context.Context) (*CurrentUser, bool) {
+	user, ok := ctx.Value(currentUserKey{}).(*CurrentUser)
+	return user, ok
+}
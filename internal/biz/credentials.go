@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"errors"
+
+	"myapp/internal/data/model"
+	"myapp/internal/password"
+)
+
+var (
+	ErrPasswordReused       = errors.New("password was used recently; choose a different one")
+	ErrWrongCurrentPassword = errors.New("current password is incorrect")
+)
+
+// PasswordPolicy holds the rules for new passwords and how many of a user's
+// latest passwords can't be chosen again.
+type PasswordPolicy struct {
+	Rules   *password.Policy
+	History int
+}
+
+// newPasswordHash checks a new password for the account against the policy
+// and, for existing accounts, the recent passwords, and hashes it.
+func (uc *AuthUsecase) newPasswordHash(ctx context.Context, user *model.User, username, email, pw string) (string, error) {
+	if err := uc.passwordPolicy.Rules.Check(pw, username, email); err != nil {
+		return "", err
+	}
+	if user != nil && uc.passwordPolicy.History > 0 {
+		if uc.hasher.Verify(user.Password, pw) {
+			return "", ErrPasswordReused
+		}
+		recent, err := uc.passwordHistory.Recent(ctx, user.ID, uc.passwordPolicy.History)
+		if err != nil {
+			return "", err
+		}
+		for _, hash := range recent {
+			if uc.hasher.Verify(hash, pw) {
+				return "", ErrPasswordReused
+			}
+		}
+	}
+	return uc.hasher.Hash(pw)
+}
+
+// recordPassword remembers the hash for the reuse check.
+func (uc *AuthUsecase) recordPassword(ctx context.Context, userID uint, hash string) error {
+	if uc.passwordPolicy.History <= 0 {
+		return nil
+	}
+	return uc.passwordHistory.Add(ctx, userID, hash, uc.passwordPolicy.History)
+}
+
+// setPassword replaces the user's password after the policy and reuse checks.
+func (uc *AuthUsecase) setPassword(ctx context.Context, user *model.User, pw string) error {
+	hash, err := uc.newPasswordHash(ctx, user, user.Username, user.Email, pw)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.UpdatePassword(ctx, user.ID, hash); err != nil {
+		return err
+	}
+	user.Password = hash
+	return uc.recordPassword(ctx, user.ID, hash)
+}
+
+// ChangePassword sets a new password for the caller and ends their other
+// sessions. A wrong current password counts as a failed login.
+func (uc *AuthUsecase) ChangePassword(ctx context.Context, current, pw string) error {
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if !uc.hasher.Verify(user.Password, current) {
+		if err := uc.guard.Failed(ctx, user, user.Username, ""); !errors.Is(err, ErrInvalidCredentials) {
+			return err
+		}
+		return ErrWrongCurrentPassword
+	}
+	if err := uc.setPassword(ctx, user, pw); err != nil {
+		return err
+	}
+
+	caller, _ := UserFromContext(ctx)
+	sessions, err := uc.sessions.ListByUser(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if session.ID == caller.SessionID {
+			continue
+		}
+		if err := uc.sessions.Delete(ctx, session); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rehashPassword upgrades the stored hash after a successful login when the
+// hasher settings changed since it was made.
+func (uc *AuthUsecase) rehashPassword(ctx context.Context, user *model.User, pw string) error {
+	if !uc.hasher.NeedsRehash(user.Password) {
+		return nil
+	}
+	hash, err := uc.hasher.Hash(pw)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.UpdatePassword(ctx, user.ID, hash); err != nil {
+		return err
+	}
+	user.Password = hash
+	return nil
+}
@@ -0,0 +1,102 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"myapp/internal/data/model"
+	"myapp/internal/password"
+)
+
+// fakePasswordHistory keeps each user's latest hashes, newest first.
+type fakePasswordHistory struct {
+	hashes map[uint][]string
+}
+
+func (f *fakePasswordHistory) Add(_ context.Context, userID uint, hash string, keep int) error {
+	list := append([]string{hash}, f.hashes[userID]...)
+	if len(list) > keep {
+		list = list[:keep]
+	}
+	f.hashes[userID] = list
+	return nil
+}
+
+func (f *fakePasswordHistory) Recent(_ context.Context, userID uint, n int) ([]string, error) {
+	list := f.hashes[userID]
+	if len(list) > n {
+		list = list[:n]
+	}
+	return list, nil
+}
+
+func (f *fakeUsers) UpdatePassword(_ context.Context, id uint, hash string) error {
+	u, err := f.Get(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	u.Password = hash
+	return nil
+}
+
+func newPasswordTestUsecase(t *testing.T, history int) (*AuthUsecase, *model.User) {
+	t.Helper()
+	hasher, err := password.NewHasher(password.Bcrypt, 4, password.Argon2Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	users := &fakeUsers{}
+	user := &model.User{Username: "ana", Email: "ana@example.com"}
+	users.Create(context.Background(), user)
+	uc := &AuthUsecase{
+		repo:            users,
+		hasher:          hasher,
+		passwordPolicy:  PasswordPolicy{Rules: &password.Policy{}, History: history},
+		passwordHistory: &fakePasswordHistory{hashes: map[uint][]string{}},
+	}
+	if err := uc.setPassword(context.Background(), user, "first-password"); err != nil {
+		t.Fatal(err)
+	}
+	return uc, user
+}
+
+func TestSetPasswordRejectsReuse(t *testing.T) {
+	uc, user := newPasswordTestUsecase(t, 2)
+	ctx := context.Background()
+
+	if err := uc.setPassword(ctx, user, "first-password"); !errors.Is(err, ErrPasswordReused) {
+		t.Fatalf("current password: got %v, want ErrPasswordReused", err)
+	}
+	if err := uc.setPassword(ctx, user, "second-password"); err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.setPassword(ctx, user, "first-password"); !errors.Is(err, ErrPasswordReused) {
+		t.Fatalf("previous password: got %v, want ErrPasswordReused", err)
+	}
+	if err := uc.setPassword(ctx, user, "third-password"); err != nil {
+		t.Fatal(err)
+	}
+	// Only the latest two are remembered.
+	if err := uc.setPassword(ctx, user, "first-password"); err != nil {
+		t.Fatalf("password older than the history: %v", err)
+	}
+	if !uc.hasher.Verify(user.Password, "first-password") {
+		t.Fatal("stored hash was not updated")
+	}
+}
+
+func TestSetPasswordWithoutHistory(t *testing.T) {
+	uc, user := newPasswordTestUsecase(t, 0)
+	if err := uc.setPassword(context.Background(), user, "first-password"); err != nil {
+		t.Fatalf("reuse refused with history disabled: %v", err)
+	}
+}
+
+func TestSetPasswordAppliesRules(t *testing.T) {
+	uc, user := newPasswordTestUsecase(t, 2)
+	var policyErr *password.PolicyError
+	if err := uc.setPassword(context.Background(), user, "ana-2026-pw"); !errors.As(err, &policyErr) {
+		t.Fatalf("password containing the username: got %v, want a *password.PolicyError", err)
+	}
+}
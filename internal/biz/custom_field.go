@@ -0,0 +1,245 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"myapp/internal/data/model"
+)
+
+const maxCustomValueLength = 500
+
+var (
+	ErrInvalidFieldKey     = errors.New("field key must start with a letter and contain only lowercase letters, digits and underscores (max 64)")
+	ErrInvalidFieldType    = errors.New("field type must be string, number, boolean, date or enum")
+	ErrEnumValuesRequired  = errors.New("enum fields need at least one distinct, non-empty value")
+	ErrInvalidFieldPattern = errors.New("pattern is not a valid regular expression")
+	ErrInvalidFieldBounds  = errors.New("min must not be greater than max")
+	ErrInvalidCustomField  = errors.New("invalid custom field")
+)
+
+var fieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// CustomFieldSpec is the admin-editable part of a custom field definition.
+type CustomFieldSpec struct {
+	Label      string
+	Required   bool
+	EnumValues []string
+	Pattern    string
+	Min        *float64
+	Max        *float64
+}
+
+func (uc *EmployeeUsecase) CreateCustomField(ctx context.Context, key, fieldType string, spec CustomFieldSpec) (*model.CustomField, error) {
+	if !fieldKeyPattern.MatchString(key) {
+		return nil, ErrInvalidFieldKey
+	}
+	field := &model.CustomField{Key: key, Type: strings.ToLower(fieldType), Active: true}
+	switch field.Type {
+	case model.FieldString, model.FieldNumber, model.FieldBoolean, model.FieldDate, model.FieldEnum:
+	default:
+		return nil, ErrInvalidFieldType
+	}
+	if err := applyFieldSpec(field, spec); err != nil {
+		return nil, err
+	}
+	if err := uc.customFieldRepo.CreateField(ctx, field); err != nil {
+		return nil, fmt.Errorf("create custom field: %w", err)
+	}
+	return field, nil
+}
+
+// UpdateCustomField changes a definition. The key and type are fixed once
+// created; stored values are only re-validated when they are next written.
+func (uc *EmployeeUsecase) UpdateCustomField(ctx context.Context, id uint, spec CustomFieldSpec, active bool) (*model.CustomField, error) {
+	field, err := uc.customFieldRepo.GetField(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyFieldSpec(field, spec); err != nil {
+		return nil, err
+	}
+	field.Active = active
+	if err := uc.customFieldRepo.UpdateField(ctx, field); err != nil {
+		return nil, fmt.Errorf("update custom field: %w", err)
+	}
+	return field, nil
+}
+
+func (uc *EmployeeUsecase) ListCustomFields(ctx context.Context, includeInactive bool) ([]*model.CustomField, error) {
+	return uc.customFieldRepo.ListFields(ctx, !includeInactive)
+}
+
+func applyFieldSpec(field *model.CustomField, spec CustomFieldSpec) error {
+	field.Label = strings.TrimSpace(spec.Label)
+	if field.Label == "" {
+		field.Label = field.Key
+	}
+	field.Required = spec.Required
+	field.EnumValues, field.Pattern, field.Min, field.Max = nil, "", nil, nil
+
+	switch field.Type {
+	case model.FieldEnum:
+		seen := make(map[string]bool)
+		for _, v := range spec.EnumValues {
+			v = strings.TrimSpace(v)
+			if v == "" || seen[strings.ToLower(v)] {
+				return ErrEnumValuesRequired
+			}
+			seen[strings.ToLower(v)] = true
+			field.EnumValues = append(field.EnumValues, v)
+		}
+		if len(field.EnumValues) == 0 {
+			return ErrEnumValuesRequired
+		}
+	case model.FieldString:
+		if spec.Pattern != "" {
+			if _, err := regexp.Compile(spec.Pattern); err != nil {
+				return ErrInvalidFieldPattern
+			}
+			field.Pattern = spec.Pattern
+		}
+	case model.FieldNumber:
+		if spec.Min != nil && spec.Max != nil && *spec.Min > *spec.Max {
+			return ErrInvalidFieldBounds
+		}
+		field.Min, field.Max = spec.Min, spec.Max
+	}
+	return nil
+}
+
+// customValues validates input against the active definitions and returns
+// the canonical values by field ID. When creating, every required field must
+// be set; on update a required field cannot be cleared.
+func (uc *EmployeeUsecase) customValues(ctx context.Context, input map[string]string, creating bool) (map[uint]string, error) {
+	if len(input) == 0 && !creating {
+		return nil, nil
+	}
+	fields, err := uc.customFieldRepo.ListFields(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return checkCustomValues(fields, input, creating)
+}
+
+// checkCustomValues is customValues for already loaded active fields.
+func checkCustomValues(fields []*model.CustomField, input map[string]string, creating bool) (map[uint]string, error) {
+	byKey := make(map[string]*model.CustomField, len(fields))
+	for _, f := range fields {
+		byKey[f.Key] = f
+	}
+
+	values := make(map[uint]string, len(input))
+	for key, raw := range input {
+		field, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidCustomField, key)
+		}
+		value, err := canonicalValue(field, raw)
+		if err != nil {
+			return nil, err
+		}
+		if value == "" && field.Required {
+			return nil, fmt.Errorf("%w: %s is required", ErrInvalidCustomField, key)
+		}
+		values[field.ID] = value
+	}
+	if creating {
+		for _, f := range fields {
+			if f.Required && values[f.ID] == "" {
+				return nil, fmt.Errorf("%w: %s is required", ErrInvalidCustomField, f.Key)
+			}
+		}
+	}
+	return values, nil
+}
+
+// customFilter converts List filters by field key into canonical values by
+// field ID so they compare equal to the stored text.
+func (uc *EmployeeUsecase) customFilter(ctx context.Context, input map[string]string) (map[uint]string, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	fields, err := uc.customFieldRepo.ListFields(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*model.CustomField, len(fields))
+	for _, f := range fields {
+		byKey[f.Key] = f
+	}
+	filter := make(map[uint]string, len(input))
+	for key, raw := range input {
+		field, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidCustomField, key)
+		}
+		// Bounds and patterns only guard writes; any well-formed value can
+		// be searched for.
+		loose := *field
+		loose.Pattern, loose.Min, loose.Max = "", nil, nil
+		value, err := canonicalValue(&loose, raw)
+		if err != nil {
+			return nil, err
+		}
+		filter[field.ID] = value
+	}
+	return filter, nil
+}
+
+// canonicalValue validates raw for field and returns its stored form. Blank
+// input returns "".
+func canonicalValue(field *model.CustomField, raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", nil
+	}
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: %s %s", ErrInvalidCustomField, field.Key, reason)
+	}
+	switch field.Type {
+	case model.FieldString:
+		if len(raw) > maxCustomValueLength {
+			return "", invalid(fmt.Sprintf("must not exceed %d characters", maxCustomValueLength))
+		}
+		if field.Pattern != "" {
+			if ok, _ := regexp.MatchString(field.Pattern, raw); !ok {
+				return "", invalid("does not match " + field.Pattern)
+			}
+		}
+		return raw, nil
+	case model.FieldNumber:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return "", invalid("must be a number")
+		}
+		if (field.Min != nil && n < *field.Min) || (field.Max != nil && n > *field.Max) {
+			return "", invalid("is out of range")
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case model.FieldBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return "", invalid("must be true or false")
+		}
+		return strconv.FormatBool(b), nil
+	case model.FieldDate:
+		d, err := parseDate(raw)
+		if err != nil {
+			return "", invalid("must be a date (YYYY-MM-DD)")
+		}
+		return d.Format("2006-01-02"), nil
+	case model.FieldEnum:
+		for _, v := range field.EnumValues {
+			if strings.EqualFold(v, raw) {
+				return v, nil
+			}
+		}
+		return "", invalid("must be one of " + strings.Join(field.EnumValues, ", "))
+	}
+	return "", invalid("has an unsupported type")
+}
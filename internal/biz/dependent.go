@@ -0,0 +1,186 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+)
+
+var (
+	ErrInvalidRelationship = errors.New("relationship must be child, spouse, parent, sibling or other")
+	ErrInvalidTaxID        = errors.New("tax ID must be 10 or 12 digits")
+	ErrInvalidMonth        = errors.New("invalid month format, expected YYYY-MM")
+	ErrDependentMonths     = errors.New("to_month must not be before from_month")
+	ErrDuplicateDependent  = errors.New("a dependent with this tax ID is already registered")
+)
+
+// DependentReportItem is a dependent registered for at least one month of the
+// report year.
+type DependentReportItem struct {
+	Dependent    *model.Dependent
+	EmployeeName string
+	MonthsInYear int
+}
+
+// AddDependent registers a dependent for the family deduction from fromMonth,
+// and through toMonth when it is not empty.
+func (uc *EmploymentUsecase) AddDependent(ctx context.Context, employeeID uint, name, relationship, taxID string, dateOfBirth *time.Time, fromMonth, toMonth string) (*model.Dependent, error) {
+	emp, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if emp.Status == model.EmployeeTerminated {
+		return nil, ErrEmployeeTerminated
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("dependent name is required")
+	}
+	relationship = strings.ToLower(relationship)
+	switch relationship {
+	case model.RelationshipChild, model.RelationshipSpouse, model.RelationshipParent, model.RelationshipSibling, model.RelationshipOther:
+	default:
+		return nil, ErrInvalidRelationship
+	}
+	taxID = strings.TrimSpace(taxID)
+	if taxID != "" && !validTaxID(taxID) {
+		return nil, ErrInvalidTaxID
+	}
+
+	from, err := time.Parse("2006-01", fromMonth)
+	if err != nil {
+		return nil, ErrInvalidMonth
+	}
+	dependent := &model.Dependent{
+		EmployeeID:   employeeID,
+		Name:         name,
+		Relationship: relationship,
+		TaxID:        taxID,
+		FromMonth:    from,
+	}
+	if dateOfBirth != nil {
+		d := dateOf(*dateOfBirth)
+		dependent.DateOfBirth = &d
+	}
+	if toMonth != "" {
+		to, err := time.Parse("2006-01", toMonth)
+		if err != nil {
+			return nil, ErrInvalidMonth
+		}
+		if to.Before(from) {
+			return nil, ErrDependentMonths
+		}
+		dependent.ToMonth = &to
+	}
+
+	if taxID != "" {
+		existing, err := uc.dependentRepo.List(ctx, employeeID)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range existing {
+			if d.TaxID == taxID && d.ToMonth == nil {
+				return nil, ErrDuplicateDependent
+			}
+		}
+	}
+
+	if err := uc.dependentRepo.Create(ctx, dependent); err != nil {
+		return nil, fmt.Errorf("create dependent: %w", err)
+	}
+	return dependent, nil
+}
+
+func (uc *EmploymentUsecase) ListDependents(ctx context.Context, employeeID uint) ([]*model.Dependent, error) {
+	return uc.dependentRepo.List(ctx, employeeID)
+}
+
+// EndDependent ends the registration after toMonth, the last month the
+// deduction applies.
+func (uc *EmploymentUsecase) EndDependent(ctx context.Context, employeeID, id uint, toMonth string) (*model.Dependent, error) {
+	dependent, err := uc.dependentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if dependent.EmployeeID != employeeID {
+		return nil, errors.New("dependent not found")
+	}
+	to, err := time.Parse("2006-01", toMonth)
+	if err != nil {
+		return nil, ErrInvalidMonth
+	}
+	if to.Before(dateOf(dependent.FromMonth)) {
+		return nil, ErrDependentMonths
+	}
+	dependent.ToMonth = &to
+	if err := uc.dependentRepo.Update(ctx, dependent); err != nil {
+		return nil, fmt.Errorf("update dependent: %w", err)
+	}
+	return dependent, nil
+}
+
+// DependentReport lists every dependent registered for at least one month of
+// year with the number of months deducted, for the annual tax finalization.
+func (uc *PayrollUsecase) DependentReport(ctx context.Context, year int) ([]*DependentReportItem, error) {
+	if year < 1 {
+		return nil, errors.New("invalid year")
+	}
+	first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(year, time.December, 1, 0, 0, 0, 0, time.UTC)
+	dependents, err := uc.dependentRepo.ListOverlapping(ctx, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint]bool)
+	var ids []uint
+	for _, d := range dependents {
+		if !seen[d.EmployeeID] {
+			seen[d.EmployeeID] = true
+			ids = append(ids, d.EmployeeID)
+		}
+	}
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("load employees: %w", err)
+	}
+	names := make(map[uint]string, len(employees))
+	for _, e := range employees {
+		names[e.ID] = e.Name
+	}
+
+	items := make([]*DependentReportItem, 0, len(dependents))
+	for _, d := range dependents {
+		from, to := dateOf(d.FromMonth), last
+		if from.Before(first) {
+			from = first
+		}
+		if d.ToMonth != nil && d.ToMonth.Before(last) {
+			to = dateOf(*d.ToMonth)
+		}
+		items = append(items, &DependentReportItem{
+			Dependent:    d,
+			EmployeeName: names[d.EmployeeID],
+			MonthsInYear: monthsSpanned(from, to),
+		})
+	}
+	return items, nil
+}
+
+// validTaxID reports whether id is a personal tax code: 10 digits, or 12 for
+// a citizen ID used as the tax code.
+func validTaxID(id string) bool {
+	if len(id) != 10 && len(id) != 12 {
+		return false
+	}
+	for _, r := range id {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,238 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"myapp/internal/blob"
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+)
+
+const (
+	maxDocumentSize        = 10 << 20 // 10 MiB
+	defaultExpiryAlertDays = 30
+)
+
+var (
+	ErrInvalidDocumentType   = errors.New("document type must be contract, id_card, certificate or other")
+	ErrInvalidDocumentAccess = errors.New("access must be restricted or internal")
+	ErrEmptyDocument         = errors.New("document content is empty")
+	ErrDocumentTooLarge      = errors.New("document must not exceed 10 MiB")
+	ErrDocumentContentType   = errors.New("document must be a PDF, JPEG, PNG or WebP file")
+	ErrDocumentForbidden     = errors.New("not allowed to access this document")
+)
+
+// documentContentTypes are the detected content types accepted for upload.
+var documentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+}
+
+// ExpiringDocument is a document that has expired or will expire within the
+// alert window.
+type ExpiringDocument struct {
+	Document     *model.Document
+	EmployeeName string
+	DaysLeft     int // negative once expired
+}
+
+type DocumentUsecase struct {
+	repo         repository.DocumentRepo
+	employeeRepo repository.EmployeeRepo
+	store        blob.Store
+	pii          *PIIPolicy
+}
+
+func NewDocumentUsecase(repo repository.DocumentRepo, employeeRepo repository.EmployeeRepo, store blob.Store, pii *PIIPolicy) *DocumentUsecase {
+	return &DocumentUsecase{repo: repo, employeeRepo: employeeRepo, store: store, pii: pii}
+}
+
+// Upload stores content in the blob store and records it as a document of the
+// employee. ID card scans are always restricted; other types default to
+// restricted unless access says otherwise.
+func (uc *DocumentUsecase) Upload(ctx context.Context, employeeID uint, docType, title, fileName, access string, expiresAt *time.Time, content []byte) (*model.Document, error) {
+	if _, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID); err != nil {
+		return nil, err
+	}
+	docType = strings.ToLower(docType)
+	switch docType {
+	case model.DocumentContract, model.DocumentIDCard, model.DocumentCertificate, model.DocumentOther:
+	default:
+		return nil, ErrInvalidDocumentType
+	}
+	switch access = strings.ToLower(access); {
+	case access == "" || docType == model.DocumentIDCard:
+		access = model.DocumentRestricted
+	case access != model.DocumentRestricted && access != model.DocumentInternal:
+		return nil, ErrInvalidDocumentAccess
+	}
+	if access == model.DocumentRestricted && !uc.pii.CanView(ctx) {
+		return nil, ErrDocumentForbidden
+	}
+	if len(content) == 0 {
+		return nil, ErrEmptyDocument
+	}
+	if len(content) > maxDocumentSize {
+		return nil, ErrDocumentTooLarge
+	}
+	contentType := http.DetectContentType(content)
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	if !documentContentTypes[contentType] {
+		return nil, ErrDocumentContentType
+	}
+
+	suffix := make([]byte, 16)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(content)
+	doc := &model.Document{
+		EmployeeID:  employeeID,
+		Type:        docType,
+		Title:       strings.TrimSpace(title),
+		FileName:    path.Base(strings.ReplaceAll(fileName, `\`, "/")),
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		SHA256:      hex.EncodeToString(sum[:]),
+		StorageKey:  fmt.Sprintf("employees/%d/%s", employeeID, hex.EncodeToString(suffix)),
+		Access:      access,
+	}
+	if doc.FileName == "." || doc.FileName == "/" {
+		doc.FileName = ""
+	}
+	if expiresAt != nil {
+		d := dateOf(*expiresAt)
+		doc.ExpiresAt = &d
+	}
+	if user, ok := UserFromContext(ctx); ok {
+		doc.UploadedBy = &user.ID
+	}
+
+	if err := uc.store.Put(ctx, doc.StorageKey, bytes.NewReader(content), doc.Size, contentType); err != nil {
+		return nil, fmt.Errorf("store document: %w", err)
+	}
+	if err := uc.repo.Create(ctx, doc); err != nil {
+		uc.store.Delete(ctx, doc.StorageKey)
+		return nil, fmt.Errorf("create document: %w", err)
+	}
+	return doc, nil
+}
+
+// List returns the employee's documents the caller may see.
+func (uc *DocumentUsecase) List(ctx context.Context, employeeID uint, docType string) ([]*model.Document, error) {
+	docs, err := uc.repo.List(ctx, employeeID, strings.ToLower(docType))
+	if err != nil {
+		return nil, err
+	}
+	visible := docs[:0]
+	for _, d := range docs {
+		if uc.canAccess(ctx, d) {
+			visible = append(visible, d)
+		}
+	}
+	return visible, nil
+}
+
+// Download returns the document and its content.
+func (uc *DocumentUsecase) Download(ctx context.Context, id uint) (*model.Document, []byte, error) {
+	doc, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !uc.canAccess(ctx, doc) {
+		return nil, nil, ErrDocumentForbidden
+	}
+	r, err := uc.store.Get(ctx, doc.StorageKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read document: %w", err)
+	}
+	defer r.Close()
+	content, err := io.ReadAll(io.LimitReader(r, maxDocumentSize+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("read document: %w", err)
+	}
+	return doc, content, nil
+}
+
+func (uc *DocumentUsecase) Delete(ctx context.Context, id uint) error {
+	doc, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !uc.canAccess(ctx, doc) {
+		return ErrDocumentForbidden
+	}
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete document: %w", err)
+	}
+	if err := uc.store.Delete(ctx, doc.StorageKey); err != nil && !errors.Is(err, blob.ErrNotFound) {
+		return fmt.Errorf("delete document content: %w", err)
+	}
+	return nil
+}
+
+// Expiring lists documents of current employees that expired already or
+// expire within withinDays (30 when zero), soonest first.
+func (uc *DocumentUsecase) Expiring(ctx context.Context, withinDays int) ([]*ExpiringDocument, error) {
+	if withinDays < 0 {
+		return nil, errors.New("within_days must not be negative")
+	}
+	if withinDays == 0 {
+		withinDays = defaultExpiryAlertDays
+	}
+	now := today()
+	docs, err := uc.repo.ListExpiring(ctx, now.AddDate(0, 0, withinDays))
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint
+	seen := make(map[uint]bool)
+	for _, d := range docs {
+		if !seen[d.EmployeeID] {
+			seen[d.EmployeeID] = true
+			ids = append(ids, d.EmployeeID)
+		}
+	}
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("load employees: %w", err)
+	}
+	names := make(map[uint]string, len(employees))
+	for _, e := range employees {
+		names[e.ID] = e.Name
+	}
+
+	items := make([]*ExpiringDocument, 0, len(docs))
+	for _, d := range docs {
+		items = append(items, &ExpiringDocument{
+			Document:     d,
+			EmployeeName: names[d.EmployeeID],
+			DaysLeft:     int(dateOf(*d.ExpiresAt).Sub(now).Hours() / 24),
+		})
+	}
+	return items, nil
+}
+
+func (uc *DocumentUsecase) canAccess(ctx context.Context, doc *model.Document) bool {
+	if doc.Access == model.DocumentInternal {
+		_, ok := UserFromContext(ctx)
+		return ok
+	}
+	return uc.pii.CanView(ctx)
+}
@@ -2,62 +2,265 @@ package biz
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
 	"time"
 
 	"myapp/internal/data/model"
+	"myapp/internal/pagination"
 	"myapp/internal/repository"
 )
 
 type EmployeeUsecase struct {
-	repo repository.EmployeeRepo
+	repo            repository.EmployeeRepo
+	orgRepo         repository.OrganizationRepo
+	piiRepo         repository.PIIRepo
+	customFieldRepo repository.CustomFieldRepo
+	pii             *PIIPolicy
+	keyID           string
 }
 
-func NewEmployeeUsecase(repo repository.EmployeeRepo) *EmployeeUsecase {
-	return &EmployeeUsecase{repo: repo}
+func NewEmployeeUsecase(repo repository.EmployeeRepo, orgRepo repository.OrganizationRepo, piiRepo repository.PIIRepo, customFieldRepo repository.CustomFieldRepo, pii *PIIPolicy, activeKeyID string) *EmployeeUsecase {
+	return &EmployeeUsecase{repo: repo, orgRepo: orgRepo, piiRepo: piiRepo, customFieldRepo: customFieldRepo, pii: pii, keyID: activeKeyID}
 }
 
-func (uc *EmployeeUsecase) List(ctx context.Context, pageSize int, pageToken string) ([]*model.Employee, string, error) {
-	return uc.repo.List(ctx, pageSize, pageToken)
+// CanViewPII reports whether the caller may see bank accounts, tax IDs and
+// salaries unmasked.
+func (uc *EmployeeUsecase) CanViewPII(ctx context.Context) bool {
+	return uc.pii.CanView(ctx)
+}
+
+var (
+	ErrInvalidEmployeeStatus = errors.New("status must be probation, official, on_leave or terminated")
+	ErrInvalidEmail          = errors.New("invalid email address")
+	ErrInvalidPhone          = errors.New("phone must be 9 to 15 digits, optionally starting with +")
+	ErrInvalidPayslipChannel = errors.New("payslip channel must be work_email, personal_email or paper")
+	ErrPayslipChannelAddress = errors.New("the preferred payslip channel has no address on file")
+	ErrDependentsDeprecated  = errors.New("dependents is no longer accepted; register each dependent instead")
+)
+
+// Contact holds an employee's contact details and where payslips are sent.
+type Contact struct {
+	WorkEmail      string
+	PersonalEmail  string
+	Phone          string
+	Address        string
+	PayslipChannel string // defaults to work_email
+}
+
+// normalize trims the fields, fills the default channel and checks that the
+// chosen channel can be delivered to.
+func (c *Contact) normalize() error {
+	c.WorkEmail = strings.ToLower(strings.TrimSpace(c.WorkEmail))
+	c.PersonalEmail = strings.ToLower(strings.TrimSpace(c.PersonalEmail))
+	c.Phone = strings.NewReplacer(" ", "", "-", "", ".", "").Replace(c.Phone)
+	c.Address = strings.TrimSpace(c.Address)
+	for _, email := range []string{c.WorkEmail, c.PersonalEmail} {
+		if email == "" {
+			continue
+		}
+		if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+			return fmt.Errorf("%w: %s", ErrInvalidEmail, email)
+		}
+	}
+	if c.Phone != "" && !validPhone(c.Phone) {
+		return ErrInvalidPhone
+	}
+	if c.PayslipChannel == "" {
+		c.PayslipChannel = model.PayslipWorkEmail
+	}
+	switch c.PayslipChannel {
+	case model.PayslipWorkEmail:
+		if c.WorkEmail == "" && c.PersonalEmail != "" {
+			c.PayslipChannel = model.PayslipPersonalEmail
+		}
+	case model.PayslipPersonalEmail:
+		if c.PersonalEmail == "" {
+			return ErrPayslipChannelAddress
+		}
+	case model.PayslipPaper:
+		if c.Address == "" {
+			return ErrPayslipChannelAddress
+		}
+	default:
+		return ErrInvalidPayslipChannel
+	}
+	return nil
+}
+
+func (c Contact) apply(e *model.Employee) {
+	e.WorkEmail = c.WorkEmail
+	e.PersonalEmail = c.PersonalEmail
+	e.Phone = c.Phone
+	e.Address = c.Address
+	e.PayslipChannel = c.PayslipChannel
+}
+
+func validPhone(phone string) bool {
+	digits := strings.TrimPrefix(phone, "+")
+	if len(digits) < 9 || len(digits) > 15 {
+		return false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// List returns a page of employees matching filter and the custom field
+// values in custom (by field key) together with the total number of matches.
+func (uc *EmployeeUsecase) List(ctx context.Context, filter repository.EmployeeFilter, custom map[string]string, pageSize int32, pageToken string) ([]*model.Employee, string, int64, error) {
+	var err error
+	if filter.Custom, err = uc.customFilter(ctx, custom); err != nil {
+		return nil, "", 0, err
+	}
+	if filter.Status != "" && !validEmployeeStatus(filter.Status) {
+		return nil, "", 0, ErrInvalidEmployeeStatus
+	}
+	if filter.JoinedFrom != nil && filter.JoinedTo != nil && filter.JoinedTo.Before(*filter.JoinedFrom) {
+		return nil, "", 0, ErrInvalidDateRange
+	}
+	page, err := pagination.New(pageSize, pageToken, filter)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return uc.repo.List(ctx, filter, page)
+}
+
+func validEmployeeStatus(status string) bool {
+	switch status {
+	case model.EmployeeProbation, model.EmployeeOfficial, model.EmployeeOnLeave, model.EmployeeTerminated:
+		return true
+	}
+	return false
 }
 
 func (uc *EmployeeUsecase) Get(ctx context.Context, id uint32) (*model.Employee, error) {
 	return uc.repo.Get(ctx, id)
 }
 
-func (uc *EmployeeUsecase) Create(ctx context.Context, name string, position string, baseSalary float64, bankAccount string, joinDate time.Time, dependents int) (*model.Employee, error) {
+func (uc *EmployeeUsecase) Create(ctx context.Context, name string, position string, baseSalary float64, bankAccount string, joinDate time.Time, dependents int, status string, contact Contact, customFields map[string]string) (*model.Employee, error) {
+	// The family deduction counts registered dependents only.
+	if dependents != 0 {
+		return nil, ErrDependentsDeprecated
+	}
+	if status == "" {
+		status = model.EmployeeOfficial
+	}
+	if !validEmployeeStatus(status) {
+		return nil, ErrInvalidEmployeeStatus
+	}
+	if err := contact.normalize(); err != nil {
+		return nil, err
+	}
+	values, err := uc.customValues(ctx, customFields, true)
+	if err != nil {
+		return nil, err
+	}
+	bankAccount = normalizeBankAccount(bankAccount)
+	accounts, err := uc.loadBankAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := accounts.claim(bankAccount, ""); err != nil {
+		return nil, err
+	}
 	employee := &model.Employee{
 		Name:        name,
 		Position:    position,
 		BaseSalary:  baseSalary,
 		BankAccount: bankAccount,
 		JoinDate:    joinDate,
-		Dependents:  dependents,
+		Status:      status,
 	}
-	err := uc.repo.Create(ctx, employee)
-	if err != nil {
+	contact.apply(employee)
+	for id, v := range values {
+		if v != "" {
+			employee.FieldValues = append(employee.FieldValues, model.EmployeeFieldValue{FieldID: id, Value: v})
+		}
+	}
+	if err := uc.repo.Create(ctx, employee); err != nil {
 		return nil, err
 	}
-	return employee, nil
+	if len(employee.FieldValues) == 0 {
+		return employee, nil
+	}
+	return uc.repo.Get(ctx, uint32(employee.ID))
 }
 
-func (uc *EmployeeUsecase) Update(ctx context.Context, id uint32, name string, position string, baseSalary float64, bankAccount string, joinDate time.Time, dependents int) (*model.Employee, error) {
+func (uc *EmployeeUsecase) Update(ctx context.Context, id uint32, name string, position string, baseSalary float64, bankAccount string, joinDate time.Time, dependents int, contact Contact, customFields map[string]string) (*model.Employee, error) {
+	if dependents != 0 {
+		return nil, ErrDependentsDeprecated
+	}
+	if err := contact.normalize(); err != nil {
+		return nil, err
+	}
+	// Only the custom fields present are changed; an empty value clears one.
+	values, err := uc.customValues(ctx, customFields, false)
+	if err != nil {
+		return nil, err
+	}
 	employee, err := uc.repo.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if !uc.pii.CanView(ctx) {
+		// Callers who only see masked values send them back unchanged; they
+		// may not overwrite the stored salary or bank account.
+		if baseSalary != 0 && baseSalary != employee.BaseSalary {
+			return nil, ErrPIIForbidden
+		}
+		if bankAccount != "" && bankAccount != MaskTail(employee.BankAccount) && bankAccount != employee.BankAccount {
+			return nil, ErrPIIForbidden
+		}
+		baseSalary, bankAccount = employee.BaseSalary, employee.BankAccount
+	}
+	// The stored account is only checked again when it changes.
+	if bankAccount != employee.BankAccount {
+		bankAccount = normalizeBankAccount(bankAccount)
+	}
+	if bankAccount != employee.BankAccount {
+		accounts, err := uc.loadBankAccounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := accounts.claim(bankAccount, employeeHolder(employee.ID)); err != nil {
+			return nil, err
+		}
+	}
 	employee.Name = name
 	employee.Position = position
 	employee.BaseSalary = baseSalary
 	employee.BankAccount = bankAccount
 	employee.JoinDate = joinDate
-	employee.Dependents = dependents
-	err = uc.repo.Update(ctx, employee)
-	if err != nil {
+	contact.apply(employee)
+	if err := uc.repo.Update(ctx, employee, values); err != nil {
 		return nil, err
 	}
-	return employee, nil
+	if len(values) == 0 {
+		return employee, nil
+	}
+	return uc.repo.Get(ctx, id)
 }
 
 func (uc *EmployeeUsecase) Delete(ctx context.Context, id uint32) error {
 	return uc.repo.Delete(ctx, id)
-}
\ No newline at end of file
+}
+
+// RotateEncryptionKeys re-encrypts all personal data with the active key and
+// returns the key ID with the number of employee and dependent rows
+// rewritten.
+func (uc *EmployeeUsecase) RotateEncryptionKeys(ctx context.Context) (string, int, int, error) {
+	if !uc.pii.CanView(ctx) {
+		return "", 0, 0, ErrPIIForbidden
+	}
+	employees, dependents, err := uc.piiRepo.Reencrypt(ctx)
+	if err != nil {
+		return "", employees, dependents, err
+	}
+	return uc.keyID, employees, dependents, nil
+}
@@ -0,0 +1,351 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"myapp/internal/data/model"
+	"myapp/internal/pagination"
+	"myapp/internal/repository"
+)
+
+// Import modes.
+const (
+	ImportAllOrNothing = "all_or_nothing"
+	ImportPartial      = "partial"
+)
+
+const exportPageSize = 100
+
+const customColumnPrefix = "custom."
+
+var ErrInvalidImportMode = errors.New("mode must be all_or_nothing or partial")
+
+// employeeColumns is the layout written by Export and read by Import. The id
+// column is informational and ignored on import. One column per active custom
+// field, named customColumnPrefix plus the field key, follows them.
+var employeeColumns = []string{
+	"id", "name", "position", "base_salary", "bank_account", "join_date",
+	"status", "department_id", "position_id", "manager_id",
+	"work_email", "personal_email", "phone", "address", "payslip_channel",
+}
+
+// EmployeeImportRow is one parsed line of an employee import together with
+// the problems found while validating it.
+type EmployeeImportRow struct {
+	Row      int
+	Employee *model.Employee
+	Errors   []string
+}
+
+type EmployeeImportResult struct {
+	Rows     []*EmployeeImportRow
+	Valid    int
+	Imported int
+	DryRun   bool
+	Mode     string
+}
+
+// Import parses an employee file and validates every row. columnMap renames
+// file headers to the export column names. In all_or_nothing mode nothing is
+// stored if any row fails; in partial mode the valid rows are stored.
+func (uc *EmployeeUsecase) Import(ctx context.Context, format string, content []byte, columnMap map[string]string, mode string, dryRun bool) (*EmployeeImportResult, error) {
+	if mode == "" {
+		mode = ImportAllOrNothing
+	}
+	if mode != ImportAllOrNothing && mode != ImportPartial {
+		return nil, ErrInvalidImportMode
+	}
+	records, err := readSheet(format, content)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("file is empty")
+	}
+
+	header := make([]string, len(records[0]))
+	mapping := make(map[string]string, len(columnMap))
+	for from, to := range columnMap {
+		mapping[normalizeColumn(from)] = normalizeColumn(to)
+	}
+	for i, h := range records[0] {
+		header[i] = normalizeColumn(h)
+		if to, ok := mapping[header[i]]; ok {
+			header[i] = to
+		}
+	}
+	idx := headerIndex(header)
+	for _, required := range []string{"name", "base_salary", "join_date"} {
+		if _, ok := idx[required]; !ok {
+			return nil, fmt.Errorf("missing required column %q", required)
+		}
+	}
+	fields, err := uc.customFieldRepo.ListFields(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	active := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		active[f.Key] = true
+	}
+	for _, h := range header {
+		if key, ok := strings.CutPrefix(h, customColumnPrefix); ok && !active[key] {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidCustomField, key)
+		}
+	}
+
+	var rows []*EmployeeImportRow
+	for i, record := range records[1:] {
+		if isBlankRow(record) {
+			continue
+		}
+		row := parseEmployeeRow(i+2, record, idx)
+		// Required custom fields must be present, as on Create.
+		input := make(map[string]string, len(fields))
+		for _, f := range fields {
+			if _, ok := idx[customColumnPrefix+f.Key]; ok {
+				input[f.Key] = cell(record, idx, customColumnPrefix+f.Key)
+			}
+		}
+		values, err := checkCustomValues(fields, input, true)
+		if err != nil {
+			row.Errors = append(row.Errors, err.Error())
+		}
+		for id, v := range values {
+			if v != "" {
+				row.Employee.FieldValues = append(row.Employee.FieldValues, model.EmployeeFieldValue{FieldID: id, Value: v})
+			}
+		}
+		rows = append(rows, row)
+	}
+	if err := uc.validateImport(ctx, rows); err != nil {
+		return nil, err
+	}
+
+	result := &EmployeeImportResult{Rows: rows, DryRun: dryRun, Mode: mode}
+	var valid []*model.Employee
+	for _, r := range rows {
+		if len(r.Errors) == 0 {
+			valid = append(valid, r.Employee)
+		}
+	}
+	result.Valid = len(valid)
+
+	if dryRun || len(valid) == 0 || (mode == ImportAllOrNothing && len(valid) < len(rows)) {
+		return result, nil
+	}
+	if err := uc.repo.CreateBatch(ctx, valid); err != nil {
+		return nil, fmt.Errorf("import employees: %w", err)
+	}
+	result.Imported = len(valid)
+	return result, nil
+}
+
+func parseEmployeeRow(line int, record []string, idx map[string]int) *EmployeeImportRow {
+	row := &EmployeeImportRow{Row: line}
+	e := &model.Employee{
+		Name:        cell(record, idx, "name"),
+		Position:    cell(record, idx, "position"),
+		BankAccount: normalizeBankAccount(cell(record, idx, "bank_account")),
+		Status:      strings.ToLower(cell(record, idx, "status")),
+	}
+	row.Employee = e
+
+	if e.Name == "" {
+		row.Errors = append(row.Errors, "name is required")
+	}
+	salary, err := strconv.ParseFloat(strings.ReplaceAll(cell(record, idx, "base_salary"), ",", ""), 64)
+	switch {
+	case err != nil:
+		row.Errors = append(row.Errors, "invalid base_salary")
+	case salary < 0:
+		row.Errors = append(row.Errors, "base_salary must not be negative")
+	}
+	e.BaseSalary = salary
+	if e.JoinDate, err = parseDate(cell(record, idx, "join_date")); err != nil {
+		row.Errors = append(row.Errors, "invalid join_date, expected YYYY-MM-DD")
+	}
+	if e.Status == "" {
+		e.Status = model.EmployeeOfficial
+	}
+	if !validEmployeeStatus(e.Status) || e.Status == model.EmployeeTerminated {
+		row.Errors = append(row.Errors, "status must be probation, official or on_leave")
+	}
+	contact := Contact{
+		WorkEmail:      cell(record, idx, "work_email"),
+		PersonalEmail:  cell(record, idx, "personal_email"),
+		Phone:          cell(record, idx, "phone"),
+		Address:        cell(record, idx, "address"),
+		PayslipChannel: strings.ToLower(cell(record, idx, "payslip_channel")),
+	}
+	if err := contact.normalize(); err != nil {
+		row.Errors = append(row.Errors, err.Error())
+	}
+	contact.apply(e)
+	for _, ref := range []struct {
+		column string
+		dst    **uint
+	}{
+		{"department_id", &e.DepartmentID},
+		{"position_id", &e.PositionID},
+		{"manager_id", &e.ManagerID},
+	} {
+		v := cell(record, idx, ref.column)
+		if v == "" || v == "0" {
+			continue
+		}
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			row.Errors = append(row.Errors, "invalid "+ref.column)
+			continue
+		}
+		u := uint(id)
+		*ref.dst = &u
+	}
+	return row
+}
+
+// validateImport checks the rows against each other and the stored data:
+// bank accounts must be unique and referenced departments, positions and
+// managers must exist.
+func (uc *EmployeeUsecase) validateImport(ctx context.Context, rows []*EmployeeImportRow) error {
+	existing, err := uc.repo.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("load employees: %w", err)
+	}
+	employees := make(map[uint]bool, len(existing))
+	for _, e := range existing {
+		employees[e.ID] = true
+	}
+	accounts, err := uc.loadBankAccounts(ctx)
+	if err != nil {
+		return err
+	}
+	departments, err := uc.orgRepo.ListDepartments(ctx)
+	if err != nil {
+		return err
+	}
+	knownDepartment := make(map[uint]bool, len(departments))
+	for _, d := range departments {
+		knownDepartment[d.ID] = true
+	}
+	positions, err := uc.orgRepo.ListPositions(ctx, 0)
+	if err != nil {
+		return err
+	}
+	positionByID := make(map[uint]*model.Position, len(positions))
+	for _, p := range positions {
+		positionByID[p.ID] = p
+	}
+
+	for _, r := range rows {
+		e := r.Employee
+		if err := accounts.claim(e.BankAccount, fmt.Sprintf("row %d", r.Row)); err != nil {
+			r.Errors = append(r.Errors, err.Error())
+		}
+		if e.DepartmentID != nil && !knownDepartment[*e.DepartmentID] {
+			r.Errors = append(r.Errors, fmt.Sprintf("unknown department %d", *e.DepartmentID))
+		}
+		if e.PositionID != nil {
+			p, ok := positionByID[*e.PositionID]
+			switch {
+			case !ok:
+				r.Errors = append(r.Errors, fmt.Sprintf("unknown position %d", *e.PositionID))
+			case p.DepartmentID != nil && e.DepartmentID != nil && *p.DepartmentID != *e.DepartmentID:
+				r.Errors = append(r.Errors, ErrPositionDepartment.Error())
+			case e.Position == "":
+				e.Position = p.Title
+			}
+		}
+		if e.ManagerID != nil && !employees[*e.ManagerID] {
+			r.Errors = append(r.Errors, fmt.Sprintf("unknown manager %d", *e.ManagerID))
+		}
+	}
+	return nil
+}
+
+// Export writes every employee matching filter as CSV or XLSX in the layout
+// Import reads. Salaries and bank accounts are masked unless the caller may
+// see personal data.
+func (uc *EmployeeUsecase) Export(ctx context.Context, filter repository.EmployeeFilter, custom map[string]string, format string) ([]byte, error) {
+	var err error
+	if filter.Custom, err = uc.customFilter(ctx, custom); err != nil {
+		return nil, err
+	}
+	format = strings.ToLower(format)
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "xlsx" {
+		return nil, ErrUnsupportedFormat
+	}
+	reveal := uc.pii.CanView(ctx)
+	fields, err := uc.customFieldRepo.ListFields(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	header := append([]string{}, employeeColumns...)
+	for _, f := range fields {
+		header = append(header, customColumnPrefix+f.Key)
+	}
+	rows := [][]string{header}
+	token := ""
+	for {
+		page, err := pagination.New(exportPageSize, token, filter)
+		if err != nil {
+			return nil, err
+		}
+		employees, next, _, err := uc.repo.List(ctx, filter, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range employees {
+			salary, bankAccount := strconv.FormatFloat(e.BaseSalary, 'f', -1, 64), e.BankAccount
+			if !reveal {
+				salary, bankAccount = "", MaskTail(e.BankAccount)
+			}
+			row := []string{
+				strconv.FormatUint(uint64(e.ID), 10),
+				e.Name,
+				e.Position,
+				salary,
+				bankAccount,
+				e.JoinDate.Format("2006-01-02"),
+				e.Status,
+				idString(e.DepartmentID),
+				idString(e.PositionID),
+				idString(e.ManagerID),
+				e.WorkEmail,
+				e.PersonalEmail,
+				e.Phone,
+				e.Address,
+				e.PayslipChannel,
+			}
+			custom := make(map[uint]string, len(e.FieldValues))
+			for _, v := range e.FieldValues {
+				custom[v.FieldID] = v.Value
+			}
+			for _, f := range fields {
+				row = append(row, custom[f.ID])
+			}
+			rows = append(rows, row)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	return writeSheet(format, rows)
+}
+
+func idString(id *uint) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*id), 10)
+}
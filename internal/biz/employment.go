@@ -0,0 +1,365 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+)
+
+// Termination reasons.
+const (
+	TerminationResignation = "resignation"
+	TerminationContractEnd = "contract_end"
+	TerminationMutual      = "mutual_agreement"
+	TerminationLayoff      = "layoff"
+	TerminationDismissal   = "dismissal"
+)
+
+const (
+	standardWorkingDays    = 26.0
+	annualLeaveDays        = 12.0 // Labor Code art. 113
+	annualLeaveType        = "annual"
+	minProbationPercent    = 85.0 // Labor Code art. 26
+	maxProbationDays       = 180  // Labor Code art. 25, enterprise managers
+	minLayoffAllowance     = 2.0  // months of salary, Labor Code art. 47
+	severancePerYear       = 0.5  // months of salary, Labor Code art. 46
+	layoffAllowancePerYear = 1.0  // months of salary, Labor Code art. 47
+)
+
+var (
+	ErrInvalidContractType    = errors.New("contract type must be probation, fixed_term or indefinite")
+	ErrContractEndRequired    = errors.New("probation and fixed-term contracts need an end date after the start date")
+	ErrProbationTooLong       = errors.New("probation must not exceed 180 days")
+	ErrInvalidProbationSalary = errors.New("probation salary must be between 85% and 100% of the base salary")
+	ErrContractOverlap        = errors.New("contract overlaps an existing contract")
+	ErrInvalidStatusChange    = errors.New("status change not allowed")
+	ErrEmployeeTerminated     = errors.New("employee has been terminated")
+	ErrInvalidTermination     = errors.New("reason must be resignation, contract_end, mutual_agreement, layoff or dismissal")
+	ErrLastDayBeforeJoin      = errors.New("last working day is before the join date")
+)
+
+// statusTransitions lists the statuses an employee may move to from each
+// status. Termination goes through Terminate so it always has a settlement.
+var statusTransitions = map[string][]string{
+	model.EmployeeProbation: {model.EmployeeOfficial},
+	model.EmployeeOfficial:  {model.EmployeeOnLeave},
+	model.EmployeeOnLeave:   {model.EmployeeOfficial},
+}
+
+type EmploymentUsecase struct {
+	contractRepo  repository.ContractRepo
+	employeeRepo  repository.EmployeeRepo
+	timesheetRepo repository.TimesheetRepo
+	dependentRepo repository.DependentRepo
+}
+
+func NewEmploymentUsecase(
+	contractRepo repository.ContractRepo,
+	employeeRepo repository.EmployeeRepo,
+	timesheetRepo repository.TimesheetRepo,
+	dependentRepo repository.DependentRepo,
+) *EmploymentUsecase {
+	return &EmploymentUsecase{
+		contractRepo:  contractRepo,
+		employeeRepo:  employeeRepo,
+		timesheetRepo: timesheetRepo,
+		dependentRepo: dependentRepo,
+	}
+}
+
+func (uc *EmploymentUsecase) CreateContract(ctx context.Context, employeeID uint, contractType string, startDate time.Time, endDate *time.Time, probationPercent float64, note string) (*model.Contract, error) {
+	emp, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if emp.Status == model.EmployeeTerminated {
+		return nil, ErrEmployeeTerminated
+	}
+
+	contract := &model.Contract{
+		EmployeeID:             employeeID,
+		Type:                   strings.ToLower(contractType),
+		StartDate:              dateOf(startDate),
+		ProbationSalaryPercent: 100,
+		Note:                   note,
+	}
+	if endDate != nil {
+		d := dateOf(*endDate)
+		contract.EndDate = &d
+	}
+
+	switch contract.Type {
+	case model.ContractProbation:
+		if contract.EndDate == nil || !contract.EndDate.After(contract.StartDate) {
+			return nil, ErrContractEndRequired
+		}
+		if contract.EndDate.Sub(contract.StartDate) >= maxProbationDays*24*time.Hour {
+			return nil, ErrProbationTooLong
+		}
+		if probationPercent != 0 {
+			if probationPercent < minProbationPercent || probationPercent > 100 {
+				return nil, ErrInvalidProbationSalary
+			}
+			contract.ProbationSalaryPercent = probationPercent
+		}
+	case model.ContractFixedTerm:
+		if contract.EndDate == nil || !contract.EndDate.After(contract.StartDate) {
+			return nil, ErrContractEndRequired
+		}
+	case model.ContractIndefinite:
+		contract.EndDate = nil
+	default:
+		return nil, ErrInvalidContractType
+	}
+
+	var until time.Time
+	if contract.EndDate != nil {
+		until = *contract.EndDate
+	}
+	existing, err := uc.contractRepo.ListContracts(ctx, employeeID, contract.StartDate, until)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, ErrContractOverlap
+	}
+
+	if err := uc.contractRepo.CreateContract(ctx, contract); err != nil {
+		return nil, fmt.Errorf("create contract: %w", err)
+	}
+	return contract, nil
+}
+
+func (uc *EmploymentUsecase) ListContracts(ctx context.Context, employeeID uint) ([]*model.Contract, error) {
+	return uc.contractRepo.ListContracts(ctx, employeeID, time.Time{}, time.Time{})
+}
+
+// ChangeStatus moves an employee between probation, official and on leave.
+func (uc *EmploymentUsecase) ChangeStatus(ctx context.Context, employeeID uint, status string) (*model.Employee, error) {
+	emp, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if emp.Status == model.EmployeeTerminated {
+		return nil, ErrEmployeeTerminated
+	}
+	allowed := false
+	for _, next := range statusTransitions[emp.Status] {
+		allowed = allowed || next == status
+	}
+	if !allowed {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidStatusChange, emp.Status, status)
+	}
+
+	emp.Status = status
+	if err := uc.employeeRepo.Update(ctx, emp, nil); err != nil {
+		return nil, err
+	}
+	return emp, nil
+}
+
+func (uc *EmploymentUsecase) GetTermination(ctx context.Context, employeeID uint) (*model.Termination, error) {
+	return uc.contractRepo.GetTermination(ctx, employeeID)
+}
+
+// Terminate ends an employee's employment on lastDay and computes the final
+// settlement: salary for the approved working days of the last month, unused
+// annual leave and severance for the years of service. With preview set the
+// settlement is returned without being stored.
+func (uc *EmploymentUsecase) Terminate(ctx context.Context, employeeID uint, lastDay time.Time, reason, note string, preview bool) (*model.Termination, error) {
+	emp, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if emp.Status == model.EmployeeTerminated {
+		return nil, ErrEmployeeTerminated
+	}
+	switch reason {
+	case TerminationResignation, TerminationContractEnd, TerminationMutual, TerminationLayoff, TerminationDismissal:
+	default:
+		return nil, ErrInvalidTermination
+	}
+	lastDay = dateOf(lastDay)
+	joinDate := dateOf(emp.JoinDate)
+	if lastDay.Before(joinDate) {
+		return nil, ErrLastDayBeforeJoin
+	}
+
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	yearStart := time.Date(lastDay.Year(), 1, 1, 0, 0, 0, 0, location)
+	monthStart := time.Date(lastDay.Year(), lastDay.Month(), 1, 0, 0, 0, 0, location)
+	lastMoment := time.Date(lastDay.Year(), lastDay.Month(), lastDay.Day(), 0, 0, 0, 0, location).AddDate(0, 0, 1).Add(-time.Nanosecond)
+
+	rows, err := uc.timesheetRepo.ListRange(ctx, yearStart, lastMoment, []uint{employeeID})
+	if err != nil {
+		return nil, err
+	}
+	var worked []time.Time
+	usedLeave := 0.0
+	for _, ts := range rows {
+		switch {
+		case ts.IsLeave && strings.EqualFold(ts.LeaveType, annualLeaveType):
+			usedLeave++
+		case !ts.IsLeave && ts.Status == model.TimesheetApproved && !ts.WorkDate.Before(monthStart):
+			worked = append(worked, dateOf(ts.WorkDate))
+		}
+	}
+	contracts, err := uc.contractRepo.ListContracts(ctx, employeeID, dateOf(monthStart), lastDay)
+	if err != nil {
+		return nil, err
+	}
+
+	dailyRate := emp.BaseSalary / standardWorkingDays
+	t := &model.Termination{
+		EmployeeID:     employeeID,
+		LastWorkingDay: lastDay,
+		Reason:         reason,
+		Note:           note,
+		WorkingDays:    len(worked),
+		ProratedSalary: roundMoney(earnedSalary(emp.BaseSalary, worked, contracts)),
+	}
+
+	t.UnusedLeaveDays = math.Max(0, accruedLeaveDays(dateOf(yearStart), joinDate, lastDay)-usedLeave)
+	t.LeavePayout = roundMoney(t.UnusedLeaveDays * dailyRate)
+
+	t.ServiceYears = serviceYears(joinDate, lastDay)
+	if t.ServiceYears >= 1 {
+		switch reason {
+		case TerminationLayoff:
+			t.Severance = math.Max(minLayoffAllowance, layoffAllowancePerYear*t.ServiceYears) * emp.BaseSalary
+		case TerminationDismissal:
+		default:
+			t.Severance = severancePerYear * t.ServiceYears * emp.BaseSalary
+		}
+		t.Severance = roundMoney(t.Severance)
+	}
+	t.Total = t.ProratedSalary + t.LeavePayout + t.Severance
+
+	if preview {
+		return t, nil
+	}
+	if user, ok := UserFromContext(ctx); ok {
+		t.ProcessedBy = &user.ID
+	}
+	if err := uc.contractRepo.Terminate(ctx, t); err != nil {
+		return nil, fmt.Errorf("terminate employee: %w", err)
+	}
+	return t, nil
+}
+
+// LeaveBalance is an employee's annual leave for one calendar year.
+type LeaveBalance struct {
+	Year      int
+	Entitled  float64 // whole year, prorated from the join date
+	Accrued   float64 // earned up to today, or the whole year for past years
+	Used      float64
+	Remaining float64 // Accrued - Used, negative when taken in advance
+}
+
+// LeaveBalance returns the employee's annual leave for year, the current year
+// when zero. Leave accrues monthly like in the termination settlement.
+func (uc *EmploymentUsecase) LeaveBalance(ctx context.Context, employeeID uint, year int) (*LeaveBalance, error) {
+	emp, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	now := today()
+	if year == 0 {
+		year = now.Year()
+	}
+	yearStart := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	yearEnd := time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC)
+	joinDate := dateOf(emp.JoinDate)
+	balance := &LeaveBalance{Year: year}
+	if joinDate.After(yearEnd) || year > now.Year() {
+		return balance, nil
+	}
+
+	balance.Entitled = accruedLeaveDays(yearStart, joinDate, yearEnd)
+	balance.Accrued = balance.Entitled
+	if year == now.Year() {
+		balance.Accrued = accruedLeaveDays(yearStart, joinDate, now)
+	}
+	rows, err := uc.timesheetRepo.ListRange(ctx, yearStart, yearEnd.AddDate(0, 0, 1).Add(-time.Nanosecond), []uint{employeeID})
+	if err != nil {
+		return nil, err
+	}
+	for _, ts := range rows {
+		if ts.IsLeave && strings.EqualFold(ts.LeaveType, annualLeaveType) {
+			balance.Used++
+		}
+	}
+	balance.Remaining = balance.Accrued - balance.Used
+	return balance, nil
+}
+
+// accruedLeaveDays is the whole days of annual leave earned from yearStart,
+// or joinDate if later, through asOf.
+func accruedLeaveDays(yearStart, joinDate, asOf time.Time) float64 {
+	from := yearStart
+	if joinDate.After(from) {
+		from = joinDate
+	}
+	if asOf.Before(from) {
+		return 0
+	}
+	return math.Floor(annualLeaveDays * float64(monthsSpanned(from, asOf)) / 12)
+}
+
+// earnedSalary pays each worked day at the daily rate, reduced to the
+// probation percentage on days covered by a probation contract.
+func earnedSalary(baseSalary float64, worked []time.Time, contracts []*model.Contract) float64 {
+	dailyRate := baseSalary / standardWorkingDays
+	total := 0.0
+	for _, day := range worked {
+		share := 1.0
+		for _, c := range contracts {
+			if c.Type != model.ContractProbation || day.Before(dateOf(c.StartDate)) {
+				continue
+			}
+			if c.EndDate == nil || !day.After(dateOf(*c.EndDate)) {
+				share = c.ProbationSalaryPercent / 100
+				break
+			}
+		}
+		total += dailyRate * share
+	}
+	return total
+}
+
+// monthsSpanned counts the calendar months from from to to, both included.
+func monthsSpanned(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()-from.Month()) + 1
+}
+
+// serviceYears is the time worked between joinDate and lastDay in years,
+// with a remainder of up to six months counted as half a year and a longer
+// one as a full year (Decree 145/2020).
+func serviceYears(joinDate, lastDay time.Time) float64 {
+	months := (lastDay.Year()-joinDate.Year())*12 + int(lastDay.Month()-joinDate.Month())
+	if lastDay.Day() < joinDate.Day() {
+		months--
+	}
+	if months < 0 {
+		return 0
+	}
+	years := float64(months / 12)
+	switch rem := months % 12; {
+	case rem == 0:
+	case rem <= 6:
+		years += 0.5
+	default:
+		years++
+	}
+	return years
+}
+
+func roundMoney(v float64) float64 {
+	return math.Round(v)
+}
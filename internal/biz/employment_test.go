@@ -0,0 +1,204 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+type fakeContracts struct {
+	repository.ContractRepo
+	contracts []*model.Contract
+}
+
+func (f *fakeContracts) ListContracts(_ context.Context, employeeID uint, from, to time.Time) ([]*model.Contract, error) {
+	var out []*model.Contract
+	for _, c := range f.contracts {
+		if c.EmployeeID == employeeID && !c.StartDate.After(to) && (c.EndDate == nil || !c.EndDate.Before(from)) {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func datePtr(y int, m time.Month, d int) *time.Time {
+	t := date(y, m, d)
+	return &t
+}
+
+// A base salary of 26,000,000 pays 1,000,000 per standard working day.
+const testBaseSalary = 26_000_000
+
+// settlementTimesheets records for employee id approved work on 2-6 March
+// 2026, unapproved work on 9-11 March, approved work in February and two
+// days of annual leave in January.
+func settlementTimesheets(id uint) []*model.Timesheet {
+	var rows []*model.Timesheet
+	add := func(d time.Time, status string, leave bool) {
+		ts := &model.Timesheet{EmployeeID: id, WorkDate: d, Status: status, IsLeave: leave}
+		if leave {
+			ts.LeaveType = annualLeaveType
+		} else {
+			ts.HoursWorked = 8
+		}
+		rows = append(rows, ts)
+	}
+	for d := 2; d <= 6; d++ {
+		add(date(2026, 3, d), model.TimesheetApproved, false)
+	}
+	add(date(2026, 3, 9), model.TimesheetDraft, false)
+	add(date(2026, 3, 10), model.TimesheetDraft, false)
+	add(date(2026, 3, 11), model.TimesheetSubmitted, false)
+	add(date(2026, 2, 27), model.TimesheetApproved, false)
+	add(date(2026, 1, 12), model.TimesheetApproved, true)
+	add(date(2026, 1, 13), model.TimesheetApproved, true)
+	return rows
+}
+
+func TestTerminateSettlement(t *testing.T) {
+	const (
+		veteran  = 1 // joined 15 March 2023
+		newcomer = 2 // joined 2 March 2026 on probation
+	)
+	lastDay := date(2026, 3, 20)
+
+	tests := []struct {
+		name      string
+		employee  uint
+		reason    string
+		contracts []*model.Contract
+
+		workingDays                    int
+		prorated, unusedLeave, payout  float64
+		serviceYears, severance, total float64
+	}{
+		{
+			// Only approved days of March count; three days of leave accrued
+			// by March, two taken; three years of service.
+			name:        "resignation",
+			employee:    veteran,
+			reason:      TerminationResignation,
+			workingDays: 5, prorated: 5_000_000,
+			unusedLeave: 1, payout: 1_000_000,
+			serviceYears: 3, severance: 39_000_000,
+			total: 45_000_000,
+		},
+		{
+			name:        "layoff pays a month per year",
+			employee:    veteran,
+			reason:      TerminationLayoff,
+			workingDays: 5, prorated: 5_000_000,
+			unusedLeave: 1, payout: 1_000_000,
+			serviceYears: 3, severance: 78_000_000,
+			total: 84_000_000,
+		},
+		{
+			name:        "dismissal has no severance",
+			employee:    veteran,
+			reason:      TerminationDismissal,
+			workingDays: 5, prorated: 5_000_000,
+			unusedLeave: 1, payout: 1_000_000,
+			serviceYears: 3,
+			total:        6_000_000,
+		},
+		{
+			name:     "probation days paid at the probation rate",
+			employee: newcomer,
+			reason:   TerminationResignation,
+			contracts: []*model.Contract{
+				{EmployeeID: newcomer, Type: model.ContractProbation, StartDate: date(2026, 3, 2), EndDate: datePtr(2026, 4, 30), ProbationSalaryPercent: 85},
+			},
+			workingDays: 5, prorated: 4_250_000,
+			unusedLeave: 1, payout: 1_000_000,
+			total: 5_250_000,
+		},
+		{
+			name:     "official contract after probation",
+			employee: newcomer,
+			reason:   TerminationMutual,
+			contracts: []*model.Contract{
+				{EmployeeID: newcomer, Type: model.ContractProbation, StartDate: date(2026, 3, 2), EndDate: datePtr(2026, 3, 4), ProbationSalaryPercent: 85},
+				{EmployeeID: newcomer, Type: model.ContractIndefinite, StartDate: date(2026, 3, 5)},
+			},
+			workingDays: 5, prorated: 4_550_000,
+			unusedLeave: 1, payout: 1_000_000,
+			total: 5_550_000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rows []*model.Timesheet
+			for _, ts := range settlementTimesheets(tt.employee) {
+				// The newcomer joined in March and took no leave.
+				if tt.employee == newcomer && (ts.IsLeave || ts.WorkDate.Month() != time.March) {
+					continue
+				}
+				rows = append(rows, ts)
+			}
+			uc := &EmploymentUsecase{
+				employeeRepo: &fakeEmployees{employees: []*model.Employee{
+					{Model: gorm.Model{ID: veteran}, JoinDate: date(2023, 3, 15), BaseSalary: testBaseSalary, Status: model.EmployeeOfficial},
+					{Model: gorm.Model{ID: newcomer}, JoinDate: date(2026, 3, 2), BaseSalary: testBaseSalary, Status: model.EmployeeProbation},
+				}},
+				timesheetRepo: &fakeTimesheets{rows: rows},
+				contractRepo:  &fakeContracts{contracts: tt.contracts},
+			}
+			got, err := uc.Terminate(context.Background(), tt.employee, lastDay, tt.reason, "", true)
+			if err != nil {
+				t.Fatalf("Terminate: %v", err)
+			}
+			if got.WorkingDays != tt.workingDays || got.ProratedSalary != tt.prorated {
+				t.Errorf("got %d days paid %v, want %d days paid %v", got.WorkingDays, got.ProratedSalary, tt.workingDays, tt.prorated)
+			}
+			if got.UnusedLeaveDays != tt.unusedLeave || got.LeavePayout != tt.payout {
+				t.Errorf("got %v leave days paid %v, want %v paid %v", got.UnusedLeaveDays, got.LeavePayout, tt.unusedLeave, tt.payout)
+			}
+			if got.ServiceYears != tt.serviceYears || got.Severance != tt.severance {
+				t.Errorf("got %v years and severance %v, want %v and %v", got.ServiceYears, got.Severance, tt.serviceYears, tt.severance)
+			}
+			if got.Total != tt.total {
+				t.Errorf("got total %v, want %v", got.Total, tt.total)
+			}
+		})
+	}
+}
+
+func TestTerminateRejects(t *testing.T) {
+	terminatedAt := date(2026, 2, 27)
+	uc := &EmploymentUsecase{
+		employeeRepo: &fakeEmployees{employees: []*model.Employee{
+			{Model: gorm.Model{ID: 1}, JoinDate: date(2023, 3, 15), BaseSalary: testBaseSalary, Status: model.EmployeeOfficial},
+			{Model: gorm.Model{ID: 2}, JoinDate: date(2023, 3, 15), BaseSalary: testBaseSalary, Status: model.EmployeeTerminated, TerminatedAt: &terminatedAt},
+		}},
+		timesheetRepo: &fakeTimesheets{},
+		contractRepo:  &fakeContracts{},
+	}
+	tests := []struct {
+		name     string
+		employee uint
+		lastDay  time.Time
+		reason   string
+		want     error
+	}{
+		{"already terminated", 2, date(2026, 3, 20), TerminationResignation, ErrEmployeeTerminated},
+		{"unknown reason", 1, date(2026, 3, 20), "retired", ErrInvalidTermination},
+		{"last day before joining", 1, date(2023, 3, 14), TerminationResignation, ErrLastDayBeforeJoin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.Terminate(context.Background(), tt.employee, tt.lastDay, tt.reason, "", true); !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
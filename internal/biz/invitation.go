@@ -0,0 +1,166 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+)
+
+const invitationTTL = 7 * 24 * time.Hour
+
+var (
+	ErrInvitationRole     = errors.New("invitation role must be employee, manager, hr or payroll")
+	ErrInvitationEmail    = errors.New("employee has no email address; pass one explicitly")
+	ErrInvitationInvalid  = errors.New("invitation is invalid, expired or already used")
+	ErrEmployeeLinked     = errors.New("employee is already linked to another account")
+	ErrCredentialsMissing = errors.New("username and password are required")
+	ErrAccountNotLinked   = errors.New("account is not linked to an employee")
+)
+
+// CurrentEmployeeID returns the employee the caller's account is linked to.
+func CurrentEmployeeID(ctx context.Context) (uint, error) {
+	user, ok := UserFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if user.EmployeeID == 0 {
+		return 0, ErrAccountNotLinked
+	}
+	return user.EmployeeID, nil
+}
+
+// Invite emails the employee a one-time link to create an account bound to
+// their record. Admin accounts cannot be created by invitation.
+func (uc *AuthUsecase) Invite(ctx context.Context, employeeID uint, email, role string) (*model.Invitation, error) {
+	if role == "" {
+		role = model.RoleEmployee
+	}
+	if !ValidRole(role) || role == model.RoleAdmin {
+		return nil, ErrInvitationRole
+	}
+	emp, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if emp.Status == model.EmployeeTerminated {
+		return nil, ErrEmployeeTerminated
+	}
+	if _, err := uc.repo.GetByEmployeeID(ctx, employeeID); err == nil {
+		return nil, ErrEmployeeLinked
+	}
+	email = normalizeEmail(email)
+	if email == "" {
+		email = emp.WorkEmail
+	}
+	if email == "" {
+		email = emp.PersonalEmail
+	}
+	if email == "" {
+		return nil, ErrInvitationEmail
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	invitation := &model.Invitation{
+		EmployeeID: employeeID,
+		Email:      email,
+		Role:       role,
+		TokenHash:  hashToken(token),
+		ExpiresAt:  time.Now().Add(invitationTTL),
+	}
+	if user, ok := UserFromContext(ctx); ok {
+		invitation.InvitedBy = &user.ID
+	}
+	if err := uc.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("create invitation: %w", err)
+	}
+
+	link := withToken(uc.invitationURL, token)
+	if err := uc.emailRepo.SendInvitation(ctx, email, emp.Name, link, invitation.ExpiresAt); err != nil {
+		return nil, fmt.Errorf("send invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// AcceptInvitation creates the invited account with the chosen credentials.
+func (uc *AuthUsecase) AcceptInvitation(ctx context.Context, token, username, password string) (*model.User, error) {
+	username = strings.TrimSpace(username)
+	if username == "" || password == "" {
+		return nil, ErrCredentialsMissing
+	}
+	invitation, err := uc.invitationRepo.GetByTokenHash(ctx, hashToken(token))
+	if err != nil || invitation.AcceptedAt != nil || time.Now().After(invitation.ExpiresAt) {
+		return nil, ErrInvitationInvalid
+	}
+	if _, err := uc.repo.GetByEmployeeID(ctx, invitation.EmployeeID); err == nil {
+		return nil, ErrEmployeeLinked
+	}
+	if _, err := uc.repo.GetByUsername(ctx, username); err == nil {
+		return nil, errors.New("username already taken")
+	}
+
+	hashed, err := uc.newPasswordHash(ctx, nil, username, invitation.Email, password)
+	if err != nil {
+		return nil, err
+	}
+	employeeID := invitation.EmployeeID
+	now := time.Now()
+	user := &model.User{
+		Username:   username,
+		Password:   hashed,
+		Email:      invitation.Email,
+		Role:       invitation.Role,
+		EmployeeID: &employeeID,
+
+		// The invitation link went to this address.
+		EmailVerifiedAt: &now,
+	}
+	invitation.AcceptedAt = &now
+	if err := uc.invitationRepo.Accept(ctx, invitation, user); err != nil {
+		return nil, fmt.Errorf("accept invitation: %w", err)
+	}
+	if err := uc.recordPassword(ctx, user.ID, hashed); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// LinkEmployee binds the user to employeeID, or unlinks them when it is zero,
+// and ends their sessions so the next token carries the link.
+func (uc *AuthUsecase) LinkEmployee(ctx context.Context, userID, employeeID uint) (*model.User, error) {
+	user, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	var link *uint
+	if employeeID != 0 {
+		if _, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID); err != nil {
+			return nil, err
+		}
+		if other, err := uc.repo.GetByEmployeeID(ctx, employeeID); err == nil && other.ID != userID {
+			return nil, ErrEmployeeLinked
+		}
+		link = &employeeID
+	}
+	if err := uc.repo.SetEmployee(ctx, userID, link); err != nil {
+		return nil, err
+	}
+	user.EmployeeID = link
+	if err := uc.sessions.DeleteByUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/pagination"
+	"myapp/internal/password"
+	"myapp/internal/repository"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrAccountLocked      = errors.New("account is temporarily locked after too many failed logins; try again later")
+)
+
+// LockoutPolicy sets when failed logins lock an account. Zero fields take
+// the defaults below.
+type LockoutPolicy struct {
+	MaxAttempts     int           // failures within Window that lock the account
+	Window          time.Duration // counting starts at the first failure
+	LockDuration    time.Duration // first lock; each further lockout doubles it
+	MaxLockDuration time.Duration
+	IPMaxAttempts   int // failures from one IP within Window, across usernames
+}
+
+func (p LockoutPolicy) withDefaults() LockoutPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 5
+	}
+	if p.Window <= 0 {
+		p.Window = 15 * time.Minute
+	}
+	if p.LockDuration <= 0 {
+		p.LockDuration = 5 * time.Minute
+	}
+	if p.MaxLockDuration < p.LockDuration {
+		p.MaxLockDuration = 24 * time.Hour
+	}
+	if p.IPMaxAttempts <= 0 {
+		p.IPMaxAttempts = 50
+	}
+	return p
+}
+
+// LoginGuard counts failed logins per username and per IP and locks
+// usernames progressively. Lockouts and blocked IPs are recorded as audit
+// events.
+type LoginGuard struct {
+	attempts  repository.LoginAttemptRepo
+	events    repository.AuthEventRepo
+	hasher    *password.Hasher
+	policy    LockoutPolicy
+	dummyHash string
+}
+
+func NewLoginGuard(attempts repository.LoginAttemptRepo, events repository.AuthEventRepo, hasher *password.Hasher, policy LockoutPolicy) *LoginGuard {
+	// Compared against when the username is unknown, so both cases take
+	// as long.
+	dummyHash, _ := hasher.Hash("not a password")
+	return &LoginGuard{attempts: attempts, events: events, hasher: hasher, policy: policy.withDefaults(), dummyHash: dummyHash}
+}
+
+// Check refuses logins for locked usernames and IPs with too many failures.
+func (g *LoginGuard) Check(ctx context.Context, username, ip string) error {
+	if ip != "" {
+		failures, err := g.attempts.IPFailures(ctx, ip)
+		if err != nil {
+			return err
+		}
+		if failures >= int64(g.policy.IPMaxAttempts) {
+			return ErrTooManyRequests
+		}
+	}
+	locked, err := g.attempts.LockedFor(ctx, username)
+	if err != nil {
+		return err
+	}
+	if locked > 0 {
+		return ErrAccountLocked
+	}
+	return nil
+}
+
+// Failed records a failed login for username, whose account is user or nil
+// if none exists, and returns the error to report.
+func (g *LoginGuard) Failed(ctx context.Context, user *model.User, username, ip string) error {
+	if ip != "" {
+		failures, err := g.attempts.AddIPFailure(ctx, ip, g.policy.Window)
+		if err != nil {
+			return err
+		}
+		if failures == int64(g.policy.IPMaxAttempts) {
+			if err := g.record(ctx, model.AuthEventIPBlocked, user, username, ip, nil,
+				fmt.Sprintf("%d failed logins within %s", failures, g.policy.Window)); err != nil {
+				return err
+			}
+		}
+	}
+
+	failures, err := g.attempts.AddUserFailure(ctx, username, g.policy.Window)
+	if err != nil {
+		return err
+	}
+	if failures < int64(g.policy.MaxAttempts) {
+		return ErrInvalidCredentials
+	}
+
+	// Lockouts are remembered for twice the longest lock, so an account
+	// locked at the cap stays there while attacks go on.
+	lockouts, err := g.attempts.AddLockout(ctx, username, 2*g.policy.MaxLockDuration)
+	if err != nil {
+		return err
+	}
+	d := g.lockDuration(lockouts)
+	if err := g.attempts.Lock(ctx, username, d); err != nil {
+		return err
+	}
+	if err := g.record(ctx, model.AuthEventAccountLocked, user, username, ip, nil,
+		fmt.Sprintf("locked for %s after %d failed logins (lockout %d)", d, failures, lockouts)); err != nil {
+		return err
+	}
+	return ErrAccountLocked
+}
+
+// Succeeded clears the username's failures.
+func (g *LoginGuard) Succeeded(ctx context.Context, username string) error {
+	return g.attempts.ClearUserFailures(ctx, username)
+}
+
+// Unlock lifts the lock on the user's account and forgets earlier lockouts.
+func (g *LoginGuard) Unlock(ctx context.Context, user *model.User) error {
+	if err := g.attempts.Unlock(ctx, user.Username); err != nil {
+		return err
+	}
+	var actorID *uint
+	if actor, ok := UserFromContext(ctx); ok {
+		actorID = &actor.ID
+	}
+	return g.record(ctx, model.AuthEventAccountUnlocked, user, user.Username, "", actorID, "")
+}
+
+// lockDuration doubles the lock with each lockout, up to the maximum.
+func (g *LoginGuard) lockDuration(lockouts int64) time.Duration {
+	d := g.policy.LockDuration
+	for i := int64(1); i < lockouts && d < g.policy.MaxLockDuration; i++ {
+		d *= 2
+	}
+	if d > g.policy.MaxLockDuration {
+		d = g.policy.MaxLockDuration
+	}
+	return d
+}
+
+// comparePassword checks password against the user's hash, or against a
+// dummy hash when user is nil.
+func (g *LoginGuard) comparePassword(user *model.User, pw string) bool {
+	if user == nil {
+		g.hasher.Verify(g.dummyHash, pw)
+		return false
+	}
+	return g.hasher.Verify(user.Password, pw)
+}
+
+func (g *LoginGuard) record(ctx context.Context, event string, user *model.User, username, ip string, actorID *uint, detail string) error {
+	e := &model.AuthEvent{
+		Event:    event,
+		Username: strings.TrimSpace(username),
+		IP:       ip,
+		ActorID:  actorID,
+		Detail:   detail,
+	}
+	if user != nil {
+		e.UserID = &user.ID
+	}
+	if err := g.events.Create(ctx, e); err != nil {
+		return fmt.Errorf("record %s: %w", event, err)
+	}
+	return nil
+}
+
+// UnlockUser lifts a login lockout of the user.
+func (uc *AuthUsecase) UnlockUser(ctx context.Context, id uint) (*model.User, error) {
+	user, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	if err := uc.guard.Unlock(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ListAuthEvents returns the audit trail of lockouts and unlocks, newest
+// first.
+func (uc *AuthUsecase) ListAuthEvents(ctx context.Context, filter repository.AuthEventFilter, pageSize int32, pageToken string) ([]*model.AuthEvent, string, error) {
+	page, err := pagination.New(pageSize, pageToken, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	return uc.guard.events.List(ctx, filter, page)
+}
@@ -0,0 +1,277 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/oidc"
+	"myapp/internal/repository"
+)
+
+// oidcStateTTL is how long the user has to sign in at the provider.
+const oidcStateTTL = 10 * time.Minute
+
+var (
+	ErrOIDCDisabled        = errors.New("single sign-on is not configured")
+	ErrOIDCStateInvalid    = errors.New("sign-in attempt is invalid or expired; start again")
+	ErrOIDCFailed          = errors.New("single sign-on failed")
+	ErrOIDCEmailMissing    = errors.New("the identity provider did not share an email address")
+	ErrOIDCEmailUnverified = errors.New("the identity provider has not verified your email address")
+	ErrOIDCAccountConflict = errors.New("an account whose email is not verified uses this address; verify it or ask an administrator")
+)
+
+// IdentityProvider is an external login such as the company SSO.
+// *oidc.Provider implements it.
+type IdentityProvider interface {
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*oidc.Identity, error)
+}
+
+// rolesByRank orders roles from most to least privileged, to pick one when
+// the provider's claims map to several.
+var rolesByRank = []string{model.RoleAdmin, model.RoleHR, model.RolePayroll, model.RoleManager, model.RoleEmployee}
+
+// OIDCPolicy configures login through an external identity provider. Users
+// are linked to local accounts by verified email, and accounts are created on
+// first login for everyone else.
+type OIDCPolicy struct {
+	Provider IdentityProvider // nil turns single sign-on off
+
+	// RoleClaim names the ID token claim, a string or list of strings such
+	// as groups, whose values RoleMapping maps to roles. Without a match
+	// users get DefaultRole, or employee.
+	RoleClaim   string
+	RoleMapping map[string]string
+	DefaultRole string
+
+	// SyncRole sets the role from the claims at every login, not just when
+	// the account is created.
+	SyncRole bool
+
+	// TrustEmail links and creates accounts even when the provider doesn't
+	// mark the email as verified, for providers that never send the flag.
+	TrustEmail bool
+
+	// SkipTwoFactor trusts the provider's own second factor, so SSO logins
+	// aren't asked for a TOTP code.
+	SkipTwoFactor bool
+}
+
+// role maps the claims to a role.
+func (p OIDCPolicy) role(claims map[string]interface{}) string {
+	mapped := make(map[string]bool)
+	switch v := claims[p.RoleClaim].(type) {
+	case string:
+		mapped[p.RoleMapping[v]] = true
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				mapped[p.RoleMapping[s]] = true
+			}
+		}
+	}
+	for _, role := range rolesByRank {
+		if mapped[role] {
+			return role
+		}
+	}
+	if p.DefaultRole != "" {
+		return p.DefaultRole
+	}
+	return model.RoleEmployee
+}
+
+// StartOIDCLogin returns the provider page to send the user to and the state
+// it will send back. The caller should keep the state and check that the
+// redirect carries the same one.
+func (uc *AuthUsecase) StartOIDCLogin(ctx context.Context) (string, string, error) {
+	if uc.oidc.Provider == nil {
+		return "", "", ErrOIDCDisabled
+	}
+	state, err := randomToken(32)
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := randomToken(32)
+	if err != nil {
+		return "", "", err
+	}
+	verifier, err := randomToken(32)
+	if err != nil {
+		return "", "", err
+	}
+	authURL, err := uc.oidc.Provider.AuthCodeURL(ctx, state, nonce, oidc.CodeChallenge(verifier))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %v", ErrOIDCFailed, err)
+	}
+	pending := &repository.OIDCState{Nonce: nonce, CodeVerifier: verifier}
+	if err := uc.oidcStates.Save(ctx, hashToken(state), pending, oidcStateTTL); err != nil {
+		return "", "", err
+	}
+	return authURL, state, nil
+}
+
+// FinishOIDCLogin redeems the code the provider redirected back with and
+// starts a session for the linked account, creating it on first login. Like
+// Login it returns a challenge when a second factor is needed.
+func (uc *AuthUsecase) FinishOIDCLogin(ctx context.Context, code, state, device, ip string) (*TokenPair, error) {
+	if uc.oidc.Provider == nil {
+		return nil, ErrOIDCDisabled
+	}
+	pending, err := uc.oidcStates.Consume(ctx, hashToken(state))
+	if errors.Is(err, repository.ErrOIDCStateNotFound) {
+		return nil, ErrOIDCStateInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	identity, err := uc.oidc.Provider.Exchange(ctx, code, pending.CodeVerifier, pending.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOIDCFailed, err)
+	}
+	user, err := uc.oidcUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.oidc.SyncRole {
+		if role := uc.oidc.role(identity.Claims); role != user.Role {
+			if err := uc.repo.UpdateRole(ctx, user.ID, role); err != nil {
+				return nil, err
+			}
+			user.Role = role
+		}
+	}
+	if !uc.oidc.SkipTwoFactor && (user.TOTPEnabledAt != nil || uc.twoFactor.required(user.Role)) {
+		return uc.loginChallenge(ctx, user)
+	}
+	return uc.startSession(ctx, user, device, ip)
+}
+
+// oidcUser returns the account linked to the identity. Unlinked identities
+// are linked to the account with the same verified email, or get a new
+// account.
+func (uc *AuthUsecase) oidcUser(ctx context.Context, identity *oidc.Identity) (*model.User, error) {
+	email := normalizeEmail(identity.Email)
+	now := time.Now()
+	link, err := uc.identities.Get(ctx, identity.Issuer, identity.Subject)
+	if err == nil {
+		if err := uc.identities.Touch(ctx, link.ID, email, now); err != nil {
+			return nil, err
+		}
+		return uc.repo.Get(ctx, link.UserID)
+	}
+	if !errors.Is(err, repository.ErrExternalIdentityNotFound) {
+		return nil, err
+	}
+
+	if email == "" {
+		return nil, ErrOIDCEmailMissing
+	}
+	if !identity.EmailVerified && !uc.oidc.TrustEmail {
+		return nil, ErrOIDCEmailUnverified
+	}
+	user, err := uc.repo.GetByEmail(ctx, email)
+	if err == nil && user.EmailVerifiedAt == nil {
+		// Whoever registered the address never proved it; linking would
+		// hand their password access to the provider's user.
+		return nil, ErrOIDCAccountConflict
+	}
+	if err != nil {
+		if user, err = uc.provisionOIDCUser(ctx, identity, email); err != nil {
+			return nil, err
+		}
+	}
+
+	link = &model.ExternalIdentity{
+		UserID:      user.ID,
+		Issuer:      identity.Issuer,
+		Subject:     identity.Subject,
+		Email:       email,
+		LastLoginAt: &now,
+	}
+	if err := uc.identities.Create(ctx, link); err != nil {
+		return nil, fmt.Errorf("link external identity: %w", err)
+	}
+	return user, nil
+}
+
+// provisionOIDCUser creates the account of a first-time SSO user. It gets an
+// unknown random password; the owner can set one through the password reset
+// flow to log in without the provider.
+func (uc *AuthUsecase) provisionOIDCUser(ctx context.Context, identity *oidc.Identity, email string) (*model.User, error) {
+	username, err := uc.freeUsername(ctx, identity.PreferredUsername, email)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := uc.hasher.Hash(secret)
+	if err != nil {
+		return nil, err
+	}
+	verifiedAt := time.Now()
+	user := &model.User{
+		Username:        username,
+		Password:        hash,
+		Email:           email,
+		Role:            uc.oidc.role(identity.Claims),
+		EmailVerifiedAt: &verifiedAt,
+	}
+	if err := uc.repo.Create(ctx, user); err != nil {
+		return nil, fmt.Errorf("create user: %w", err)
+	}
+	return user, nil
+}
+
+// freeUsername picks an unused username from the provider's preferred
+// username or the email's local part, adding a number when it is taken.
+// Bootstrap admin names are never handed out.
+func (uc *AuthUsecase) freeUsername(ctx context.Context, preferred, email string) (string, error) {
+	base := sanitizeUsername(preferred)
+	if base == "" {
+		local, _, _ := strings.Cut(email, "@")
+		base = sanitizeUsername(local)
+	}
+	if base == "" {
+		base = "user"
+	}
+	for i := 1; i <= 100; i++ {
+		candidate := base
+		if i > 1 {
+			candidate = base + strconv.Itoa(i)
+		}
+		if uc.admins[candidate] {
+			continue
+		}
+		if _, err := uc.repo.GetByUsername(ctx, candidate); err != nil {
+			return candidate, nil
+		}
+	}
+	suffix, err := randomToken(4)
+	if err != nil {
+		return "", err
+	}
+	return base + "-" + strings.ToLower(suffix), nil
+}
+
+// sanitizeUsername keeps letters, digits, dots, dashes and underscores.
+func sanitizeUsername(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '.' || r == '-' || r == '_' {
+			b.WriteRune(r)
+		}
+		if b.Len() >= 50 {
+			break
+		}
+	}
+	return b.String()
+}
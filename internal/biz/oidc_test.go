@@ -0,0 +1,211 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/oidc"
+	"myapp/internal/password"
+	"myapp/internal/repository"
+)
+
+// fakeUsers keeps accounts in memory. Methods single sign-on doesn't use are
+// left to the nil embedded repo.
+type fakeUsers struct {
+	repository.UserRepo
+	users []*model.User
+}
+
+func (f *fakeUsers) Create(_ context.Context, user *model.User) error {
+	user.ID = uint(len(f.users) + 1)
+	f.users = append(f.users, user)
+	return nil
+}
+
+func (f *fakeUsers) Get(_ context.Context, id uint) (*model.User, error) {
+	for _, u := range f.users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
+func (f *fakeUsers) GetByUsername(_ context.Context, username string) (*model.User, error) {
+	for _, u := range f.users {
+		if u.Username == username {
+			return u, nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
+func (f *fakeUsers) GetByEmail(_ context.Context, email string) (*model.User, error) {
+	for _, u := range f.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
+type fakeIdentities struct {
+	links []*model.ExternalIdentity
+}
+
+func (f *fakeIdentities) Get(_ context.Context, issuer, subject string) (*model.ExternalIdentity, error) {
+	for _, l := range f.links {
+		if l.Issuer == issuer && l.Subject == subject {
+			return l, nil
+		}
+	}
+	return nil, repository.ErrExternalIdentityNotFound
+}
+
+func (f *fakeIdentities) Create(_ context.Context, identity *model.ExternalIdentity) error {
+	identity.ID = uint(len(f.links) + 1)
+	f.links = append(f.links, identity)
+	return nil
+}
+
+func (f *fakeIdentities) Touch(_ context.Context, id uint, email string, at time.Time) error {
+	for _, l := range f.links {
+		if l.ID == id {
+			l.Email, l.LastLoginAt = email, &at
+		}
+	}
+	return nil
+}
+
+func newOIDCTestUsecase(t *testing.T, policy OIDCPolicy, users ...*model.User) (*AuthUsecase, *fakeUsers, *fakeIdentities) {
+	t.Helper()
+	hasher, err := password.NewHasher(password.Bcrypt, 4, password.Argon2Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &fakeUsers{}
+	for _, u := range users {
+		repo.Create(context.Background(), u)
+	}
+	identities := &fakeIdentities{}
+	uc := &AuthUsecase{repo: repo, identities: identities, oidc: policy, hasher: hasher, admins: map[string]bool{}}
+	return uc, repo, identities
+}
+
+func ssoIdentity(email string, verified bool) *oidc.Identity {
+	return &oidc.Identity{
+		Issuer:            "https://sso.example.com",
+		Subject:           "sub-1",
+		Email:             email,
+		EmailVerified:     verified,
+		PreferredUsername: "ana",
+	}
+}
+
+func TestOIDCUserRefusesUnverifiedLocalAccount(t *testing.T) {
+	local := &model.User{Username: "ana", Email: "ana@example.com"}
+	uc, _, identities := newOIDCTestUsecase(t, OIDCPolicy{}, local)
+
+	_, err := uc.oidcUser(context.Background(), ssoIdentity("ana@example.com", true))
+	if !errors.Is(err, ErrOIDCAccountConflict) {
+		t.Fatalf("got %v, want ErrOIDCAccountConflict", err)
+	}
+	if len(identities.links) != 0 {
+		t.Fatal("identity was linked to an account with an unverified email")
+	}
+}
+
+func TestOIDCUserLinksVerifiedLocalAccount(t *testing.T) {
+	verifiedAt := time.Now()
+	local := &model.User{Username: "ana", Email: "ana@example.com", EmailVerifiedAt: &verifiedAt}
+	uc, users, identities := newOIDCTestUsecase(t, OIDCPolicy{}, local)
+
+	user, err := uc.oidcUser(context.Background(), ssoIdentity("Ana@Example.com", true))
+	if err != nil {
+		t.Fatalf("oidcUser: %v", err)
+	}
+	if user.ID != local.ID || len(users.users) != 1 {
+		t.Fatalf("got user %d, want the existing account %d", user.ID, local.ID)
+	}
+	if len(identities.links) != 1 || identities.links[0].UserID != local.ID {
+		t.Fatalf("identity not linked to the account: %+v", identities.links)
+	}
+
+	// The next login finds the link without looking at the email.
+	again, err := uc.oidcUser(context.Background(), ssoIdentity("ana.new@example.com", false))
+	if err != nil {
+		t.Fatalf("second oidcUser: %v", err)
+	}
+	if again.ID != local.ID || identities.links[0].Email != "ana.new@example.com" {
+		t.Fatalf("linked login did not resolve to the account: %+v", identities.links[0])
+	}
+}
+
+func TestOIDCUserUnverifiedProviderEmail(t *testing.T) {
+	verifiedAt := time.Now()
+	local := &model.User{Username: "ana", Email: "ana@example.com", EmailVerifiedAt: &verifiedAt}
+
+	t.Run("refused by default", func(t *testing.T) {
+		uc, users, identities := newOIDCTestUsecase(t, OIDCPolicy{}, local)
+		if _, err := uc.oidcUser(context.Background(), ssoIdentity("ana@example.com", false)); !errors.Is(err, ErrOIDCEmailUnverified) {
+			t.Fatalf("got %v, want ErrOIDCEmailUnverified", err)
+		}
+		if _, err := uc.oidcUser(context.Background(), ssoIdentity("new@example.com", false)); !errors.Is(err, ErrOIDCEmailUnverified) {
+			t.Fatalf("got %v, want ErrOIDCEmailUnverified", err)
+		}
+		if len(identities.links) != 0 || len(users.users) != 1 {
+			t.Fatal("unverified provider email linked or created an account")
+		}
+	})
+
+	t.Run("accepted with TrustEmail", func(t *testing.T) {
+		uc, _, _ := newOIDCTestUsecase(t, OIDCPolicy{TrustEmail: true}, local)
+		user, err := uc.oidcUser(context.Background(), ssoIdentity("ana@example.com", false))
+		if err != nil {
+			t.Fatalf("oidcUser: %v", err)
+		}
+		if user.ID != local.ID {
+			t.Fatalf("got user %d, want the existing account %d", user.ID, local.ID)
+		}
+	})
+
+	t.Run("TrustEmail still refuses an unverified local account", func(t *testing.T) {
+		unverified := &model.User{Username: "bob", Email: "bob@example.com"}
+		uc, _, _ := newOIDCTestUsecase(t, OIDCPolicy{TrustEmail: true}, unverified)
+		if _, err := uc.oidcUser(context.Background(), ssoIdentity("bob@example.com", false)); !errors.Is(err, ErrOIDCAccountConflict) {
+			t.Fatalf("got %v, want ErrOIDCAccountConflict", err)
+		}
+	})
+}
+
+func TestOIDCUserProvisionsNewAccount(t *testing.T) {
+	existing := &model.User{Username: "ana", Email: "other@example.com"}
+	uc, users, identities := newOIDCTestUsecase(t, OIDCPolicy{
+		RoleClaim:   "groups",
+		RoleMapping: map[string]string{"hr-team": model.RoleHR},
+	}, existing)
+	uc.admins["ana2"] = true
+
+	identity := ssoIdentity("ana@example.com", true)
+	identity.Claims = map[string]interface{}{"groups": []interface{}{"hr-team"}}
+	user, err := uc.oidcUser(context.Background(), identity)
+	if err != nil {
+		t.Fatalf("oidcUser: %v", err)
+	}
+	if len(users.users) != 2 || user.ID == existing.ID {
+		t.Fatal("no account was created")
+	}
+	// ana is taken and ana2 is a bootstrap admin name.
+	if user.Username != "ana3" {
+		t.Fatalf("got username %q, want ana3", user.Username)
+	}
+	if user.Role != model.RoleHR || user.EmailVerifiedAt == nil {
+		t.Fatalf("unexpected new account %+v", user)
+	}
+	if len(identities.links) != 1 || identities.links[0].UserID != user.ID {
+		t.Fatalf("identity not linked to the new account: %+v", identities.links)
+	}
+}
@@ -0,0 +1,302 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+)
+
+var (
+	ErrManagerCycle         = errors.New("an employee cannot report to themselves or to one of their reports")
+	ErrPositionDepartment   = errors.New("position belongs to a different department")
+	ErrAssignmentNotInOrder = errors.New("effective date must be after the employee's latest assignment")
+)
+
+// DepartmentNode is a department in the org tree with its sub-departments.
+type DepartmentNode struct {
+	Department *model.Department
+	Headcount  int
+	Children   []*DepartmentNode
+}
+
+// Report is an employee below a manager; Depth 1 is a direct report.
+type Report struct {
+	Employee *model.Employee
+	Depth    int
+}
+
+type OrganizationUsecase struct {
+	repo         repository.OrganizationRepo
+	employeeRepo repository.EmployeeRepo
+}
+
+func NewOrganizationUsecase(repo repository.OrganizationRepo, employeeRepo repository.EmployeeRepo) *OrganizationUsecase {
+	return &OrganizationUsecase{repo: repo, employeeRepo: employeeRepo}
+}
+
+func (uc *OrganizationUsecase) CreateDepartment(ctx context.Context, name, code string, parentID, headID uint) (*model.Department, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, errors.New("department name is required")
+	}
+	department := &model.Department{Name: name, Code: strings.ToUpper(strings.TrimSpace(code))}
+	if parentID != 0 {
+		if _, err := uc.repo.GetDepartment(ctx, parentID); err != nil {
+			return nil, err
+		}
+		department.ParentID = &parentID
+	}
+	if headID != 0 {
+		if _, err := uc.employeeRepo.GetEmployeeByID(ctx, headID); err != nil {
+			return nil, err
+		}
+		department.HeadID = &headID
+	}
+	if err := uc.repo.CreateDepartment(ctx, department); err != nil {
+		return nil, err
+	}
+	return department, nil
+}
+
+func (uc *OrganizationUsecase) ListDepartments(ctx context.Context) ([]*model.Department, error) {
+	return uc.repo.ListDepartments(ctx)
+}
+
+func (uc *OrganizationUsecase) CreatePosition(ctx context.Context, title string, departmentID uint, level int) (*model.Position, error) {
+	if strings.TrimSpace(title) == "" {
+		return nil, errors.New("position title is required")
+	}
+	position := &model.Position{Title: title, Level: level}
+	if departmentID != 0 {
+		if _, err := uc.repo.GetDepartment(ctx, departmentID); err != nil {
+			return nil, err
+		}
+		position.DepartmentID = &departmentID
+	}
+	if err := uc.repo.CreatePosition(ctx, position); err != nil {
+		return nil, err
+	}
+	return position, nil
+}
+
+func (uc *OrganizationUsecase) ListPositions(ctx context.Context, departmentID uint) ([]*model.Position, error) {
+	return uc.repo.ListPositions(ctx, departmentID)
+}
+
+// OrgTree returns the top-level departments with their sub-departments nested
+// below them.
+func (uc *OrganizationUsecase) OrgTree(ctx context.Context) ([]*DepartmentNode, error) {
+	if err := uc.repo.ApplyDueAssignments(ctx, today()); err != nil {
+		return nil, fmt.Errorf("apply due assignments: %w", err)
+	}
+	departments, err := uc.repo.ListDepartments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	headcount, err := uc.repo.Headcount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[uint]*DepartmentNode, len(departments))
+	for _, d := range departments {
+		nodes[d.ID] = &DepartmentNode{Department: d, Headcount: headcount[d.ID]}
+	}
+	var roots []*DepartmentNode
+	for _, d := range departments {
+		node := nodes[d.ID]
+		if parent, ok := nodes[derefUint(d.ParentID)]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots, nil
+}
+
+// MoveEmployee records a change of department, position or manager taking
+// effect on effectiveFrom; a zero ID keeps the employee's current value.
+// Moves dated today or earlier apply immediately; later ones are applied
+// once they become due.
+func (uc *OrganizationUsecase) MoveEmployee(ctx context.Context, employeeID, departmentID, positionID, managerID uint, effectiveFrom time.Time, reason string) (*model.EmployeeAssignment, error) {
+	employee, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	assignment := &model.EmployeeAssignment{
+		EmployeeID:    employeeID,
+		EffectiveFrom: dateOf(effectiveFrom),
+		Reason:        reason,
+	}
+	if departmentID != 0 {
+		if _, err := uc.repo.GetDepartment(ctx, departmentID); err != nil {
+			return nil, err
+		}
+		assignment.DepartmentID = &departmentID
+	}
+	if positionID != 0 {
+		position, err := uc.repo.GetPosition(ctx, positionID)
+		if err != nil {
+			return nil, err
+		}
+		department := departmentID
+		if department == 0 {
+			department = derefUint(employee.DepartmentID)
+		}
+		if position.DepartmentID != nil && *position.DepartmentID != department {
+			return nil, ErrPositionDepartment
+		}
+		assignment.PositionID = &positionID
+	}
+	if managerID != 0 {
+		if err := uc.checkManager(ctx, employeeID, managerID); err != nil {
+			return nil, err
+		}
+		assignment.ManagerID = &managerID
+	}
+
+	history, err := uc.repo.ListAssignments(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if n := len(history); n > 0 && !dateOf(history[n-1].EffectiveFrom).Before(assignment.EffectiveFrom) {
+		return nil, ErrAssignmentNotInOrder
+	}
+
+	if err := uc.repo.CreateAssignment(ctx, assignment); err != nil {
+		return nil, fmt.Errorf("create employee assignment: %w", err)
+	}
+	if err := uc.repo.ApplyDueAssignments(ctx, today()); err != nil {
+		return nil, fmt.Errorf("apply due assignments: %w", err)
+	}
+	return assignment, nil
+}
+
+// checkManager fails if managerID reports to the employee, directly or
+// indirectly, which would make the hierarchy circular. Pending moves count
+// as well as current reporting lines, so a future-dated move can't close a
+// loop once it applies.
+func (uc *OrganizationUsecase) checkManager(ctx context.Context, employeeID, managerID uint) error {
+	if err := uc.repo.ApplyDueAssignments(ctx, today()); err != nil {
+		return fmt.Errorf("apply due assignments: %w", err)
+	}
+	pending, err := uc.repo.ListPendingAssignments(ctx)
+	if err != nil {
+		return err
+	}
+	upcoming := map[uint][]uint{}
+	for _, a := range pending {
+		if a.ManagerID != nil {
+			upcoming[a.EmployeeID] = append(upcoming[a.EmployeeID], *a.ManagerID)
+		}
+	}
+
+	seen := map[uint]bool{}
+	queue := []uint{managerID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == 0 || seen[id] {
+			continue
+		}
+		if id == employeeID {
+			return ErrManagerCycle
+		}
+		seen[id] = true
+		manager, err := uc.employeeRepo.GetEmployeeByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("manager %d: %w", id, err)
+		}
+		queue = append(queue, derefUint(manager.ManagerID))
+		queue = append(queue, upcoming[id]...)
+	}
+	return nil
+}
+
+func (uc *OrganizationUsecase) ListAssignments(ctx context.Context, employeeID uint) ([]*model.EmployeeAssignment, error) {
+	return uc.repo.ListAssignments(ctx, employeeID)
+}
+
+// ListReports returns the employees reporting to managerID, or with indirect
+// set, everyone below them in the hierarchy.
+func (uc *OrganizationUsecase) ListReports(ctx context.Context, managerID uint, indirect bool) ([]*Report, error) {
+	if _, err := uc.employeeRepo.GetEmployeeByID(ctx, managerID); err != nil {
+		return nil, err
+	}
+	return listReports(ctx, uc.repo, managerID, indirect)
+}
+
+// listReports walks the reporting lines below managerID one level at a time.
+func listReports(ctx context.Context, repo repository.OrganizationRepo, managerID uint, indirect bool) ([]*Report, error) {
+	if err := repo.ApplyDueAssignments(ctx, today()); err != nil {
+		return nil, fmt.Errorf("apply due assignments: %w", err)
+	}
+
+	var reports []*Report
+	seen := map[uint]bool{managerID: true}
+	level := []uint{managerID}
+	for depth := 1; len(level) > 0; depth++ {
+		employees, err := repo.ListReports(ctx, level)
+		if err != nil {
+			return nil, err
+		}
+		level = nil
+		for _, e := range employees {
+			if seen[e.ID] {
+				continue
+			}
+			seen[e.ID] = true
+			reports = append(reports, &Report{Employee: e, Depth: depth})
+			level = append(level, e.ID)
+		}
+		if !indirect {
+			break
+		}
+	}
+	return reports, nil
+}
+
+// reportIDs returns the IDs of the employees below managerID.
+func reportIDs(ctx context.Context, repo repository.OrganizationRepo, managerID uint, indirect bool) ([]uint, error) {
+	reports, err := listReports(ctx, repo, managerID, indirect)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint, 0, len(reports))
+	for _, r := range reports {
+		ids = append(ids, r.Employee.ID)
+	}
+	return ids, nil
+}
+
+// intersectIDs returns the IDs in a that are also in b.
+func intersectIDs(a, b []uint) []uint {
+	in := make(map[uint]bool, len(b))
+	for _, id := range b {
+		in[id] = true
+	}
+	out := []uint{}
+	for _, id := range a {
+		if in[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// today is the current date in Vietnam, in the form dateOf returns.
+func today() time.Time {
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	return dateOf(time.Now().In(location))
+}
+
+func derefUint(p *uint) uint {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
@@ -0,0 +1,175 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+)
+
+var ErrOvertimeLimitExceeded = errors.New("overtime limit exceeded")
+
+// OvertimePolicy caps the overtime an employee may record. A zero limit is
+// not enforced. In warn mode (Reject unset) entries over a cap are stored and
+// the caller is told which caps were exceeded.
+type OvertimePolicy struct {
+	DailyLimit   float64
+	MonthlyLimit float64
+	YearlyLimit  float64
+	Reject       bool
+	WarnRatio    float64 // share of a limit at which OvertimeReport lists an employee
+}
+
+// OvertimeUsage is an employee's overtime against the monthly and yearly caps.
+type OvertimeUsage struct {
+	EmployeeID   uint
+	EmployeeName string
+	MonthlyHours float64
+	MonthlyLimit float64
+	YearlyHours  float64
+	YearlyLimit  float64
+	Exceeded     bool
+}
+
+// overtimeLedger keeps running overtime totals per employee so several new
+// entries (a bulk import) are checked together with what is already stored.
+type overtimeLedger struct {
+	uc      *TimesheetUsecase
+	monthly map[string]float64
+	yearly  map[string]float64
+}
+
+func (uc *TimesheetUsecase) newOvertimeLedger() *overtimeLedger {
+	return &overtimeLedger{
+		uc:      uc,
+		monthly: make(map[string]float64),
+		yearly:  make(map[string]float64),
+	}
+}
+
+// check tests ts against the caps. Exceeded caps are returned as warnings in
+// warn mode, or as an ErrOvertimeLimitExceeded error in reject mode. Accepted
+// entries are added to the running totals.
+func (l *overtimeLedger) check(ctx context.Context, ts *model.Timesheet) ([]string, error) {
+	policy := l.uc.overtime
+	if ts.IsLeave || ts.OvertimeHours <= 0 {
+		return nil, nil
+	}
+
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	y, m, _ := ts.WorkDate.In(location).Date()
+	monthStart := time.Date(y, m, 1, 0, 0, 0, 0, location)
+	yearStart := time.Date(y, 1, 1, 0, 0, 0, 0, location)
+	monthKey := fmt.Sprintf("%d/%s", ts.EmployeeID, monthStart.Format("2006-01"))
+	yearKey := fmt.Sprintf("%d/%d", ts.EmployeeID, y)
+
+	if _, ok := l.monthly[monthKey]; !ok {
+		total, err := l.uc.repo.SumOvertime(ctx, ts.EmployeeID, monthStart, monthStart.AddDate(0, 1, 0).Add(-time.Nanosecond), ts.ID)
+		if err != nil {
+			return nil, err
+		}
+		l.monthly[monthKey] = total
+	}
+	if _, ok := l.yearly[yearKey]; !ok {
+		total, err := l.uc.repo.SumOvertime(ctx, ts.EmployeeID, yearStart, yearStart.AddDate(1, 0, 0).Add(-time.Nanosecond), ts.ID)
+		if err != nil {
+			return nil, err
+		}
+		l.yearly[yearKey] = total
+	}
+
+	var exceeded []string
+	if policy.DailyLimit > 0 && ts.OvertimeHours > policy.DailyLimit {
+		exceeded = append(exceeded, fmt.Sprintf("daily overtime %.2fh exceeds the %.2fh limit", ts.OvertimeHours, policy.DailyLimit))
+	}
+	if monthly := l.monthly[monthKey] + ts.OvertimeHours; policy.MonthlyLimit > 0 && monthly > policy.MonthlyLimit {
+		exceeded = append(exceeded, fmt.Sprintf("monthly overtime %.2fh exceeds the %.2fh limit", monthly, policy.MonthlyLimit))
+	}
+	if yearly := l.yearly[yearKey] + ts.OvertimeHours; policy.YearlyLimit > 0 && yearly > policy.YearlyLimit {
+		exceeded = append(exceeded, fmt.Sprintf("yearly overtime %.2fh exceeds the %.2fh limit", yearly, policy.YearlyLimit))
+	}
+
+	if len(exceeded) > 0 && policy.Reject {
+		return nil, fmt.Errorf("%w: %s", ErrOvertimeLimitExceeded, strings.Join(exceeded, "; "))
+	}
+	l.monthly[monthKey] += ts.OvertimeHours
+	l.yearly[yearKey] += ts.OvertimeHours
+	return exceeded, nil
+}
+
+// OvertimeReport lists employees whose overtime for the month, or for the year
+// up to the end of that month, has reached the policy's warn ratio of a cap.
+func (uc *TimesheetUsecase) OvertimeReport(ctx context.Context, monthYearStr string) ([]*OvertimeUsage, error) {
+	monthYear, err := time.Parse("2006-01", monthYearStr)
+	if err != nil {
+		return nil, errors.New("invalid month_year format, expected YYYY-MM")
+	}
+
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	monthStart := time.Date(monthYear.Year(), monthYear.Month(), 1, 0, 0, 0, 0, location)
+	monthEnd := monthStart.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	yearStart := time.Date(monthYear.Year(), 1, 1, 0, 0, 0, 0, location)
+
+	monthly, err := uc.repo.OvertimeTotals(ctx, monthStart, monthEnd)
+	if err != nil {
+		return nil, err
+	}
+	yearly, err := uc.repo.OvertimeTotals(ctx, yearStart, monthEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	ratio := uc.overtime.WarnRatio
+	if ratio <= 0 {
+		ratio = 0.8
+	}
+	share := func(hours, limit float64) float64 {
+		if limit <= 0 {
+			return 0
+		}
+		return hours / limit
+	}
+
+	var items []*OvertimeUsage
+	var ids []uint
+	for id, yearHours := range yearly {
+		u := &OvertimeUsage{
+			EmployeeID:   id,
+			MonthlyHours: monthly[id],
+			MonthlyLimit: uc.overtime.MonthlyLimit,
+			YearlyHours:  yearHours,
+			YearlyLimit:  uc.overtime.YearlyLimit,
+		}
+		monthShare, yearShare := share(u.MonthlyHours, u.MonthlyLimit), share(u.YearlyHours, u.YearlyLimit)
+		if monthShare < ratio && yearShare < ratio {
+			continue
+		}
+		u.Exceeded = monthShare > 1 || yearShare > 1
+		items = append(items, u)
+		ids = append(ids, id)
+	}
+
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("load employees: %w", err)
+	}
+	names := make(map[uint]string, len(employees))
+	for _, e := range employees {
+		names[e.ID] = e.Name
+	}
+	for _, u := range items {
+		u.EmployeeName = names[u.EmployeeID]
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].YearlyHours != items[j].YearlyHours {
+			return items[i].YearlyHours > items[j].YearlyHours
+		}
+		return items[i].EmployeeID < items[j].EmployeeID
+	})
+	return items, nil
+}
@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"myapp/internal/data/model"
+)
+
+func TestOvertimeLedgerCheck(t *testing.T) {
+	caps := OvertimePolicy{DailyLimit: 4, MonthlyLimit: 40, YearlyLimit: 200}
+	reject := caps
+	reject.Reject = true
+
+	tests := []struct {
+		name            string
+		policy          OvertimePolicy
+		monthly, yearly float64   // already stored
+		entries         []float64 // overtime hours, checked in order
+		leave           bool
+		wantWarnings    []int // per entry
+		wantRejected    bool  // the last entry
+	}{
+		{
+			name:         "within every cap",
+			policy:       caps,
+			monthly:      10,
+			yearly:       50,
+			entries:      []float64{4},
+			wantWarnings: []int{0},
+		},
+		{
+			name:         "daily cap warns",
+			policy:       caps,
+			entries:      []float64{5},
+			wantWarnings: []int{1},
+		},
+		{
+			name:         "daily cap rejects",
+			policy:       reject,
+			entries:      []float64{5},
+			wantRejected: true,
+		},
+		{
+			name:         "monthly cap reached by stored overtime",
+			policy:       caps,
+			monthly:      38,
+			yearly:       38,
+			entries:      []float64{3},
+			wantWarnings: []int{1},
+		},
+		{
+			name:         "yearly cap reached by stored overtime",
+			policy:       reject,
+			monthly:      10,
+			yearly:       198,
+			entries:      []float64{3},
+			wantRejected: true,
+		},
+		{
+			name:         "daily, monthly and yearly caps together",
+			policy:       caps,
+			monthly:      39,
+			yearly:       199,
+			entries:      []float64{5},
+			wantWarnings: []int{3},
+		},
+		{
+			name:         "entries of one import add up",
+			policy:       caps,
+			monthly:      32,
+			yearly:       32,
+			entries:      []float64{4, 4, 4},
+			wantWarnings: []int{0, 0, 1},
+		},
+		{
+			name:         "reject mode counts earlier entries of an import",
+			policy:       reject,
+			monthly:      36,
+			yearly:       36,
+			entries:      []float64{4, 4},
+			wantWarnings: []int{0},
+			wantRejected: true,
+		},
+		{
+			name:         "zero limits are not enforced",
+			policy:       OvertimePolicy{Reject: true},
+			monthly:      500,
+			yearly:       5000,
+			entries:      []float64{12},
+			wantWarnings: []int{0},
+		},
+		{
+			name:         "leave is not checked",
+			policy:       reject,
+			monthly:      40,
+			yearly:       200,
+			entries:      []float64{8},
+			leave:        true,
+			wantWarnings: []int{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &TimesheetUsecase{repo: &fakeTimesheets{monthly: tt.monthly, yearly: tt.yearly}, overtime: tt.policy}
+			ledger := uc.newOvertimeLedger()
+			for i, hours := range tt.entries {
+				ts := &model.Timesheet{
+					EmployeeID:    1,
+					WorkDate:      time.Date(2026, 6, 1+i, 0, 0, 0, 0, time.UTC),
+					OvertimeHours: hours,
+					IsLeave:       tt.leave,
+				}
+				warnings, err := ledger.check(context.Background(), ts)
+				if last := i == len(tt.entries)-1; last && tt.wantRejected {
+					if !errors.Is(err, ErrOvertimeLimitExceeded) {
+						t.Fatalf("entry %d: got %v, want ErrOvertimeLimitExceeded", i, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("entry %d: %v", i, err)
+				}
+				if len(warnings) != tt.wantWarnings[i] {
+					t.Fatalf("entry %d: got warnings %q, want %d", i, warnings, tt.wantWarnings[i])
+				}
+			}
+		})
+	}
+}
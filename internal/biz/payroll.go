@@ -13,6 +13,7 @@ import (
 
 	v1 "myapp/api/payroll/v1"
 	"myapp/internal/data/model"
+	"myapp/internal/pagination"
 	"myapp/internal/repository"
 
 	"github.com/jung-kurt/gofpdf"
@@ -21,31 +22,61 @@ import (
 var (
 	ErrEmployeeNotFound      = errors.New("employee not found")
 	ErrNoAttendanceThisMonth = errors.New("no attendance records found for this month")
+	ErrAttendanceNotApproved = errors.New("attendance for this month has not been approved yet")
+	ErrPayslipOnPaper        = errors.New("employee receives printed payslips; pass to_email to email one anyway")
+	ErrNoPayslipAddress      = errors.New("employee has no email address on file; pass to_email")
 )
 
+// PendingTimesheets is an employee whose timesheets for a payroll month are
+// not fully approved.
+type PendingTimesheets struct {
+	EmployeeID   uint
+	EmployeeName string
+	Draft        int
+	Submitted    int
+}
+
 type PayrollUsecase struct {
 	payrollRepo   repository.PayrollRepo
-	employeeRepo  repository.EmployeeRepo 
+	employeeRepo  repository.EmployeeRepo
 	timesheetRepo repository.TimesheetRepo
-	emailRepo repository.EmailRepo
+	orgRepo       repository.OrganizationRepo
+	contractRepo  repository.ContractRepo
+	dependentRepo repository.DependentRepo
+	emailRepo     repository.EmailRepo
+	pii           *PIIPolicy
 }
 
 func NewPayrollUsecase(
 	payrollRepo repository.PayrollRepo,
 	employeeRepo repository.EmployeeRepo,
 	timesheetRepo repository.TimesheetRepo,
+	orgRepo repository.OrganizationRepo,
+	contractRepo repository.ContractRepo,
+	dependentRepo repository.DependentRepo,
 	emailRepo repository.EmailRepo,
+	pii *PIIPolicy,
 ) *PayrollUsecase {
 	return &PayrollUsecase{
 		payrollRepo:   payrollRepo,
 		employeeRepo:  employeeRepo,
 		timesheetRepo: timesheetRepo,
-		emailRepo: emailRepo,
+		orgRepo:       orgRepo,
+		contractRepo:  contractRepo,
+		dependentRepo: dependentRepo,
+		emailRepo:     emailRepo,
+		pii:           pii,
 	}
 }
 
+// CanViewPII reports whether the caller may see tax IDs and bank accounts
+// unmasked.
+func (uc *PayrollUsecase) CanViewPII(ctx context.Context) bool {
+	return uc.pii.CanView(ctx)
+}
+
 func (uc *PayrollUsecase) CalculatePayroll(ctx context.Context, r *v1.CalculatePayrollRequest) (*v1.CalculatePayrollReply, error) {
-	
+
 	monthYear, err := time.Parse("2006-01", r.MonthYear)
 	if err != nil {
 		return nil, errors.New("invalid month_year format, expected YYYY-MM")
@@ -55,6 +86,11 @@ func (uc *PayrollUsecase) CalculatePayroll(ctx context.Context, r *v1.CalculateP
 	if err != nil {
 		return nil, fmt.Errorf("get employee: %w", err)
 	}
+	// The month of the last working day is paid with the final settlement.
+	if emp.Status == model.EmployeeTerminated &&
+		(emp.TerminatedAt == nil || !monthYear.Before(time.Date(emp.TerminatedAt.Year(), emp.TerminatedAt.Month(), 1, 0, 0, 0, 0, time.UTC))) {
+		return nil, ErrEmployeeTerminated
+	}
 
 	workingDays, overtimeHours, leaveDays, err := uc.timesheetRepo.GetMonthlySummary(
 		ctx, uint(r.EmployeeId), monthYear.Year(), monthYear.Month())
@@ -62,21 +98,56 @@ func (uc *PayrollUsecase) CalculatePayroll(ctx context.Context, r *v1.CalculateP
 		return nil, fmt.Errorf("get timesheet monthly summary: %w", err)
 	}
 
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	monthStart := time.Date(monthYear.Year(), monthYear.Month(), 1, 0, 0, 0, 0, location)
+	pending, err := uc.timesheetRepo.ListPending(ctx, monthStart, monthStart.AddDate(0, 1, 0).Add(-time.Nanosecond), uint(r.EmployeeId))
+	if err != nil {
+		return nil, fmt.Errorf("get pending timesheets: %w", err)
+	}
+	unapproved := 0
+	for _, p := range pending {
+		unapproved += p.Draft + p.Submitted
+	}
+
 	if workingDays+leaveDays == 0 {
+		if unapproved > 0 {
+			return nil, ErrAttendanceNotApproved
+		}
 		return nil, ErrNoAttendanceThisMonth
 	}
 
-	const standardWorkingDays = 26.0
-	basicSalary := emp.BaseSalary * (float64(workingDays) / standardWorkingDays)
+	monthEnd := monthStart.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	rows, err := uc.timesheetRepo.ListRange(ctx, monthStart, monthEnd, []uint{emp.ID})
+	if err != nil {
+		return nil, fmt.Errorf("get timesheets: %w", err)
+	}
+	var worked []time.Time
+	for _, ts := range rows {
+		if !ts.IsLeave && ts.Status == model.TimesheetApproved {
+			worked = append(worked, dateOf(ts.WorkDate))
+		}
+	}
+	contracts, err := uc.contractRepo.ListContracts(ctx, emp.ID, dateOf(monthStart), dateOf(monthEnd))
+	if err != nil {
+		return nil, fmt.Errorf("get contracts: %w", err)
+	}
+
+	// Days worked under a probation contract are paid at its percentage.
+	basicSalary := earnedSalary(emp.BaseSalary, worked, contracts)
 	hourlyRate := emp.BaseSalary / (standardWorkingDays * 8)
 	overtimePay := overtimeHours * hourlyRate * 1.5
 	grossSalary := basicSalary + overtimePay + r.Allowances
 
 	insurance := grossSalary * 0.105
 
+	// Only dependents registered for this month earn the family deduction.
+	dependents, err := uc.dependentRepo.CountValid(ctx, emp.ID, monthYear)
+	if err != nil {
+		return nil, fmt.Errorf("count dependents: %w", err)
+	}
 	taxable := grossSalary - insurance - 11_000_000
-	if emp.Dependents > 0 {
-		taxable -= float64(emp.Dependents) * 4_400_000
+	if dependents > 0 {
+		taxable -= float64(dependents) * 4_400_000
 	}
 
 	incomeTax := calculateIncomeTax(taxable)
@@ -89,6 +160,7 @@ func (uc *PayrollUsecase) CalculatePayroll(ctx context.Context, r *v1.CalculateP
 		WorkingDays:   workingDays,
 		OvertimeHours: overtimeHours,
 		LeaveDays:     leaveDays,
+		Dependents:    dependents,
 		BasicSalary:   basicSalary,
 		Allowances:    r.Allowances,
 		GrossSalary:   grossSalary,
@@ -102,15 +174,94 @@ func (uc *PayrollUsecase) CalculatePayroll(ctx context.Context, r *v1.CalculateP
 	}
 
 	return &v1.CalculatePayrollReply{
-		GrossSalary:   grossSalary,
-		NetSalary:     netSalary,
-		Deductions:    totalDeductions,
-		WorkingDays:   int32(workingDays),
-		OvertimeHours: overtimeHours,
-		LeaveDays:     int32(leaveDays),
+		GrossSalary:       grossSalary,
+		NetSalary:         netSalary,
+		Deductions:        totalDeductions,
+		WorkingDays:       int32(workingDays),
+		OvertimeHours:     overtimeHours,
+		LeaveDays:         int32(leaveDays),
+		UnapprovedEntries: int32(unapproved),
+		Dependents:        int32(dependents),
 	}, nil
 }
 
+// ListPayrolls returns a page of calculated payrolls, latest month first.
+// monthYear and employeeID are optional filters.
+func (uc *PayrollUsecase) ListPayrolls(ctx context.Context, monthYearStr string, employeeID uint, pageSize int32, pageToken string) ([]*model.Payroll, string, error) {
+	filter := repository.PayrollFilter{EmployeeID: employeeID}
+	if monthYearStr != "" {
+		monthYear, err := time.Parse("2006-01", monthYearStr)
+		if err != nil {
+			return nil, "", errors.New("invalid month_year format, expected YYYY-MM")
+		}
+		filter.MonthYear = &monthYear
+	}
+	page, err := pagination.New(pageSize, pageToken, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	return uc.payrollRepo.List(ctx, filter, page)
+}
+
+// ListPendingTimesheets reports employees whose timesheets for the month are
+// still draft or awaiting approval and therefore left out of payroll. A
+// non-zero managerID limits the list to that manager's direct and indirect
+// reports.
+func (uc *PayrollUsecase) ListPendingTimesheets(ctx context.Context, monthYearStr string, managerID uint) ([]*PendingTimesheets, error) {
+	monthYear, err := time.Parse("2006-01", monthYearStr)
+	if err != nil {
+		return nil, errors.New("invalid month_year format, expected YYYY-MM")
+	}
+
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	start := time.Date(monthYear.Year(), monthYear.Month(), 1, 0, 0, 0, 0, location)
+	pending, err := uc.timesheetRepo.ListPending(ctx, start, start.AddDate(0, 1, 0).Add(-time.Nanosecond), 0)
+	if err != nil {
+		return nil, err
+	}
+	if managerID != 0 {
+		reports, err := reportIDs(ctx, uc.orgRepo, managerID, true)
+		if err != nil {
+			return nil, err
+		}
+		inScope := make(map[uint]bool, len(reports))
+		for _, id := range reports {
+			inScope[id] = true
+		}
+		scoped := pending[:0]
+		for _, p := range pending {
+			if inScope[p.EmployeeID] {
+				scoped = append(scoped, p)
+			}
+		}
+		pending = scoped
+	}
+
+	ids := make([]uint, 0, len(pending))
+	for _, p := range pending {
+		ids = append(ids, p.EmployeeID)
+	}
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("load employees: %w", err)
+	}
+	names := make(map[uint]string, len(employees))
+	for _, e := range employees {
+		names[e.ID] = e.Name
+	}
+
+	items := make([]*PendingTimesheets, 0, len(pending))
+	for _, p := range pending {
+		items = append(items, &PendingTimesheets{
+			EmployeeID:   p.EmployeeID,
+			EmployeeName: names[p.EmployeeID],
+			Draft:        p.Draft,
+			Submitted:    p.Submitted,
+		})
+	}
+	return items, nil
+}
+
 func calculateIncomeTax(income float64) float64 {
 	if income <= 0 {
 		return 0
@@ -149,7 +300,6 @@ func (uc *PayrollUsecase) ExportPayrollPDF(ctx context.Context, employeeID uint3
 		return nil, fmt.Errorf("get employee info: %w", err)
 	}
 
-	const standardWorkingDays = 26.0
 	hourlyRate := emp.BaseSalary / (standardWorkingDays * 8)
 	overtimePay := payroll.OvertimeHours * hourlyRate * 1.5
 	basicAndAllowances := payroll.GrossSalary - overtimePay
@@ -173,7 +323,7 @@ func (uc *PayrollUsecase) ExportPayrollPDF(ctx context.Context, employeeID uint3
 
 	pdf.Ln(5)
 
-	pdf.SetFillColor(230, 230, 250) 
+	pdf.SetFillColor(230, 230, 250)
 	pdf.SetFont("Arial", "B", 14)
 	pdf.CellFormat(120, 12, "Description", "1", 0, "C", true, 0, "")
 	pdf.CellFormat(70, 12, "Quantity", "1", 0, "C", true, 0, "")
@@ -239,16 +389,48 @@ func formatCurrency(amount float64) string {
 	return result.String() + " VND"
 }
 
-func (uc *PayrollUsecase) SendPayslipEmail(ctx context.Context, employeeID uint32, monthYearStr, toEmail string) error {
-	pdfData, err := uc.ExportPayrollPDF(ctx, employeeID, monthYearStr)
+// SendPayslipEmail emails the payslip to toEmail, or when it is empty to the
+// address of the employee's preferred payslip channel. It returns the address
+// the payslip was sent to.
+func (uc *PayrollUsecase) SendPayslipEmail(ctx context.Context, employeeID uint32, monthYearStr, toEmail string) (string, error) {
+	emp, err := uc.employeeRepo.GetEmployeeByID(ctx, uint(employeeID))
 	if err != nil {
-		return fmt.Errorf("generate PDF: %w", err)
+		return "", fmt.Errorf("get employee: %w", err)
+	}
+	if toEmail == "" {
+		if toEmail, err = payslipAddress(emp); err != nil {
+			return "", err
+		}
 	}
 
-	emp, err := uc.employeeRepo.GetEmployeeByID(ctx, uint(employeeID))
+	pdfData, err := uc.ExportPayrollPDF(ctx, employeeID, monthYearStr)
 	if err != nil {
-		return fmt.Errorf("get employee: %w", err)
+		return "", fmt.Errorf("generate PDF: %w", err)
+	}
+
+	if err := uc.emailRepo.SendPayslip(ctx, toEmail, emp.Name, monthYearStr, pdfData); err != nil {
+		return "", err
 	}
+	return toEmail, nil
+}
 
-	return uc.emailRepo.SendPayslip(ctx, toEmail, emp.Name, monthYearStr, pdfData)
-}
\ No newline at end of file
+// payslipAddress picks the email address for the employee's payslip channel,
+// falling back to the other email when the work address is missing.
+func payslipAddress(emp *model.Employee) (string, error) {
+	switch emp.PayslipChannel {
+	case model.PayslipPaper:
+		return "", ErrPayslipOnPaper
+	case model.PayslipPersonalEmail:
+		if emp.PersonalEmail != "" {
+			return emp.PersonalEmail, nil
+		}
+	default:
+		if emp.WorkEmail != "" {
+			return emp.WorkEmail, nil
+		}
+		if emp.PersonalEmail != "" {
+			return emp.PersonalEmail, nil
+		}
+	}
+	return "", ErrNoPayslipAddress
+}
@@ -0,0 +1,154 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "myapp/api/payroll/v1"
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// payrollTimesheets adds the monthly summary, which the real repo builds
+// from approved timesheets only, to the listed rows.
+type payrollTimesheets struct {
+	*fakeTimesheets
+	workingDays, leaveDays int
+	overtimeHours          float64
+	pending                []*repository.PendingTimesheets
+}
+
+func (f *payrollTimesheets) GetMonthlySummary(context.Context, uint, int, time.Month) (int, float64, int, error) {
+	return f.workingDays, f.overtimeHours, f.leaveDays, nil
+}
+
+func (f *payrollTimesheets) ListPending(context.Context, time.Time, time.Time, uint) ([]*repository.PendingTimesheets, error) {
+	return f.pending, nil
+}
+
+type fakePayrolls struct {
+	repository.PayrollRepo
+	saved []*model.Payroll
+}
+
+func (f *fakePayrolls) SavePayroll(_ context.Context, p *model.Payroll) error {
+	f.saved = append(f.saved, p)
+	return nil
+}
+
+type noDependents struct {
+	repository.DependentRepo
+}
+
+func (noDependents) CountValid(context.Context, uint, time.Time) (int, error) {
+	return 0, nil
+}
+
+func TestCalculatePayroll(t *testing.T) {
+	lastDay, terminatedAt := date(2026, 3, 20), date(2026, 4, 10)
+	// The real summary only counts the approved rows of settlementTimesheets.
+	summary := payrollTimesheets{workingDays: 5, overtimeHours: 2,
+		pending: []*repository.PendingTimesheets{{EmployeeID: 1, Draft: 2, Submitted: 1}}}
+
+	tests := []struct {
+		name       string
+		status     string
+		terminated *time.Time
+		month      string
+		timesheets payrollTimesheets
+		want       error
+
+		basic, gross float64
+		unapproved   int32
+	}{
+		{
+			// 5 approved days at 1,000,000 plus 2 overtime hours at 1.5 times
+			// the hourly rate of 125,000; drafts and submitted days are left out.
+			name:       "only approved days are paid",
+			status:     model.EmployeeOfficial,
+			month:      "2026-03",
+			timesheets: summary,
+			basic:      5_000_000, gross: 5_375_000,
+			unapproved: 3,
+		},
+		{
+			name:       "month before the last working day",
+			status:     model.EmployeeTerminated,
+			terminated: &terminatedAt,
+			month:      "2026-03",
+			timesheets: summary,
+			basic:      5_000_000, gross: 5_375_000,
+			unapproved: 3,
+		},
+		{
+			// Paid with the final settlement instead.
+			name:       "month of the last working day",
+			status:     model.EmployeeTerminated,
+			terminated: &lastDay,
+			month:      "2026-03",
+			timesheets: summary,
+			want:       ErrEmployeeTerminated,
+		},
+		{
+			name:       "month after termination",
+			status:     model.EmployeeTerminated,
+			terminated: &lastDay,
+			month:      "2026-04",
+			timesheets: summary,
+			want:       ErrEmployeeTerminated,
+		},
+		{
+			name:       "nothing approved yet",
+			status:     model.EmployeeOfficial,
+			month:      "2026-03",
+			timesheets: payrollTimesheets{pending: summary.pending},
+			want:       ErrAttendanceNotApproved,
+		},
+		{
+			name:   "no attendance",
+			status: model.EmployeeOfficial,
+			month:  "2026-03",
+			want:   ErrNoAttendanceThisMonth,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timesheets := tt.timesheets
+			timesheets.fakeTimesheets = &fakeTimesheets{rows: settlementTimesheets(1)}
+			payrolls := &fakePayrolls{}
+			uc := &PayrollUsecase{
+				payrollRepo: payrolls,
+				employeeRepo: &fakeEmployees{employees: []*model.Employee{
+					{Model: gorm.Model{ID: 1}, JoinDate: date(2023, 3, 15), BaseSalary: testBaseSalary, Status: tt.status, TerminatedAt: tt.terminated},
+				}},
+				timesheetRepo: &timesheets,
+				contractRepo:  &fakeContracts{},
+				dependentRepo: noDependents{},
+			}
+			reply, err := uc.CalculatePayroll(context.Background(), &v1.CalculatePayrollRequest{EmployeeId: 1, MonthYear: tt.month})
+			if tt.want != nil {
+				if !errors.Is(err, tt.want) {
+					t.Fatalf("got %v, want %v", err, tt.want)
+				}
+				if len(payrolls.saved) != 0 {
+					t.Fatal("payroll was saved")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CalculatePayroll: %v", err)
+			}
+			if len(payrolls.saved) != 1 || payrolls.saved[0].BasicSalary != tt.basic {
+				t.Fatalf("saved %+v, want basic salary %v", payrolls.saved, tt.basic)
+			}
+			if reply.GrossSalary != tt.gross || reply.UnapprovedEntries != tt.unapproved {
+				t.Fatalf("got gross %v with %d unapproved entries, want %v and %d",
+					reply.GrossSalary, reply.UnapprovedEntries, tt.gross, tt.unapproved)
+			}
+		})
+	}
+}
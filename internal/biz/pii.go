@@ -0,0 +1,40 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+var ErrPIIForbidden = errors.New("not allowed to access unmasked personal data")
+
+// PIIPolicy decides who may see bank accounts, tax IDs and salaries in full:
+// roles holding PermViewPII and the usernames listed in the configuration.
+// Everyone else gets masked values.
+type PIIPolicy struct {
+	viewers map[string]bool
+}
+
+// NewPIIPolicy additionally allows the listed usernames to see personal data.
+func NewPIIPolicy(viewers []string) *PIIPolicy {
+	p := &PIIPolicy{viewers: make(map[string]bool, len(viewers))}
+	for _, v := range viewers {
+		p.viewers[v] = true
+	}
+	return p
+}
+
+// CanView reports whether the caller on ctx may see unmasked personal data.
+func (p *PIIPolicy) CanView(ctx context.Context) bool {
+	user, ok := UserFromContext(ctx)
+	return ok && (user.Can(PermViewPII) || p.viewers[user.Username])
+}
+
+// MaskTail hides all but the last 4 characters of value.
+func MaskTail(value string) string {
+	const visible = 4
+	if len(value) <= visible {
+		return strings.Repeat("*", len(value))
+	}
+	return strings.Repeat("*", len(value)-visible) + value[len(value)-visible:]
+}
@@ -0,0 +1,292 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+)
+
+var (
+	ErrInvalidShiftTime  = errors.New("invalid shift time, expected HH:MM")
+	ErrShiftEndsEarly    = errors.New("shift ends before it starts; mark it as a night shift")
+	ErrEmptyPattern      = errors.New("schedule pattern must contain at least one day")
+	ErrScheduleOverlap   = errors.New("employee already has a schedule starting on or after this date")
+	ErrInvalidDateRange  = errors.New("invalid date range")
+	ErrDateRangeTooLarge = errors.New("date range must not exceed 366 days")
+)
+
+// ScheduledDay is the shift an employee is rostered on for a given date.
+// Shift is nil when the schedule marks the date as a day off.
+type ScheduledDay struct {
+	Date       time.Time
+	ScheduleID uint
+	Shift      *model.Shift
+}
+
+type ScheduleUsecase struct {
+	repo         repository.ScheduleRepo
+	employeeRepo repository.EmployeeRepo
+}
+
+func NewScheduleUsecase(repo repository.ScheduleRepo, employeeRepo repository.EmployeeRepo) *ScheduleUsecase {
+	return &ScheduleUsecase{repo: repo, employeeRepo: employeeRepo}
+}
+
+func (uc *ScheduleUsecase) CreateShift(ctx context.Context, name, startTime, endTime string, breakMinutes int, isNightShift bool) (*model.Shift, error) {
+	start, err := parseClock(startTime)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseClock(endTime)
+	if err != nil {
+		return nil, err
+	}
+	if end <= start && !isNightShift {
+		return nil, ErrShiftEndsEarly
+	}
+	if breakMinutes < 0 {
+		return nil, errors.New("break_minutes must not be negative")
+	}
+
+	shift := &model.Shift{
+		Name:         name,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		BreakMinutes: breakMinutes,
+		IsNightShift: isNightShift,
+	}
+	if ShiftHours(shift) <= 0 {
+		return nil, errors.New("break is longer than the shift")
+	}
+	if err := uc.repo.CreateShift(ctx, shift); err != nil {
+		return nil, err
+	}
+	return shift, nil
+}
+
+func (uc *ScheduleUsecase) ListShifts(ctx context.Context) ([]*model.Shift, error) {
+	return uc.repo.ListShifts(ctx)
+}
+
+func (uc *ScheduleUsecase) CreateSchedule(ctx context.Context, name string, cycleStart time.Time, shiftIDs []uint) (*model.WorkSchedule, error) {
+	if len(shiftIDs) == 0 {
+		return nil, ErrEmptyPattern
+	}
+	days := make([]string, 0, len(shiftIDs))
+	for _, id := range shiftIDs {
+		if id != 0 {
+			if _, err := uc.repo.GetShift(ctx, id); err != nil {
+				return nil, fmt.Errorf("shift %d: %w", id, err)
+			}
+		}
+		days = append(days, strconv.FormatUint(uint64(id), 10))
+	}
+
+	schedule := &model.WorkSchedule{
+		Name:       name,
+		CycleStart: dateOf(cycleStart),
+		Pattern:    strings.Join(days, ","),
+	}
+	if err := uc.repo.CreateSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func (uc *ScheduleUsecase) ListSchedules(ctx context.Context) ([]*model.WorkSchedule, error) {
+	return uc.repo.ListSchedules(ctx)
+}
+
+// AssignSchedule puts an employee on a schedule from effectiveFrom onwards.
+// Earlier assignments still running then end the day before. When the new
+// assignment is bounded, the part of an earlier one that ran past its end is
+// kept, so the employee returns to that schedule afterwards.
+func (uc *ScheduleUsecase) AssignSchedule(ctx context.Context, scheduleID, employeeID uint, effectiveFrom time.Time, effectiveTo *time.Time) (*model.ScheduleAssignment, error) {
+	if _, err := uc.repo.GetSchedule(ctx, scheduleID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID); err != nil {
+		return nil, err
+	}
+
+	from := dateOf(effectiveFrom)
+	var to *time.Time
+	if effectiveTo != nil {
+		d := dateOf(*effectiveTo)
+		if d.Before(from) {
+			return nil, ErrInvalidDateRange
+		}
+		to = &d
+	}
+
+	until := from.AddDate(100, 0, 0)
+	if to != nil {
+		until = *to
+	}
+	existing, err := uc.repo.ListAssignments(ctx, employeeID, from, until)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment := &model.ScheduleAssignment{
+		EmployeeID:    employeeID,
+		ScheduleID:    scheduleID,
+		EffectiveFrom: from,
+		EffectiveTo:   to,
+	}
+	// Nothing is written until every overlap is known to be resolvable.
+	var closed []*model.ScheduleAssignment
+	created := []*model.ScheduleAssignment{assignment}
+	for _, a := range existing {
+		if !dateOf(a.EffectiveFrom).Before(from) {
+			return nil, ErrScheduleOverlap
+		}
+		if to != nil && (a.EffectiveTo == nil || dateOf(*a.EffectiveTo).After(*to)) {
+			created = append(created, &model.ScheduleAssignment{
+				EmployeeID:    a.EmployeeID,
+				ScheduleID:    a.ScheduleID,
+				EffectiveFrom: to.AddDate(0, 0, 1),
+				EffectiveTo:   a.EffectiveTo,
+			})
+		}
+		dayBefore := from.AddDate(0, 0, -1)
+		a.EffectiveTo = &dayBefore
+		closed = append(closed, a)
+	}
+	if err := uc.repo.SaveAssignments(ctx, closed, created); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+func (uc *ScheduleUsecase) GetEmployeeSchedule(ctx context.Context, employeeID uint, from, to time.Time) ([]*ScheduledDay, error) {
+	from, to = dateOf(from), dateOf(to)
+	if to.Before(from) {
+		return nil, ErrInvalidDateRange
+	}
+	if to.Sub(from) > 366*24*time.Hour {
+		return nil, ErrDateRangeTooLarge
+	}
+	return resolveSchedule(ctx, uc.repo, employeeID, from, to)
+}
+
+// resolveSchedule expands the employee's schedule assignments into one
+// ScheduledDay per date in [from, to]. Dates not covered by any assignment
+// are left out.
+func resolveSchedule(ctx context.Context, repo repository.ScheduleRepo, employeeID uint, from, to time.Time) ([]*ScheduledDay, error) {
+	from, to = dateOf(from), dateOf(to)
+	assignments, err := repo.ListAssignments(ctx, employeeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make(map[uint]*model.WorkSchedule)
+	shifts := make(map[uint]*model.Shift)
+
+	var days []*ScheduledDay
+	for _, a := range assignments {
+		schedule, ok := schedules[a.ScheduleID]
+		if !ok {
+			schedule, err = repo.GetSchedule(ctx, a.ScheduleID)
+			if err != nil {
+				return nil, err
+			}
+			schedules[a.ScheduleID] = schedule
+		}
+		pattern, err := parsePattern(schedule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %d: %w", schedule.ID, err)
+		}
+
+		start := dateOf(a.EffectiveFrom)
+		if start.Before(from) {
+			start = from
+		}
+		end := to
+		if a.EffectiveTo != nil && dateOf(*a.EffectiveTo).Before(end) {
+			end = dateOf(*a.EffectiveTo)
+		}
+
+		for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+			day := &ScheduledDay{Date: d, ScheduleID: schedule.ID}
+			shiftID := pattern[cycleIndex(dateOf(schedule.CycleStart), d, len(pattern))]
+			if shiftID != 0 {
+				shift, ok := shifts[shiftID]
+				if !ok {
+					shift, err = repo.GetShift(ctx, shiftID)
+					if err != nil {
+						return nil, err
+					}
+					shifts[shiftID] = shift
+				}
+				day.Shift = shift
+			}
+			days = append(days, day)
+		}
+	}
+	return days, nil
+}
+
+// ShiftHours is the number of paid hours in a shift, i.e. its length minus breaks.
+func ShiftHours(shift *model.Shift) float64 {
+	start, _ := parseClock(shift.StartTime)
+	end, _ := parseClock(shift.EndTime)
+	if end <= start {
+		end += 24 * time.Hour
+	}
+	return (end - start - time.Duration(shift.BreakMinutes)*time.Minute).Hours()
+}
+
+// shiftStart is the moment the shift begins on the given date in the company timezone.
+func shiftStart(shift *model.Shift, date time.Time) time.Time {
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	offset, _ := parseClock(shift.StartTime)
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, location).Add(offset)
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, ErrInvalidShiftTime
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func parsePattern(pattern string) ([]uint, error) {
+	parts := strings.Split(pattern, ",")
+	ids := make([]uint, 0, len(parts))
+	for _, p := range parts {
+		id, err := strconv.ParseUint(strings.TrimSpace(p), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule pattern %q", pattern)
+		}
+		ids = append(ids, uint(id))
+	}
+	if len(ids) == 0 {
+		return nil, ErrEmptyPattern
+	}
+	return ids, nil
+}
+
+// cycleIndex returns which day of a rotation of length n the date falls on.
+func cycleIndex(cycleStart, date time.Time, n int) int {
+	days := int(date.Sub(cycleStart).Hours() / 24)
+	idx := days % n
+	if idx < 0 {
+		idx += n
+	}
+	return idx
+}
+
+// dateOf strips the clock from t, keeping its calendar date.
+func dateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,182 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+)
+
+// APIKeyPrefix starts every API key, so keys are recognisable in headers and
+// secret scanners.
+const APIKeyPrefix = "hrk_"
+
+const (
+	defaultAPIKeyTTL = 365 * 24 * time.Hour
+	maxAPIKeyTTL     = 2 * 365 * 24 * time.Hour
+
+	// Last use and its IP are written at most this often per key, however
+	// often the IP changes.
+	apiKeyTouchInterval = time.Minute
+)
+
+var (
+	ErrInvalidAPIKey          = errors.New("invalid, expired or revoked api key")
+	ErrServiceAccountName     = errors.New("service account name is required")
+	ErrServiceAccountDisabled = errors.New("service account is disabled")
+	ErrAPIKeyScopes           = errors.New("api key needs at least one scope")
+	ErrAPIKeyExpiry           = errors.New("api key expiry must be in the future and at most two years away")
+	ErrInvalidScope           = errors.New("scope can't be granted to an api key")
+)
+
+type ServiceAccountUsecase struct {
+	repo repository.ServiceAccountRepo
+}
+
+func NewServiceAccountUsecase(repo repository.ServiceAccountRepo) *ServiceAccountUsecase {
+	return &ServiceAccountUsecase{repo: repo}
+}
+
+func (uc *ServiceAccountUsecase) Create(ctx context.Context, name, description string) (*model.ServiceAccount, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrServiceAccountName
+	}
+	account := &model.ServiceAccount{Name: name, Description: strings.TrimSpace(description)}
+	if user, ok := UserFromContext(ctx); ok && user.ID != 0 {
+		account.CreatedBy = &user.ID
+	}
+	if err := uc.repo.Create(ctx, account); err != nil {
+		return nil, fmt.Errorf("create service account: %w", err)
+	}
+	return account, nil
+}
+
+func (uc *ServiceAccountUsecase) List(ctx context.Context) ([]*model.ServiceAccount, error) {
+	return uc.repo.List(ctx)
+}
+
+// Disable stops all keys of the account from working.
+func (uc *ServiceAccountUsecase) Disable(ctx context.Context, id uint) (*model.ServiceAccount, error) {
+	if _, err := uc.repo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Disable(ctx, id, time.Now()); err != nil {
+		return nil, err
+	}
+	return uc.repo.Get(ctx, id)
+}
+
+// CreateKey issues a key for the account limited to scopes. The returned
+// plaintext key is shown once; only its hash is kept. A nil expiresAt means
+// a year from now.
+func (uc *ServiceAccountUsecase) CreateKey(ctx context.Context, accountID uint, name string, scopes []string, expiresAt *time.Time) (*model.APIKey, string, error) {
+	account, err := uc.repo.Get(ctx, accountID)
+	if err != nil {
+		return nil, "", err
+	}
+	if account.DisabledAt != nil {
+		return nil, "", ErrServiceAccountDisabled
+	}
+	if len(scopes) == 0 {
+		return nil, "", ErrAPIKeyScopes
+	}
+	seen := make(map[string]bool, len(scopes))
+	var granted []string
+	for _, s := range scopes {
+		s = strings.TrimSpace(s)
+		if !ValidScope(s) {
+			return nil, "", fmt.Errorf("%w: %q", ErrInvalidScope, s)
+		}
+		if !seen[s] {
+			seen[s] = true
+			granted = append(granted, s)
+		}
+	}
+	now := time.Now()
+	expiry := now.Add(defaultAPIKeyTTL)
+	if expiresAt != nil {
+		if !expiresAt.After(now) || expiresAt.After(now.Add(maxAPIKeyTTL)) {
+			return nil, "", ErrAPIKeyExpiry
+		}
+		expiry = *expiresAt
+	}
+
+	rawPrefix := make([]byte, 4)
+	if _, err := rand.Read(rawPrefix); err != nil {
+		return nil, "", err
+	}
+	prefix := hex.EncodeToString(rawPrefix)
+	secret, err := randomToken(32)
+	if err != nil {
+		return nil, "", err
+	}
+	key := &model.APIKey{
+		ServiceAccountID: accountID,
+		Name:             strings.TrimSpace(name),
+		Prefix:           prefix,
+		SecretHash:       hashToken(secret),
+		Scopes:           granted,
+		ExpiresAt:        expiry,
+	}
+	if user, ok := UserFromContext(ctx); ok && user.ID != 0 {
+		key.CreatedBy = &user.ID
+	}
+	if err := uc.repo.CreateKey(ctx, key); err != nil {
+		return nil, "", fmt.Errorf("create api key: %w", err)
+	}
+	return key, APIKeyPrefix + prefix + "_" + secret, nil
+}
+
+func (uc *ServiceAccountUsecase) RevokeKey(ctx context.Context, id uint) (*model.APIKey, error) {
+	if _, err := uc.repo.GetKey(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.RevokeKey(ctx, id, time.Now()); err != nil {
+		return nil, err
+	}
+	return uc.repo.GetKey(ctx, id)
+}
+
+// Authenticate resolves an API key to the caller it acts as: the service
+// account, with the key's scopes as its only permissions.
+func (uc *ServiceAccountUsecase) Authenticate(ctx context.Context, raw, ip string) (*CurrentUser, error) {
+	prefix, secret, ok := strings.Cut(strings.TrimPrefix(raw, APIKeyPrefix), "_")
+	if !strings.HasPrefix(raw, APIKeyPrefix) || !ok || prefix == "" || secret == "" {
+		return nil, ErrInvalidAPIKey
+	}
+	key, err := uc.repo.GetKeyByPrefix(ctx, prefix)
+	if errors.Is(err, repository.ErrAPIKeyNotFound) {
+		return nil, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashToken(secret)), []byte(key.SecretHash)) != 1 {
+		return nil, ErrInvalidAPIKey
+	}
+	now := time.Now()
+	if key.RevokedAt != nil || now.After(key.ExpiresAt) ||
+		key.ServiceAccount == nil || key.ServiceAccount.DisabledAt != nil {
+		return nil, ErrInvalidAPIKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := uc.repo.TouchKey(ctx, key.ID, now, ip); err != nil {
+			return nil, err
+		}
+	}
+	return &CurrentUser{
+		Username:         "service:" + key.ServiceAccount.Name,
+		Role:             model.RoleService,
+		ServiceAccountID: key.ServiceAccountID,
+		Scopes:           key.Scopes,
+	}, nil
+}
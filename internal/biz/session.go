@@ -0,0 +1,179 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token was already used; the session has been ended")
+)
+
+// TokenPair is what a client holds for one session: a short-lived access
+// token and the refresh token that replaces it. A login that needs a second
+// factor returns only a ChallengeToken.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string // "<session id>.<secret>", valid once
+	ExpiresIn    int    // access token lifetime in seconds
+	SessionID    string
+
+	ChallengeToken     string   // exchanged for the tokens by LoginSecondFactor
+	EnrollmentRequired bool     // the user must enroll TOTP before answering the challenge
+	RecoveryCodes      []string // set when the login completed a TOTP enrollment
+}
+
+// Refresh exchanges a refresh token for a new token pair. Each refresh token
+// works once: presenting a spent one means it leaked, so the session is
+// ended for both the thief and the owner.
+func (uc *AuthUsecase) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	id, secret, ok := strings.Cut(refreshToken, ".")
+	if !ok || id == "" || secret == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+	session, err := uc.sessions.Get(ctx, id)
+	if errors.Is(err, repository.ErrSessionNotFound) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hash := hashToken(secret)
+	if hash != session.RefreshHash {
+		spent, err := uc.sessions.RefreshSpent(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if spent {
+			return nil, uc.endReusedSession(ctx, session)
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+	// Claiming is atomic, so of two concurrent refreshes with the same
+	// token only one wins and the other counts as reuse.
+	claimed, err := uc.sessions.ClaimRefresh(ctx, hash, uc.refreshTTL())
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, uc.endReusedSession(ctx, session)
+	}
+
+	// Reload the user so role and employee changes reach the new token.
+	user, err := uc.repo.Get(ctx, session.UserID)
+	if err != nil {
+		uc.sessions.Delete(ctx, session)
+		return nil, ErrInvalidRefreshToken
+	}
+	session.LastUsedAt = time.Now()
+	return uc.issueTokens(ctx, user, session)
+}
+
+func (uc *AuthUsecase) endReusedSession(ctx context.Context, session *model.Session) error {
+	if err := uc.sessions.Delete(ctx, session); err != nil {
+		return err
+	}
+	return ErrRefreshTokenReused
+}
+
+// Logout ends the caller's current session.
+func (uc *AuthUsecase) Logout(ctx context.Context) error {
+	user, ok := UserFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	return uc.RevokeSession(ctx, user.SessionID)
+}
+
+// LogoutAll ends every session of the caller, on all devices.
+func (uc *AuthUsecase) LogoutAll(ctx context.Context) error {
+	user, ok := UserFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	return uc.sessions.DeleteByUser(ctx, user.ID)
+}
+
+func (uc *AuthUsecase) ListSessions(ctx context.Context) ([]*model.Session, error) {
+	user, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return uc.sessions.ListByUser(ctx, user.ID)
+}
+
+// RevokeSession ends one of the caller's sessions.
+func (uc *AuthUsecase) RevokeSession(ctx context.Context, id string) error {
+	user, ok := UserFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	session, err := uc.sessions.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if session.UserID != user.ID {
+		return repository.ErrSessionNotFound
+	}
+	return uc.sessions.Delete(ctx, session)
+}
+
+// issueTokens rotates the session's refresh token, saves the session with a
+// fresh lifetime and signs an access token bound to it.
+func (uc *AuthUsecase) issueTokens(ctx context.Context, user *model.User, session *model.Session) (*TokenPair, error) {
+	secret, err := randomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	session.RefreshHash = hashToken(secret)
+	session.ExpiresAt = time.Now().Add(uc.refreshTTL())
+	if err := uc.sessions.Save(ctx, session); err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{
+		"username": user.Username,
+		"id":       user.ID,
+		"role":     user.Role,
+		"sid":      session.ID,
+		"exp":      time.Now().Add(time.Minute * time.Duration(uc.tokenExp)).Unix(),
+	}
+	if user.EmployeeID != nil {
+		claims["employee_id"] = *user.EmployeeID
+	}
+	signed, err := uc.keys.Sign(claims)
+	if err != nil {
+		return nil, fmt.Errorf("sign access token: %w", err)
+	}
+	return &TokenPair{
+		AccessToken:  signed,
+		RefreshToken: session.ID + "." + secret,
+		ExpiresIn:    uc.tokenExp * 60,
+		SessionID:    session.ID,
+	}, nil
+}
+
+func (uc *AuthUsecase) refreshTTL() time.Duration {
+	return time.Minute * time.Duration(uc.refreshExp)
+}
+
+// randomToken returns n random bytes, base64url encoded.
+func randomToken(n int) (string, error) {
+	raw := make([]byte, n)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const maxImportRows = 10000
+
+var ErrUnsupportedFormat = errors.New("unsupported file format")
+
+// readSheet decodes a CSV or XLSX file into rows of cells. For XLSX only the
+// first worksheet is read.
+func readSheet(format string, content []byte) ([][]string, error) {
+	var rows [][]string
+	switch strings.ToLower(format) {
+	case "csv":
+		r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+		r.FieldsPerRecord = -1
+		r.TrimLeadingSpace = true
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("read csv: %w", err)
+			}
+			rows = append(rows, record)
+		}
+	case "xlsx":
+		f, err := excelize.OpenReader(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("open xlsx: %w", err)
+		}
+		defer f.Close()
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, errors.New("xlsx file has no worksheets")
+		}
+		rows, err = f.GetRows(sheets[0])
+		if err != nil {
+			return nil, fmt.Errorf("read xlsx: %w", err)
+		}
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if len(rows) > maxImportRows+1 {
+		return nil, fmt.Errorf("file has more than %d rows", maxImportRows)
+	}
+	return rows, nil
+}
+
+// writeSheet encodes rows as a CSV file or an XLSX workbook with a single
+// worksheet. The first row is written as the header.
+func writeSheet(format string, rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	switch strings.ToLower(format) {
+	case "csv":
+		w := csv.NewWriter(&buf)
+		if err := w.WriteAll(rows); err != nil {
+			return nil, fmt.Errorf("write csv: %w", err)
+		}
+	case "xlsx":
+		f := excelize.NewFile()
+		defer f.Close()
+		sheet := f.GetSheetName(0)
+		for i, row := range rows {
+			cellName, err := excelize.CoordinatesToCellName(1, i+1)
+			if err != nil {
+				return nil, err
+			}
+			values := make([]interface{}, len(row))
+			for j, v := range row {
+				values[j] = v
+			}
+			if err := f.SetSheetRow(sheet, cellName, &values); err != nil {
+				return nil, fmt.Errorf("write xlsx: %w", err)
+			}
+		}
+		if err := f.Write(&buf); err != nil {
+			return nil, fmt.Errorf("write xlsx: %w", err)
+		}
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	return buf.Bytes(), nil
+}
+
+// headerIndex maps normalised column names to their position in the header row.
+func headerIndex(header []string) map[string]int {
+	idx := make(map[string]int, len(header))
+	for i, h := range header {
+		idx[normalizeColumn(h)] = i
+	}
+	return idx
+}
+
+func normalizeColumn(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+	return name
+}
+
+// cell returns the trimmed value of the named column, or "" when the column is
+// missing or the row is short.
+func cell(row []string, idx map[string]int, column string) string {
+	i, ok := idx[column]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+func isBlankRow(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
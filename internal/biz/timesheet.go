@@ -4,31 +4,69 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	v1 "myapp/api/timesheet/v1"
 	"myapp/internal/data/model"
+	"myapp/internal/pagination"
 	"myapp/internal/repository"
 )
 
 type TimesheetUsecase struct {
-	repo repository.TimesheetRepo
+	repo         repository.TimesheetRepo
+	scheduleRepo repository.ScheduleRepo
+	employeeRepo repository.EmployeeRepo
+	periodRepo   repository.TimesheetPeriodRepo
+	orgRepo      repository.OrganizationRepo
+	overtime     OvertimePolicy
 }
 
-func NewTimesheetUsecase(repo repository.TimesheetRepo) *TimesheetUsecase {
-	return &TimesheetUsecase{repo: repo}
+func NewTimesheetUsecase(
+	repo repository.TimesheetRepo,
+	scheduleRepo repository.ScheduleRepo,
+	employeeRepo repository.EmployeeRepo,
+	periodRepo repository.TimesheetPeriodRepo,
+	orgRepo repository.OrganizationRepo,
+	overtime OvertimePolicy,
+) *TimesheetUsecase {
+	return &TimesheetUsecase{
+		repo:         repo,
+		scheduleRepo: scheduleRepo,
+		employeeRepo: employeeRepo,
+		periodRepo:   periodRepo,
+		orgRepo:      orgRepo,
+		overtime:     overtime,
+	}
 }
 
-func (uc *TimesheetUsecase) Create(ctx context.Context, req *v1.CreateTimesheetRequest) error {
+// List returns a page of timesheets, newest first.
+func (uc *TimesheetUsecase) List(ctx context.Context, filter repository.TimesheetFilter, pageSize int32, pageToken string) ([]*model.Timesheet, string, error) {
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return nil, "", ErrInvalidDateRange
+	}
+	page, err := pagination.New(pageSize, pageToken, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	return uc.repo.List(ctx, filter, page)
+}
+
+// Create records a day of attendance. The returned warnings list overtime
+// caps the entry exceeds when the policy is in warn mode.
+func (uc *TimesheetUsecase) Create(ctx context.Context, req *v1.CreateTimesheetRequest) ([]string, error) {
 	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
-	workDate := req.WorkDate.AsTime().In(location).Truncate(24 * time.Hour)
+	workDate := workDay(req.WorkDate.AsTime())
 
 	exists, err := uc.repo.ExistsByEmployeeAndDate(ctx, uint(req.EmployeeId), workDate)
 	if err != nil {
-		return fmt.Errorf("check duplicate attendance: %w", err)
+		return nil, fmt.Errorf("check duplicate attendance: %w", err)
 	}
 	if exists {
-		return errors.New("attendance already recorded for this date")
+		return nil, errors.New("attendance already recorded for this date")
+	}
+	if err := uc.ensureUnlocked(ctx, uint(req.EmployeeId), workDate); err != nil {
+		return nil, err
 	}
 
 	ts := &model.Timesheet{
@@ -39,7 +77,145 @@ func (uc *TimesheetUsecase) Create(ctx context.Context, req *v1.CreateTimesheetR
 		IsLeave:       req.IsLeave,
 		LeaveType:     req.LeaveType,
 		Note:          req.Note,
+		Status:        model.TimesheetDraft,
+	}
+	if req.CheckIn != nil {
+		checkIn := req.CheckIn.AsTime().In(location)
+		ts.CheckIn = &checkIn
+	}
+	if req.CheckOut != nil {
+		checkOut := req.CheckOut.AsTime().In(location)
+		ts.CheckOut = &checkOut
+	}
+
+	if err := uc.applySchedule(ctx, ts); err != nil {
+		return nil, err
+	}
+	warnings, err := uc.newOvertimeLedger().check(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	return warnings, uc.repo.Create(ctx, ts)
+}
+
+func (uc *TimesheetUsecase) Update(ctx context.Context, req *v1.UpdateTimesheetRequest) ([]string, error) {
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	ts, err := uc.repo.Get(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.ensureUnlocked(ctx, ts.EmployeeID, ts.WorkDate); err != nil {
+		return nil, err
+	}
+
+	ts.HoursWorked = req.HoursWorked
+	ts.OvertimeHours = req.OvertimeHours
+	ts.IsLeave = req.IsLeave
+	ts.LeaveType = req.LeaveType
+	ts.Note = req.Note
+	ts.ShiftID, ts.ScheduledHours, ts.LateMinutes = nil, 0, 0
+	ts.CheckIn, ts.CheckOut = nil, nil
+	if req.CheckIn != nil {
+		checkIn := req.CheckIn.AsTime().In(location)
+		ts.CheckIn = &checkIn
+	}
+	if req.CheckOut != nil {
+		checkOut := req.CheckOut.AsTime().In(location)
+		ts.CheckOut = &checkOut
+	}
+
+	if err := uc.applySchedule(ctx, ts); err != nil {
+		return nil, err
+	}
+	warnings, err := uc.newOvertimeLedger().check(ctx, ts)
+	if err != nil {
+		return nil, err
 	}
 
-	return uc.repo.Create(ctx, ts)
-}
\ No newline at end of file
+	return warnings, uc.repo.Update(ctx, ts)
+}
+
+// applySchedule validates a timesheet entry against the shift the employee is
+// rostered on and splits the recorded time into regular and overtime hours.
+// Employees without a schedule assignment keep the hours as submitted; when
+// only punches are given, the time between them counts as worked.
+func (uc *TimesheetUsecase) applySchedule(ctx context.Context, ts *model.Timesheet) error {
+	if ts.CheckIn != nil && ts.CheckOut != nil && !ts.CheckOut.After(*ts.CheckIn) {
+		return errors.New("check_out must be after check_in")
+	}
+
+	days, err := resolveSchedule(ctx, uc.scheduleRepo, ts.EmployeeID, ts.WorkDate, ts.WorkDate)
+	if err != nil {
+		return fmt.Errorf("resolve schedule: %w", err)
+	}
+
+	if len(days) == 0 {
+		if !ts.IsLeave && ts.HoursWorked == 0 && ts.OvertimeHours == 0 && ts.CheckIn != nil && ts.CheckOut != nil {
+			ts.HoursWorked = roundHours(ts.CheckOut.Sub(*ts.CheckIn).Hours())
+		}
+		if !ts.IsLeave && ts.HoursWorked == 0 && ts.OvertimeHours == 0 {
+			return errors.New("hours_worked or check_in/check_out is required")
+		}
+		return validateHours(ts.HoursWorked, ts.OvertimeHours)
+	}
+
+	shift := days[0].Shift
+	if ts.IsLeave {
+		if shift == nil {
+			return errors.New("leave cannot be taken on a scheduled day off")
+		}
+		ts.ShiftID = &shift.ID
+		ts.ScheduledHours = ShiftHours(shift)
+		ts.HoursWorked, ts.OvertimeHours = 0, 0
+		return nil
+	}
+
+	total := ts.HoursWorked + ts.OvertimeHours
+	breakHours := 0.0
+	if shift != nil {
+		breakHours = float64(shift.BreakMinutes) / 60
+	}
+	if ts.CheckIn != nil && ts.CheckOut != nil {
+		total = math.Max(ts.CheckOut.Sub(*ts.CheckIn).Hours()-breakHours, 0)
+	}
+	if err := validateHours(total, 0); err != nil {
+		return err
+	}
+
+	// Work on a rostered day off is overtime in full.
+	scheduled := 0.0
+	if shift != nil {
+		scheduled = ShiftHours(shift)
+		ts.ShiftID = &shift.ID
+		if ts.CheckIn != nil {
+			if late := ts.CheckIn.Sub(shiftStart(shift, ts.WorkDate)); late > 0 {
+				ts.LateMinutes = int(late.Minutes())
+			}
+		}
+	}
+	ts.ScheduledHours = scheduled
+	ts.HoursWorked = math.Min(total, scheduled)
+	ts.OvertimeHours = roundHours(total - ts.HoursWorked)
+	return nil
+}
+
+// workDay normalises a timestamp to the date a timesheet is stored under.
+func workDay(t time.Time) time.Time {
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	return t.In(location).Truncate(24 * time.Hour)
+}
+
+func validateHours(hoursWorked, overtimeHours float64) error {
+	if hoursWorked < 0 || overtimeHours < 0 {
+		return errors.New("hours must not be negative")
+	}
+	if hoursWorked+overtimeHours > 24 {
+		return errors.New("total hours for a day must not exceed 24")
+	}
+	return nil
+}
+
+func roundHours(h float64) float64 {
+	return math.Round(h*100) / 100
+}
@@ -0,0 +1,200 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"myapp/internal/data/model"
+)
+
+// Anomaly types reported by AnomalyReport.
+const (
+	AnomalyMissingEntry     = "missing_entry"
+	AnomalyHoursOutOfRange  = "hours_out_of_range"
+	AnomalyOvertimeOnLeave  = "overtime_on_leave"
+	AnomalyBeforeJoinDate   = "before_join_date"
+	AnomalyIdenticalHours   = "identical_hours"
+	AnomalyIdenticalPunches = "identical_punches"
+)
+
+// minPatternDays is how many working days of identical figures it takes for
+// the report to call the pattern suspicious.
+const minPatternDays = 10
+
+type Anomaly struct {
+	EmployeeID   uint
+	EmployeeName string
+	WorkDate     *time.Time
+	Type         string
+	Detail       string
+}
+
+// AnomalyReport checks a month of timesheets for data problems that would
+// otherwise only show up as wrong payslips. It is meant to run before payroll.
+// A non-zero managerID limits the report to that manager's direct and
+// indirect reports.
+func (uc *TimesheetUsecase) AnomalyReport(ctx context.Context, monthYearStr string, employeeID, managerID uint) ([]*Anomaly, error) {
+	monthYear, err := time.Parse("2006-01", monthYearStr)
+	if err != nil {
+		return nil, errors.New("invalid month_year format, expected YYYY-MM")
+	}
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	start := time.Date(monthYear.Year(), monthYear.Month(), 1, 0, 0, 0, 0, location)
+	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+
+	// ids stays nil, meaning everyone, unless the report is narrowed.
+	var ids []uint
+	var employees []*model.Employee
+	switch {
+	case managerID != 0:
+		if ids, err = reportIDs(ctx, uc.orgRepo, managerID, true); err != nil {
+			return nil, err
+		}
+		if employeeID != 0 {
+			ids = intersectIDs(ids, []uint{employeeID})
+		}
+		if employees, err = uc.employeeRepo.ListByIDs(ctx, ids); err != nil {
+			return nil, fmt.Errorf("list employees: %w", err)
+		}
+	case employeeID != 0:
+		emp, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID)
+		if err != nil {
+			return nil, err
+		}
+		ids = []uint{employeeID}
+		employees = []*model.Employee{emp}
+	default:
+		if employees, err = uc.employeeRepo.ListAll(ctx); err != nil {
+			return nil, fmt.Errorf("list employees: %w", err)
+		}
+	}
+
+	rows, err := uc.repo.ListRange(ctx, start, end, ids)
+	if err != nil {
+		return nil, err
+	}
+	byEmployee := make(map[uint][]*model.Timesheet)
+	for _, ts := range rows {
+		byEmployee[ts.EmployeeID] = append(byEmployee[ts.EmployeeID], ts)
+	}
+
+	// Only days that have already passed can be missing.
+	lastDay := dateOf(end)
+	if now := today(); now.Before(lastDay) {
+		lastDay = now.AddDate(0, 0, -1)
+	}
+
+	var anomalies []*Anomaly
+	for _, emp := range employees {
+		found, err := uc.employeeAnomalies(ctx, emp, byEmployee[emp.ID], dateOf(start), lastDay)
+		if err != nil {
+			return nil, err
+		}
+		anomalies = append(anomalies, found...)
+	}
+
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		a, b := anomalies[i], anomalies[j]
+		if a.EmployeeID != b.EmployeeID {
+			return a.EmployeeID < b.EmployeeID
+		}
+		if a.WorkDate == nil || b.WorkDate == nil {
+			return a.WorkDate != nil && b.WorkDate == nil
+		}
+		return a.WorkDate.Before(*b.WorkDate)
+	})
+	return anomalies, nil
+}
+
+func (uc *TimesheetUsecase) employeeAnomalies(ctx context.Context, emp *model.Employee, rows []*model.Timesheet, from, to time.Time) ([]*Anomaly, error) {
+	var anomalies []*Anomaly
+	add := func(date *time.Time, kind, format string, args ...interface{}) {
+		anomalies = append(anomalies, &Anomaly{
+			EmployeeID:   emp.ID,
+			EmployeeName: emp.Name,
+			WorkDate:     date,
+			Type:         kind,
+			Detail:       fmt.Sprintf(format, args...),
+		})
+	}
+
+	recorded := make(map[time.Time]bool, len(rows))
+	joinDate := dateOf(emp.JoinDate)
+	for _, ts := range rows {
+		date := dateOf(ts.WorkDate)
+		recorded[date] = true
+
+		if ts.HoursWorked < 0 || ts.OvertimeHours < 0 || ts.HoursWorked+ts.OvertimeHours > 24 {
+			add(&date, AnomalyHoursOutOfRange, "hours_worked %.2f, overtime_hours %.2f", ts.HoursWorked, ts.OvertimeHours)
+		}
+		if ts.IsLeave && ts.OvertimeHours > 0 {
+			add(&date, AnomalyOvertimeOnLeave, "%.2f overtime hours recorded on a leave day", ts.OvertimeHours)
+		}
+		if !emp.JoinDate.IsZero() && date.Before(joinDate) {
+			add(&date, AnomalyBeforeJoinDate, "entry before join date %s", joinDate.Format("2006-01-02"))
+		}
+	}
+
+	if !to.Before(from) {
+		days, err := resolveSchedule(ctx, uc.scheduleRepo, emp.ID, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("resolve schedule for employee %d: %w", emp.ID, err)
+		}
+		for _, day := range days {
+			if day.Shift == nil || recorded[day.Date] || day.Date.Before(joinDate) {
+				continue
+			}
+			if emp.TerminatedAt != nil && day.Date.After(dateOf(*emp.TerminatedAt)) {
+				continue
+			}
+			date := day.Date
+			add(&date, AnomalyMissingEntry, "no entry for scheduled shift %q", day.Shift.Name)
+		}
+	}
+
+	if kind, detail := suspiciousPattern(rows); kind != "" {
+		add(nil, kind, "%s", detail)
+	}
+	return anomalies, nil
+}
+
+// suspiciousPattern looks for figures that repeat exactly on every working
+// day, which usually means the timesheet was filled in rather than recorded.
+func suspiciousPattern(rows []*model.Timesheet) (string, string) {
+	var worked []*model.Timesheet
+	for _, ts := range rows {
+		if !ts.IsLeave {
+			worked = append(worked, ts)
+		}
+	}
+	if len(worked) < minPatternDays {
+		return "", ""
+	}
+
+	first := worked[0]
+	samePunches := first.CheckIn != nil && first.CheckOut != nil
+	sameHours := true
+	for _, ts := range worked[1:] {
+		if samePunches && (ts.CheckIn == nil || ts.CheckOut == nil ||
+			ts.CheckIn.Format("15:04:05") != first.CheckIn.Format("15:04:05") ||
+			ts.CheckOut.Format("15:04:05") != first.CheckOut.Format("15:04:05")) {
+			samePunches = false
+		}
+		if ts.HoursWorked != first.HoursWorked || ts.OvertimeHours != first.OvertimeHours {
+			sameHours = false
+		}
+	}
+
+	switch {
+	case samePunches:
+		return AnomalyIdenticalPunches, fmt.Sprintf("check-in %s and check-out %s on all %d working days",
+			first.CheckIn.Format("15:04:05"), first.CheckOut.Format("15:04:05"), len(worked))
+	case sameHours:
+		return AnomalyIdenticalHours, fmt.Sprintf("%.2f hours plus %.2f overtime on all %d working days",
+			first.HoursWorked, first.OvertimeHours, len(worked))
+	}
+	return "", ""
+}
@@ -0,0 +1,97 @@
+package biz
+
+import (
+	"testing"
+
+	"myapp/internal/data/model"
+)
+
+// workedDays builds minPatternDays consecutive working days from shiftDay,
+// letting change adjust each one.
+func workedDays(change func(i int, ts *model.Timesheet)) []*model.Timesheet {
+	var rows []*model.Timesheet
+	for i := 0; i < minPatternDays; i++ {
+		date := shiftDay.AddDate(0, 0, i)
+		ts := &model.Timesheet{
+			EmployeeID:  1,
+			WorkDate:    date,
+			HoursWorked: 8,
+			CheckIn:     at(date, 8, 0),
+			CheckOut:    at(date, 17, 0),
+		}
+		change(i, ts)
+		rows = append(rows, ts)
+	}
+	return rows
+}
+
+func TestSuspiciousPattern(t *testing.T) {
+	tests := []struct {
+		name string
+		rows []*model.Timesheet
+		want string
+	}{
+		{
+			name: "identical punches",
+			rows: workedDays(func(int, *model.Timesheet) {}),
+			want: AnomalyIdenticalPunches,
+		},
+		{
+			name: "identical hours with varying punches",
+			rows: workedDays(func(i int, ts *model.Timesheet) {
+				ts.CheckIn = at(ts.WorkDate, 8, i)
+			}),
+			want: AnomalyIdenticalHours,
+		},
+		{
+			name: "identical hours without punches on the first day",
+			rows: workedDays(func(i int, ts *model.Timesheet) {
+				if i == 0 {
+					ts.CheckIn, ts.CheckOut = nil, nil
+				}
+			}),
+			want: AnomalyIdenticalHours,
+		},
+		{
+			name: "identical hours without any punches",
+			rows: workedDays(func(_ int, ts *model.Timesheet) {
+				ts.CheckIn, ts.CheckOut = nil, nil
+			}),
+			want: AnomalyIdenticalHours,
+		},
+		{
+			name: "a missing punch later on",
+			rows: workedDays(func(i int, ts *model.Timesheet) {
+				if i == 3 {
+					ts.CheckOut = nil
+				}
+			}),
+			want: AnomalyIdenticalHours,
+		},
+		{
+			name: "hours and punches vary",
+			rows: workedDays(func(i int, ts *model.Timesheet) {
+				ts.CheckOut = at(ts.WorkDate, 17, i)
+				ts.OvertimeHours = float64(i) / 4
+			}),
+		},
+		{
+			name: "leave days are ignored",
+			rows: append(workedDays(func(int, *model.Timesheet) {}),
+				&model.Timesheet{EmployeeID: 1, WorkDate: shiftDay.AddDate(0, 0, minPatternDays), IsLeave: true}),
+			want: AnomalyIdenticalPunches,
+		},
+		{
+			name: "too few working days",
+			rows: workedDays(func(int, *model.Timesheet) {})[1:],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, detail := suspiciousPattern(tt.rows)
+			if got != tt.want {
+				t.Fatalf("got %q (%s), want %q", got, detail, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+)
+
+var (
+	ErrInvalidPeriodType      = errors.New("period_type must be week or month")
+	ErrPeriodAlreadySubmitted = errors.New("timesheet period has already been submitted")
+	ErrPeriodLocked           = errors.New("timesheet period is submitted or approved and cannot be changed")
+	ErrPeriodNotPending       = errors.New("only submitted periods can be reviewed")
+	ErrCommentRequired        = errors.New("a comment is required when sending a period back")
+	ErrOwnPeriod              = errors.New("you cannot review your own timesheet period")
+	ErrUnauthenticated        = errors.New("unauthenticated")
+)
+
+// SubmitPeriod submits the week or month containing date for approval.
+// A period that was sent back can be submitted again.
+func (uc *TimesheetUsecase) SubmitPeriod(ctx context.Context, employeeID uint, periodType string, date time.Time) (*model.TimesheetPeriod, error) {
+	start, end, err := periodBounds(periodType, date)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.employeeRepo.GetEmployeeByID(ctx, employeeID); err != nil {
+		return nil, err
+	}
+
+	overlapping, err := uc.periodRepo.FindOverlapping(ctx, employeeID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	period := &model.TimesheetPeriod{
+		EmployeeID: employeeID,
+		PeriodType: strings.ToLower(periodType),
+		StartDate:  start,
+		EndDate:    end,
+	}
+	for _, p := range overlapping {
+		if p.Status != model.TimesheetRejected {
+			return nil, ErrPeriodAlreadySubmitted
+		}
+		if dateOf(p.StartDate).Equal(start) && dateOf(p.EndDate).Equal(end) {
+			period = p
+		}
+	}
+
+	period.Status = model.TimesheetSubmitted
+	period.SubmittedAt = time.Now()
+	period.ReviewedBy, period.ReviewedAt = nil, nil
+	if err := uc.periodRepo.Save(ctx, period, model.TimesheetSubmitted); err != nil {
+		return nil, fmt.Errorf("submit timesheet period: %w", err)
+	}
+	return period, nil
+}
+
+// ApprovePeriod approves a submitted period, which releases its timesheets to payroll.
+func (uc *TimesheetUsecase) ApprovePeriod(ctx context.Context, id uint, comment string) (*model.TimesheetPeriod, error) {
+	return uc.reviewPeriod(ctx, id, comment, model.TimesheetApproved, model.TimesheetApproved)
+}
+
+// RejectPeriod sends a submitted period back to the employee with a comment
+// and reopens its timesheets for editing.
+func (uc *TimesheetUsecase) RejectPeriod(ctx context.Context, id uint, comment string) (*model.TimesheetPeriod, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, ErrCommentRequired
+	}
+	return uc.reviewPeriod(ctx, id, comment, model.TimesheetRejected, model.TimesheetDraft)
+}
+
+func (uc *TimesheetUsecase) reviewPeriod(ctx context.Context, id uint, comment, status, timesheetStatus string) (*model.TimesheetPeriod, error) {
+	reviewer, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	period, err := uc.periodRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if period.Status != model.TimesheetSubmitted {
+		return nil, ErrPeriodNotPending
+	}
+	// Applies to every role, not just managers whose own record authz
+	// already excludes.
+	if reviewer.EmployeeID != 0 && reviewer.EmployeeID == period.EmployeeID {
+		return nil, ErrOwnPeriod
+	}
+
+	now := time.Now()
+	period.Status = status
+	period.Comment = comment
+	period.ReviewedBy = &reviewer.ID
+	period.ReviewedAt = &now
+	if err := uc.periodRepo.Save(ctx, period, timesheetStatus); err != nil {
+		return nil, fmt.Errorf("review timesheet period: %w", err)
+	}
+	return period, nil
+}
+
+// ListPeriods lists timesheet periods. A non-zero managerID limits the list
+// to the manager's direct reports, or with indirect set to everyone below
+// them, so each manager sees the periods they are expected to review.
+func (uc *TimesheetUsecase) ListPeriods(ctx context.Context, status string, employeeID, managerID uint, indirect bool) ([]*model.TimesheetPeriod, error) {
+	var employeeIDs []uint
+	if employeeID != 0 {
+		employeeIDs = []uint{employeeID}
+	}
+	if managerID != 0 {
+		reports, err := reportIDs(ctx, uc.orgRepo, managerID, indirect)
+		if err != nil {
+			return nil, err
+		}
+		if employeeID != 0 {
+			reports = intersectIDs(reports, employeeIDs)
+		}
+		employeeIDs = reports
+	}
+	return uc.periodRepo.List(ctx, status, employeeIDs)
+}
+
+// ensureUnlocked rejects changes to a day that is part of a submitted or
+// approved period.
+func (uc *TimesheetUsecase) ensureUnlocked(ctx context.Context, employeeID uint, workDate time.Time) error {
+	d := dateOf(workDate)
+	periods, err := uc.periodRepo.FindOverlapping(ctx, employeeID, d, d)
+	if err != nil {
+		return err
+	}
+	for _, p := range periods {
+		if p.Status == model.TimesheetSubmitted || p.Status == model.TimesheetApproved {
+			return ErrPeriodLocked
+		}
+	}
+	return nil
+}
+
+// periodBounds returns the first and last day of the week (Monday to Sunday)
+// or month that contains date.
+func periodBounds(periodType string, date time.Time) (time.Time, time.Time, error) {
+	d := dateOf(date)
+	switch strings.ToLower(periodType) {
+	case "week":
+		start := d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 6), nil
+	case "month":
+		start := time.Date(d.Year(), d.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, -1), nil
+	default:
+		return time.Time{}, time.Time{}, ErrInvalidPeriodType
+	}
+}
@@ -0,0 +1,378 @@
+package biz
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+)
+
+// ImportRow is one parsed line of a timesheet import together with the
+// problems found while validating it.
+type ImportRow struct {
+	Row       int
+	Timesheet *model.Timesheet
+	Errors    []string
+	Warnings  []string
+}
+
+type ImportResult struct {
+	Rows     []*ImportRow
+	Valid    int
+	Imported int
+	DryRun   bool
+}
+
+// Import parses an attendance file, validates every row and, unless dryRun is
+// set, stores the rows that passed validation.
+func (uc *TimesheetUsecase) Import(ctx context.Context, format string, content []byte, dryRun bool) (*ImportResult, error) {
+	var rows []*ImportRow
+	var err error
+	switch strings.ToLower(format) {
+	case "csv", "xlsx":
+		rows, err = parseTimesheetSheet(format, content)
+	case "zkteco":
+		rows, err = parseZKTecoLog(content)
+	case "punches":
+		rows, err = parsePunchCSV(content)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.validateImport(ctx, rows); err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{Rows: rows, DryRun: dryRun}
+	var valid []*model.Timesheet
+	for _, r := range rows {
+		if len(r.Errors) == 0 {
+			valid = append(valid, r.Timesheet)
+		}
+	}
+	result.Valid = len(valid)
+
+	if dryRun || len(valid) == 0 {
+		return result, nil
+	}
+	if err := uc.repo.CreateBatch(ctx, valid); err != nil {
+		return nil, fmt.Errorf("import timesheets: %w", err)
+	}
+	result.Imported = len(valid)
+	return result, nil
+}
+
+func (uc *TimesheetUsecase) validateImport(ctx context.Context, rows []*ImportRow) error {
+	var ids []uint
+	seenID := make(map[uint]bool)
+	for _, r := range rows {
+		if r.Timesheet != nil && len(r.Errors) == 0 && !seenID[r.Timesheet.EmployeeID] {
+			seenID[r.Timesheet.EmployeeID] = true
+			ids = append(ids, r.Timesheet.EmployeeID)
+		}
+	}
+	employees, err := uc.employeeRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("load employees: %w", err)
+	}
+	known := make(map[uint]bool, len(employees))
+	for _, e := range employees {
+		known[e.ID] = true
+	}
+
+	ledger := uc.newOvertimeLedger()
+	seen := make(map[string]int)
+	for _, r := range rows {
+		ts := r.Timesheet
+		if ts == nil || len(r.Errors) > 0 {
+			continue
+		}
+		if !known[ts.EmployeeID] {
+			r.Errors = append(r.Errors, fmt.Sprintf("unknown employee %d", ts.EmployeeID))
+			continue
+		}
+
+		key := fmt.Sprintf("%d/%s", ts.EmployeeID, ts.WorkDate.Format("2006-01-02"))
+		if first, ok := seen[key]; ok {
+			r.Errors = append(r.Errors, fmt.Sprintf("duplicate of row %d", first))
+			continue
+		}
+		seen[key] = r.Row
+
+		exists, err := uc.repo.ExistsByEmployeeAndDate(ctx, ts.EmployeeID, ts.WorkDate)
+		if err != nil {
+			return fmt.Errorf("check duplicate attendance: %w", err)
+		}
+		if exists {
+			r.Errors = append(r.Errors, "attendance already recorded for this date")
+			continue
+		}
+		if err := uc.ensureUnlocked(ctx, ts.EmployeeID, ts.WorkDate); err != nil {
+			if !errors.Is(err, ErrPeriodLocked) {
+				return err
+			}
+			r.Errors = append(r.Errors, err.Error())
+			continue
+		}
+
+		if err := uc.applySchedule(ctx, ts); err != nil {
+			r.Errors = append(r.Errors, err.Error())
+			continue
+		}
+
+		warnings, err := ledger.check(ctx, ts)
+		switch {
+		case errors.Is(err, ErrOvertimeLimitExceeded):
+			r.Errors = append(r.Errors, err.Error())
+		case err != nil:
+			return err
+		default:
+			r.Warnings = warnings
+		}
+	}
+	return nil
+}
+
+// parseTimesheetSheet reads a CSV/XLSX file with a header row. Recognised
+// columns: employee_id, work_date, hours_worked, overtime_hours, is_leave,
+// leave_type, note, check_in, check_out.
+func parseTimesheetSheet(format string, content []byte) ([]*ImportRow, error) {
+	records, err := readSheet(format, content)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	idx := headerIndex(records[0])
+	for _, required := range []string{"employee_id", "work_date"} {
+		if _, ok := idx[required]; !ok {
+			return nil, fmt.Errorf("missing required column %q", required)
+		}
+	}
+
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	var rows []*ImportRow
+	for i, record := range records[1:] {
+		if isBlankRow(record) {
+			continue
+		}
+		row := &ImportRow{Row: i + 2}
+		rows = append(rows, row)
+
+		employeeID, err := strconv.ParseUint(cell(record, idx, "employee_id"), 10, 32)
+		if err != nil {
+			row.Errors = append(row.Errors, "invalid employee_id")
+		}
+		date, err := parseDate(cell(record, idx, "work_date"))
+		if err != nil {
+			row.Errors = append(row.Errors, "invalid work_date, expected YYYY-MM-DD")
+		}
+
+		ts := &model.Timesheet{
+			EmployeeID: uint(employeeID),
+			WorkDate:   workDay(date),
+			LeaveType:  cell(record, idx, "leave_type"),
+			Note:       cell(record, idx, "note"),
+			Status:     model.TimesheetDraft,
+		}
+		if ts.HoursWorked, err = parseHours(cell(record, idx, "hours_worked")); err != nil {
+			row.Errors = append(row.Errors, "invalid hours_worked")
+		}
+		if ts.OvertimeHours, err = parseHours(cell(record, idx, "overtime_hours")); err != nil {
+			row.Errors = append(row.Errors, "invalid overtime_hours")
+		}
+		if v := cell(record, idx, "is_leave"); v != "" {
+			if ts.IsLeave, err = strconv.ParseBool(v); err != nil {
+				row.Errors = append(row.Errors, "invalid is_leave")
+			}
+		}
+		if ts.CheckIn, err = parseClockOn(date, cell(record, idx, "check_in"), location); err != nil {
+			row.Errors = append(row.Errors, "invalid check_in, expected HH:MM")
+		}
+		if ts.CheckOut, err = parseClockOn(date, cell(record, idx, "check_out"), location); err != nil {
+			row.Errors = append(row.Errors, "invalid check_out, expected HH:MM")
+		}
+		if ts.CheckIn != nil && ts.CheckOut != nil && !ts.CheckOut.After(*ts.CheckIn) {
+			// Punch-out past midnight on a night shift.
+			next := ts.CheckOut.AddDate(0, 0, 1)
+			ts.CheckOut = &next
+		}
+		if ts.CheckIn == nil && ts.CheckOut == nil && cell(record, idx, "hours_worked") == "" && !ts.IsLeave {
+			row.Errors = append(row.Errors, "hours_worked or check_in/check_out is required")
+		}
+		row.Timesheet = ts
+	}
+	return rows, nil
+}
+
+type punch struct {
+	line       int
+	employeeID uint
+	at         time.Time
+}
+
+// parseZKTecoLog reads the attlog.dat export of ZKTeco terminals: one punch
+// per line, tab separated, starting with the enrolment number and the local
+// punch time ("2006-01-02 15:04:05"). The enrolment number must match the
+// employee ID.
+func parseZKTecoLog(content []byte) ([]*ImportRow, error) {
+	var punches []punch
+	var rows []*ImportRow
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		fields := strings.Split(text, "\t")
+		if len(fields) < 2 {
+			rows = append(rows, &ImportRow{Row: line, Errors: []string{"malformed punch record"}})
+			continue
+		}
+		p, err := newPunch(line, fields[0], fields[1])
+		if err != nil {
+			rows = append(rows, &ImportRow{Row: line, Errors: []string{err.Error()}})
+			continue
+		}
+		punches = append(punches, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read attendance log: %w", err)
+	}
+	return sortRows(append(rows, groupPunches(punches)...)), nil
+}
+
+// parsePunchCSV reads the generic "employee_id,timestamp" punch export most
+// terminals can produce.
+func parsePunchCSV(content []byte) ([]*ImportRow, error) {
+	records, err := readSheet("csv", content)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("file is empty")
+	}
+	idx := headerIndex(records[0])
+	for _, required := range []string{"employee_id", "timestamp"} {
+		if _, ok := idx[required]; !ok {
+			return nil, fmt.Errorf("missing required column %q", required)
+		}
+	}
+
+	var punches []punch
+	var rows []*ImportRow
+	for i, record := range records[1:] {
+		if isBlankRow(record) {
+			continue
+		}
+		p, err := newPunch(i+2, cell(record, idx, "employee_id"), cell(record, idx, "timestamp"))
+		if err != nil {
+			rows = append(rows, &ImportRow{Row: i + 2, Errors: []string{err.Error()}})
+			continue
+		}
+		punches = append(punches, p)
+	}
+	return sortRows(append(rows, groupPunches(punches)...)), nil
+}
+
+func newPunch(line int, employeeID, timestamp string) (punch, error) {
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	id, err := strconv.ParseUint(strings.TrimSpace(employeeID), 10, 32)
+	if err != nil {
+		return punch{}, errors.New("invalid employee id")
+	}
+	at, err := time.ParseInLocation("2006-01-02 15:04:05", strings.TrimSpace(timestamp), location)
+	if err != nil {
+		return punch{}, errors.New("invalid punch time, expected YYYY-MM-DD HH:MM:SS")
+	}
+	return punch{line: line, employeeID: uint(id), at: at}, nil
+}
+
+// groupPunches turns raw punches into one timesheet row per employee and day,
+// using the first punch as check-in and the last as check-out.
+func groupPunches(punches []punch) []*ImportRow {
+	type dayKey struct {
+		employeeID uint
+		date       string
+	}
+	groups := make(map[dayKey][]punch)
+	var keys []dayKey
+	for _, p := range punches {
+		k := dayKey{p.employeeID, p.at.Format("2006-01-02")}
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], p)
+	}
+
+	var rows []*ImportRow
+	for _, k := range keys {
+		day := groups[k]
+		sort.Slice(day, func(i, j int) bool { return day[i].at.Before(day[j].at) })
+		row := &ImportRow{Row: day[0].line}
+		rows = append(rows, row)
+		if len(day) < 2 {
+			row.Errors = append(row.Errors, "only one punch recorded for this day")
+			continue
+		}
+		checkIn, checkOut := day[0].at, day[len(day)-1].at
+		date, _ := parseDate(k.date)
+		row.Timesheet = &model.Timesheet{
+			EmployeeID: k.employeeID,
+			WorkDate:   workDay(date),
+			CheckIn:    &checkIn,
+			CheckOut:   &checkOut,
+			Note:       fmt.Sprintf("imported from %d punches", len(day)),
+			Status:     model.TimesheetDraft,
+		}
+	}
+	return rows
+}
+
+func sortRows(rows []*ImportRow) []*ImportRow {
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].Row < rows[j].Row })
+	return rows
+}
+
+func parseDate(s string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02", "02/01/2006", "2006/01/02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+func parseHours(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// parseClockOn combines a calendar date with an "HH:MM" clock time in loc.
+func parseClockOn(date time.Time, clock string, loc *time.Location) (*time.Time, error) {
+	if clock == "" {
+		return nil, nil
+	}
+	offset, err := parseClock(clock)
+	if err != nil {
+		return nil, err
+	}
+	y, m, d := date.Date()
+	t := time.Date(y, m, d, 0, 0, 0, 0, loc).Add(offset)
+	return &t, nil
+}
@@ -0,0 +1,149 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// fakeTimesheets stores imported rows in memory and lists rows. monthly and
+// yearly are the overtime already stored for any employee.
+type fakeTimesheets struct {
+	repository.TimesheetRepo
+	stored          []*model.Timesheet
+	rows            []*model.Timesheet
+	monthly, yearly float64
+}
+
+func (f *fakeTimesheets) ListRange(_ context.Context, from, to time.Time, employeeIDs []uint) ([]*model.Timesheet, error) {
+	var out []*model.Timesheet
+	for _, ts := range f.rows {
+		if ts.WorkDate.Before(from) || ts.WorkDate.After(to) {
+			continue
+		}
+		for _, id := range employeeIDs {
+			if ts.EmployeeID == id {
+				out = append(out, ts)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeTimesheets) CreateBatch(_ context.Context, rows []*model.Timesheet) error {
+	f.stored = append(f.stored, rows...)
+	return nil
+}
+
+func (f *fakeTimesheets) ExistsByEmployeeAndDate(context.Context, uint, time.Time) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeTimesheets) SumOvertime(_ context.Context, _ uint, from, to time.Time, _ uint) (float64, error) {
+	if to.Sub(from) > 31*24*time.Hour {
+		return f.yearly, nil
+	}
+	return f.monthly, nil
+}
+
+type fakeEmployees struct {
+	repository.EmployeeRepo
+	employees []*model.Employee
+}
+
+func (f *fakeEmployees) GetEmployeeByID(_ context.Context, id uint) (*model.Employee, error) {
+	for _, e := range f.employees {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, ErrEmployeeNotFound
+}
+
+func (f *fakeEmployees) ListByIDs(_ context.Context, ids []uint) ([]*model.Employee, error) {
+	var out []*model.Employee
+	for _, e := range f.employees {
+		for _, id := range ids {
+			if e.ID == id {
+				out = append(out, e)
+			}
+		}
+	}
+	return out, nil
+}
+
+type fakePeriods struct {
+	repository.TimesheetPeriodRepo
+}
+
+func (fakePeriods) FindOverlapping(context.Context, uint, time.Time, time.Time) ([]*model.TimesheetPeriod, error) {
+	return nil, nil
+}
+
+func newImportTestUsecase() (*TimesheetUsecase, *fakeTimesheets) {
+	repo := &fakeTimesheets{}
+	employees := &fakeEmployees{employees: []*model.Employee{
+		{Model: gorm.Model{ID: scheduledEmployee}},
+		{Model: gorm.Model{ID: unscheduledEmployee}},
+	}}
+	return &TimesheetUsecase{
+		repo:         repo,
+		scheduleRepo: newScheduleFixture(),
+		employeeRepo: employees,
+		periodRepo:   fakePeriods{},
+	}, repo
+}
+
+func TestImportUnscheduledEmployee(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		content string
+	}{
+		{
+			name:   "punches",
+			format: "punches",
+			content: "employee_id,timestamp\n" +
+				"2,2026-01-05 08:00:00\n" +
+				"2,2026-01-05 12:00:00\n" +
+				"2,2026-01-05 17:30:00\n" +
+				"2,2026-01-07 08:00:00\n",
+		},
+		{
+			name:   "sheet with check-in and check-out only",
+			format: "csv",
+			content: "employee_id,work_date,check_in,check_out\n" +
+				"2,2026-01-05,08:00,17:30\n" +
+				"2,2026-01-07,08:00,\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := newImportTestUsecase()
+			result, err := uc.Import(context.Background(), tt.format, []byte(tt.content), false)
+			if err != nil {
+				t.Fatalf("Import: %v", err)
+			}
+			if len(result.Rows) != 2 {
+				t.Fatalf("got %d rows, want 2", len(result.Rows))
+			}
+			if errs := result.Rows[0].Errors; len(errs) != 0 {
+				t.Fatalf("first row rejected: %v", errs)
+			}
+			if len(result.Rows[1].Errors) == 0 {
+				t.Fatal("row with a single punch was accepted")
+			}
+			if result.Imported != 1 || len(repo.stored) != 1 {
+				t.Fatalf("imported %d rows, want 1", result.Imported)
+			}
+			if ts := repo.stored[0]; ts.HoursWorked != 9.5 || ts.OvertimeHours != 0 {
+				t.Fatalf("stored hours %v and overtime %v, want 9.5 and 0", ts.HoursWorked, ts.OvertimeHours)
+			}
+		})
+	}
+}
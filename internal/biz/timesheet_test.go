@@ -0,0 +1,182 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+// fakeSchedules rosters employees on one schedule each. Methods the tests
+// don't reach are left to the nil embedded repo.
+type fakeSchedules struct {
+	repository.ScheduleRepo
+	shifts      map[uint]*model.Shift
+	schedules   map[uint]*model.WorkSchedule
+	assignments map[uint][]*model.ScheduleAssignment // by employee
+}
+
+func (f *fakeSchedules) GetShift(_ context.Context, id uint) (*model.Shift, error) {
+	if s, ok := f.shifts[id]; ok {
+		return s, nil
+	}
+	return nil, errors.New("shift not found")
+}
+
+func (f *fakeSchedules) GetSchedule(_ context.Context, id uint) (*model.WorkSchedule, error) {
+	if s, ok := f.schedules[id]; ok {
+		return s, nil
+	}
+	return nil, errors.New("schedule not found")
+}
+
+func (f *fakeSchedules) ListAssignments(_ context.Context, employeeID uint, from, to time.Time) ([]*model.ScheduleAssignment, error) {
+	var out []*model.ScheduleAssignment
+	for _, a := range f.assignments[employeeID] {
+		if !a.EffectiveFrom.After(to) && (a.EffectiveTo == nil || !a.EffectiveTo.Before(from)) {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+const (
+	scheduledEmployee   = 1
+	unscheduledEmployee = 2
+)
+
+var (
+	// Monday 5 January 2026 is a shift day and the Tuesday after it a day off.
+	shiftDay = time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	dayOff   = time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+)
+
+// newScheduleFixture rosters scheduledEmployee on an 08:00-17:00 shift with a
+// one-hour break every other day, starting on shiftDay.
+func newScheduleFixture() *fakeSchedules {
+	return &fakeSchedules{
+		shifts: map[uint]*model.Shift{
+			1: {Model: gorm.Model{ID: 1}, StartTime: "08:00", EndTime: "17:00", BreakMinutes: 60},
+		},
+		schedules: map[uint]*model.WorkSchedule{
+			1: {Model: gorm.Model{ID: 1}, CycleStart: shiftDay, Pattern: "1,0"},
+		},
+		assignments: map[uint][]*model.ScheduleAssignment{
+			scheduledEmployee: {{EmployeeID: scheduledEmployee, ScheduleID: 1, EffectiveFrom: shiftDay.AddDate(0, 0, -4)}},
+		},
+	}
+}
+
+// at is the given clock time on date in the company timezone.
+func at(date time.Time, hour, minute int) *time.Time {
+	location, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	t := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, location)
+	return &t
+}
+
+func TestApplySchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		ts      model.Timesheet
+		wantErr bool
+
+		hours, overtime, scheduled float64
+		late                       int
+	}{
+		{
+			name:  "unscheduled punches count as worked",
+			ts:    model.Timesheet{EmployeeID: unscheduledEmployee, WorkDate: shiftDay, CheckIn: at(shiftDay, 8, 0), CheckOut: at(shiftDay, 17, 30)},
+			hours: 9.5,
+		},
+		{
+			name:  "unscheduled hours kept as submitted",
+			ts:    model.Timesheet{EmployeeID: unscheduledEmployee, WorkDate: shiftDay, HoursWorked: 8, OvertimeHours: 1, CheckIn: at(shiftDay, 8, 0), CheckOut: at(shiftDay, 12, 0)},
+			hours: 8, overtime: 1,
+		},
+		{
+			name:    "unscheduled without hours or punches",
+			ts:      model.Timesheet{EmployeeID: unscheduledEmployee, WorkDate: shiftDay},
+			wantErr: true,
+		},
+		{
+			name:    "unscheduled with only a check-in",
+			ts:      model.Timesheet{EmployeeID: unscheduledEmployee, WorkDate: shiftDay, CheckIn: at(shiftDay, 8, 0)},
+			wantErr: true,
+		},
+		{
+			name: "unscheduled leave",
+			ts:   model.Timesheet{EmployeeID: unscheduledEmployee, WorkDate: shiftDay, IsLeave: true},
+		},
+		{
+			name:    "unscheduled over 24 hours",
+			ts:      model.Timesheet{EmployeeID: unscheduledEmployee, WorkDate: shiftDay, HoursWorked: 20, OvertimeHours: 5},
+			wantErr: true,
+		},
+		{
+			name:    "check-out before check-in",
+			ts:      model.Timesheet{EmployeeID: unscheduledEmployee, WorkDate: shiftDay, CheckIn: at(shiftDay, 17, 0), CheckOut: at(shiftDay, 8, 0)},
+			wantErr: true,
+		},
+		{
+			name:  "full shift",
+			ts:    model.Timesheet{EmployeeID: scheduledEmployee, WorkDate: shiftDay, CheckIn: at(shiftDay, 8, 0), CheckOut: at(shiftDay, 17, 0)},
+			hours: 8, scheduled: 8,
+		},
+		{
+			name:  "late start and overtime",
+			ts:    model.Timesheet{EmployeeID: scheduledEmployee, WorkDate: shiftDay, CheckIn: at(shiftDay, 8, 15), CheckOut: at(shiftDay, 19, 0)},
+			hours: 8, overtime: 1.75, scheduled: 8, late: 15,
+		},
+		{
+			name:  "short day",
+			ts:    model.Timesheet{EmployeeID: scheduledEmployee, WorkDate: shiftDay, CheckIn: at(shiftDay, 8, 0), CheckOut: at(shiftDay, 13, 0)},
+			hours: 4, scheduled: 8,
+		},
+		{
+			name:  "hours without punches are split at the shift length",
+			ts:    model.Timesheet{EmployeeID: scheduledEmployee, WorkDate: shiftDay, HoursWorked: 7, OvertimeHours: 3},
+			hours: 8, overtime: 2, scheduled: 8,
+		},
+		{
+			name:     "work on a day off is overtime",
+			ts:       model.Timesheet{EmployeeID: scheduledEmployee, WorkDate: dayOff, CheckIn: at(dayOff, 9, 0), CheckOut: at(dayOff, 13, 0)},
+			overtime: 4,
+		},
+		{
+			name:      "leave on a shift day",
+			ts:        model.Timesheet{EmployeeID: scheduledEmployee, WorkDate: shiftDay, IsLeave: true, HoursWorked: 8},
+			scheduled: 8,
+		},
+		{
+			name:    "leave on a day off",
+			ts:      model.Timesheet{EmployeeID: scheduledEmployee, WorkDate: dayOff, IsLeave: true},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &TimesheetUsecase{scheduleRepo: newScheduleFixture()}
+			ts := tt.ts
+			err := uc.applySchedule(context.Background(), &ts)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("accepted %+v", ts)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applySchedule: %v", err)
+			}
+			if ts.HoursWorked != tt.hours || ts.OvertimeHours != tt.overtime || ts.ScheduledHours != tt.scheduled || ts.LateMinutes != tt.late {
+				t.Fatalf("got hours %v, overtime %v, scheduled %v, late %d; want %v, %v, %v, %d",
+					ts.HoursWorked, ts.OvertimeHours, ts.ScheduledHours, ts.LateMinutes,
+					tt.hours, tt.overtime, tt.scheduled, tt.late)
+			}
+		})
+	}
+}
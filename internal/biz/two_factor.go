@@ -0,0 +1,304 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/totp"
+)
+
+const (
+	tokenLoginChallenge = "login_challenge"
+	loginChallengeTTL   = 5 * time.Minute
+	recoveryCodeCount   = 10
+)
+
+var (
+	ErrInvalidChallenge    = errors.New("login challenge is invalid or expired; log in again")
+	ErrInvalidTOTPCode     = errors.New("invalid authentication code")
+	ErrTOTPNotEnrolled     = errors.New("two-factor authentication is not set up")
+	ErrTOTPAlreadyEnabled  = errors.New("two-factor authentication is already enabled")
+	ErrTOTPRequiredForRole = errors.New("two-factor authentication is required for your role")
+)
+
+// TwoFactorPolicy names the issuer shown in authenticator apps and the roles
+// that must use two-factor authentication. Other users may opt in.
+type TwoFactorPolicy struct {
+	Issuer        string
+	RequiredRoles []string
+}
+
+func (p TwoFactorPolicy) required(role string) bool {
+	for _, r := range p.RequiredRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// TOTPEnrollment is a new TOTP secret waiting for its first code.
+type TOTPEnrollment struct {
+	Secret string
+	URI    string // otpauth:// URI for a QR code
+}
+
+// loginChallenge ends the password step of a login for users with
+// two-factor authentication, or whose role requires it. The challenge token
+// is exchanged for a token pair by LoginSecondFactor.
+func (uc *AuthUsecase) loginChallenge(ctx context.Context, user *model.User) (*TokenPair, error) {
+	token, err := randomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tokens.Issue(ctx, tokenLoginChallenge, hashToken(token), user.ID, loginChallengeTTL); err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		ChallengeToken:     token,
+		EnrollmentRequired: user.TOTPEnabledAt == nil,
+	}, nil
+}
+
+// LoginSecondFactor completes a login with a TOTP or recovery code. When the
+// challenge was for a required enrollment, the code confirms the new secret
+// and the reply carries the user's recovery codes.
+func (uc *AuthUsecase) LoginSecondFactor(ctx context.Context, challenge, code, device, ip string) (*TokenPair, error) {
+	user, err := uc.challengeUser(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.guard.Check(ctx, user.Username, ip); err != nil {
+		return nil, err
+	}
+	if user.TOTPSecret == "" {
+		return nil, ErrTOTPNotEnrolled
+	}
+	if err := uc.checkSecondFactor(ctx, user, code, ip); err != nil {
+		return nil, err
+	}
+	if _, err := uc.tokens.Consume(ctx, tokenLoginChallenge, hashToken(challenge)); err != nil {
+		return nil, ErrInvalidChallenge
+	}
+	if err := uc.guard.Succeeded(ctx, user.Username); err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	if user.TOTPEnabledAt == nil {
+		if recoveryCodes, err = uc.enableTOTP(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+	tokens, err := uc.startSession(ctx, user, device, ip)
+	if err != nil {
+		return nil, err
+	}
+	tokens.RecoveryCodes = recoveryCodes
+	return tokens, nil
+}
+
+// EnrollTOTPForLogin starts enrollment for a user stopped at login because
+// their role requires two-factor authentication.
+func (uc *AuthUsecase) EnrollTOTPForLogin(ctx context.Context, challenge string) (*TOTPEnrollment, error) {
+	user, err := uc.challengeUser(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+	return uc.enrollTOTP(ctx, user)
+}
+
+// EnrollTOTP starts enrollment for the caller. It is confirmed by
+// ConfirmTOTP.
+func (uc *AuthUsecase) EnrollTOTP(ctx context.Context) (*TOTPEnrollment, error) {
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.enrollTOTP(ctx, user)
+}
+
+// ConfirmTOTP enables two-factor authentication with the first code from the
+// authenticator and returns the recovery codes.
+func (uc *AuthUsecase) ConfirmTOTP(ctx context.Context, code string) ([]string, error) {
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabledAt != nil {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+	if user.TOTPSecret == "" {
+		return nil, ErrTOTPNotEnrolled
+	}
+	if err := uc.checkSecondFactor(ctx, user, code, ""); err != nil {
+		return nil, err
+	}
+	return uc.enableTOTP(ctx, user)
+}
+
+// DisableTOTP turns two-factor authentication off for the caller, unless
+// their role requires it.
+func (uc *AuthUsecase) DisableTOTP(ctx context.Context, code string) error {
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if uc.twoFactor.required(user.Role) {
+		return ErrTOTPRequiredForRole
+	}
+	if user.TOTPEnabledAt == nil {
+		return ErrTOTPNotEnrolled
+	}
+	if err := uc.checkSecondFactor(ctx, user, code, ""); err != nil {
+		return err
+	}
+	if err := uc.repo.SetTOTP(ctx, user.ID, "", nil); err != nil {
+		return err
+	}
+	return uc.recoveryCodes.DeleteByUser(ctx, user.ID)
+}
+
+// RegenerateRecoveryCodes replaces the caller's recovery codes.
+func (uc *AuthUsecase) RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error) {
+	user, err := uc.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabledAt == nil {
+		return nil, ErrTOTPNotEnrolled
+	}
+	if err := uc.checkSecondFactor(ctx, user, code, ""); err != nil {
+		return nil, err
+	}
+	return uc.newRecoveryCodes(ctx, user.ID)
+}
+
+// ResetUserTOTP removes the user's two-factor authentication, for when the
+// authenticator and recovery codes are lost, and ends their sessions. Users
+// whose role requires it enroll again at their next login.
+func (uc *AuthUsecase) ResetUserTOTP(ctx context.Context, id uint) (*model.User, error) {
+	user, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+	if err := uc.repo.SetTOTP(ctx, id, "", nil); err != nil {
+		return nil, err
+	}
+	if err := uc.recoveryCodes.DeleteByUser(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := uc.sessions.DeleteByUser(ctx, id); err != nil {
+		return nil, err
+	}
+	var actorID *uint
+	if actor, ok := UserFromContext(ctx); ok {
+		actorID = &actor.ID
+	}
+	if err := uc.guard.record(ctx, model.AuthEventTOTPReset, user, user.Username, "", actorID, ""); err != nil {
+		return nil, err
+	}
+	user.TOTPSecret, user.TOTPEnabledAt = "", nil
+	return user, nil
+}
+
+func (uc *AuthUsecase) challengeUser(ctx context.Context, challenge string) (*model.User, error) {
+	userID, err := uc.tokens.Lookup(ctx, tokenLoginChallenge, hashToken(challenge))
+	if err != nil {
+		return nil, ErrInvalidChallenge
+	}
+	user, err := uc.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, ErrInvalidChallenge
+	}
+	return user, nil
+}
+
+func (uc *AuthUsecase) currentUser(ctx context.Context) (*model.User, error) {
+	caller, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return uc.repo.Get(ctx, caller.ID)
+}
+
+func (uc *AuthUsecase) enrollTOTP(ctx context.Context, user *model.User) (*TOTPEnrollment, error) {
+	if user.TOTPEnabledAt != nil {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.SetTOTP(ctx, user.ID, secret, nil); err != nil {
+		return nil, err
+	}
+	return &TOTPEnrollment{Secret: secret, URI: totp.URI(uc.twoFactor.Issuer, user.Username, secret)}, nil
+}
+
+func (uc *AuthUsecase) enableTOTP(ctx context.Context, user *model.User) ([]string, error) {
+	now := time.Now()
+	if err := uc.repo.SetTOTP(ctx, user.ID, user.TOTPSecret, &now); err != nil {
+		return nil, err
+	}
+	user.TOTPEnabledAt = &now
+	return uc.newRecoveryCodes(ctx, user.ID)
+}
+
+// checkSecondFactor accepts a TOTP code not used before or, once two-factor
+// authentication is enabled, an unused recovery code. Wrong codes count as
+// failed logins.
+func (uc *AuthUsecase) checkSecondFactor(ctx context.Context, user *model.User, code, ip string) error {
+	if step, ok := totp.Validate(user.TOTPSecret, code, time.Now()); ok {
+		fresh, err := uc.repo.UseTOTPStep(ctx, user.ID, step)
+		if err != nil {
+			return err
+		}
+		if fresh {
+			return nil
+		}
+	} else if user.TOTPEnabledAt != nil {
+		used, err := uc.recoveryCodes.Use(ctx, user.ID, hashToken(normalizeRecoveryCode(code)))
+		if err != nil {
+			return err
+		}
+		if used {
+			return nil
+		}
+	}
+	if err := uc.guard.Failed(ctx, user, user.Username, ip); !errors.Is(err, ErrInvalidCredentials) {
+		return err
+	}
+	return ErrInvalidTOTPCode
+}
+
+// newRecoveryCodes replaces the user's recovery codes and returns them. They
+// are shown once; only hashes are kept.
+func (uc *AuthUsecase) newRecoveryCodes(ctx context.Context, userID uint) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		raw := make([]byte, 10)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+		code := strings.ToLower(base32.StdEncoding.EncodeToString(raw))
+		codes[i] = code[:4] + "-" + code[4:8] + "-" + code[8:12] + "-" + code[12:]
+		hashes[i] = hashToken(code)
+	}
+	if err := uc.recoveryCodes.Replace(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"myapp/internal/data/model"
+	"myapp/internal/password"
+	"myapp/internal/repository"
+	"myapp/internal/totp"
+)
+
+// UseTOTPStep remembers the last step each user logged in with.
+func (f *fakeUsers) UseTOTPStep(_ context.Context, id uint, step int64) (bool, error) {
+	u, err := f.Get(context.Background(), id)
+	if err != nil {
+		return false, err
+	}
+	if step <= u.TOTPLastStep {
+		return false, nil
+	}
+	u.TOTPLastStep = step
+	return true, nil
+}
+
+type fakeRecoveryCodes struct {
+	repository.RecoveryCodeRepo
+	used map[string]bool // by hash
+}
+
+func (f *fakeRecoveryCodes) Replace(_ context.Context, _ uint, hashes []string) error {
+	f.used = make(map[string]bool, len(hashes))
+	for _, h := range hashes {
+		f.used[h] = false
+	}
+	return nil
+}
+
+func (f *fakeRecoveryCodes) Use(_ context.Context, _ uint, hash string) (bool, error) {
+	used, ok := f.used[hash]
+	if !ok || used {
+		return false, nil
+	}
+	f.used[hash] = true
+	return true, nil
+}
+
+// fakeAttempts counts failed logins without ever locking anyone out.
+type fakeAttempts struct {
+	repository.LoginAttemptRepo
+	failures int64
+}
+
+func (f *fakeAttempts) AddUserFailure(context.Context, string, time.Duration) (int64, error) {
+	f.failures++
+	return 1, nil
+}
+
+func (f *fakeAttempts) AddIPFailure(context.Context, string, time.Duration) (int64, error) {
+	return 1, nil
+}
+
+func newTwoFactorTestUsecase(t *testing.T, enabled bool) (*AuthUsecase, *model.User, *fakeAttempts) {
+	t.Helper()
+	hasher, err := password.NewHasher(password.Bcrypt, 4, password.Argon2Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &model.User{Username: "ana", TOTPSecret: secret}
+	if enabled {
+		enabledAt := time.Now()
+		user.TOTPEnabledAt = &enabledAt
+	}
+	users := &fakeUsers{}
+	users.Create(context.Background(), user)
+	attempts := &fakeAttempts{}
+	return &AuthUsecase{
+		repo:          users,
+		recoveryCodes: &fakeRecoveryCodes{},
+		guard:         NewLoginGuard(attempts, nil, hasher, LockoutPolicy{}),
+		hasher:        hasher,
+	}, user, attempts
+}
+
+func TestRecoveryCodeIsSingleUse(t *testing.T) {
+	uc, user, attempts := newTwoFactorTestUsecase(t, true)
+	ctx := context.Background()
+	codes, err := uc.newRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != recoveryCodeCount {
+		t.Fatalf("got %d codes, want %d", len(codes), recoveryCodeCount)
+	}
+
+	if err := uc.checkSecondFactor(ctx, user, codes[0], "198.51.100.1"); err != nil {
+		t.Fatalf("unused recovery code refused: %v", err)
+	}
+	if err := uc.checkSecondFactor(ctx, user, codes[0], "198.51.100.1"); !errors.Is(err, ErrInvalidTOTPCode) {
+		t.Fatalf("reused recovery code: got %v, want ErrInvalidTOTPCode", err)
+	}
+	if attempts.failures != 1 {
+		t.Fatalf("got %d failed logins, want 1", attempts.failures)
+	}
+
+	// Codes are accepted however they are typed.
+	typed := strings.ToUpper(strings.ReplaceAll(codes[1], "-", " "))
+	if err := uc.checkSecondFactor(ctx, user, typed, ""); err != nil {
+		t.Fatalf("recovery code %q refused: %v", typed, err)
+	}
+
+	// New codes replace the old ones.
+	if _, err := uc.newRecoveryCodes(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.checkSecondFactor(ctx, user, codes[2], ""); !errors.Is(err, ErrInvalidTOTPCode) {
+		t.Fatalf("replaced recovery code: got %v, want ErrInvalidTOTPCode", err)
+	}
+}
+
+func TestRecoveryCodeNeedsTwoFactorEnabled(t *testing.T) {
+	uc, user, _ := newTwoFactorTestUsecase(t, false)
+	ctx := context.Background()
+	codes, err := uc.newRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.checkSecondFactor(ctx, user, codes[0], ""); !errors.Is(err, ErrInvalidTOTPCode) {
+		t.Fatalf("got %v, want ErrInvalidTOTPCode", err)
+	}
+}
+
+func TestTOTPCodeIsSingleUse(t *testing.T) {
+	uc, user, attempts := newTwoFactorTestUsecase(t, true)
+	ctx := context.Background()
+	code, err := totp.Code(user.TOTPSecret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := uc.checkSecondFactor(ctx, user, code, ""); err != nil {
+		t.Fatalf("fresh code refused: %v", err)
+	}
+	if err := uc.checkSecondFactor(ctx, user, code, ""); !errors.Is(err, ErrInvalidTOTPCode) {
+		t.Fatalf("replayed code: got %v, want ErrInvalidTOTPCode", err)
+	}
+	// A code from before the last one used is refused too.
+	earlier, _ := totp.Code(user.TOTPSecret, totp.Step(time.Now())-1)
+	if err := uc.checkSecondFactor(ctx, user, earlier, ""); !errors.Is(err, ErrInvalidTOTPCode) {
+		t.Fatalf("earlier code: got %v, want ErrInvalidTOTPCode", err)
+	}
+	if attempts.failures != 2 {
+		t.Fatalf("got %d failed logins, want 2", attempts.failures)
+	}
+}
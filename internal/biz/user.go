@@ -3,69 +3,177 @@ package biz
 import (
 	"context"
 	"errors"
-	"strconv"
 	"time"
 
 	"myapp/internal/data/model"
+	"myapp/internal/password"
 	"myapp/internal/repository"
-
-	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
+	"myapp/internal/signing"
 )
 
+var ErrInvalidRole = errors.New("role must be admin, hr, payroll, manager or employee")
+
 type AuthUsecase struct {
-	repo     *repository.UserRepo
-	redis    *repository.RedisRepo // Assuming RedisRepo for token storage
-	secret   string                // JWT secret
-	tokenExp int                   // Token expiration in minutes
+	repo            repository.UserRepo
+	sessions        repository.SessionRepo
+	tokens          repository.AuthTokenRepo
+	guard           *LoginGuard
+	hasher          *password.Hasher
+	passwordPolicy  PasswordPolicy
+	passwordHistory repository.PasswordHistoryRepo
+	recoveryCodes   repository.RecoveryCodeRepo
+	twoFactor       TwoFactorPolicy
+	oidc            OIDCPolicy
+	identities      repository.ExternalIdentityRepo
+	oidcStates      repository.OIDCStateRepo
+	keys            *signing.Keyring // signs access tokens
+	tokenExp        int              // Access token expiration in minutes
+	refreshExp      int              // Refresh token (session) expiration in minutes
+	admins          map[string]bool  // usernames promoted to admin on login
+	invitationRepo  repository.InvitationRepo
+	employeeRepo    repository.EmployeeRepo
+	emailRepo       repository.EmailRepo
+	invitationURL   string
+
+	// Pages that take the emailed tokens; the token is added as ?token=.
+	verificationURL  string
+	passwordResetURL string
 }
 
-func NewAuthUsecase(repo *repository.UserRepo, redis *repository.RedisRepo, secret string, tokenExp int) *AuthUsecase {
-	return &AuthUsecase{repo: repo, redis: redis, secret: secret, tokenExp: tokenExp}
+func NewAuthUsecase(repo repository.UserRepo, sessions repository.SessionRepo, tokens repository.AuthTokenRepo, guard *LoginGuard, hasher *password.Hasher, passwordPolicy PasswordPolicy, passwordHistory repository.PasswordHistoryRepo, recoveryCodes repository.RecoveryCodeRepo, twoFactor TwoFactorPolicy, oidcPolicy OIDCPolicy, identities repository.ExternalIdentityRepo, oidcStates repository.OIDCStateRepo, keys *signing.Keyring, tokenExp, refreshExp int, admins []string, invitationRepo repository.InvitationRepo, employeeRepo repository.EmployeeRepo, emailRepo repository.EmailRepo, invitationURL, verificationURL, passwordResetURL string) *AuthUsecase {
+	uc := &AuthUsecase{
+		repo:            repo,
+		sessions:        sessions,
+		tokens:          tokens,
+		guard:           guard,
+		hasher:          hasher,
+		passwordPolicy:  passwordPolicy,
+		passwordHistory: passwordHistory,
+		recoveryCodes:   recoveryCodes,
+		twoFactor:       twoFactor,
+		oidc:            oidcPolicy,
+		identities:      identities,
+		oidcStates:      oidcStates,
+		keys:            keys,
+		tokenExp:        tokenExp,
+		refreshExp:      refreshExp,
+		admins:          make(map[string]bool, len(admins)),
+		invitationRepo:  invitationRepo,
+		employeeRepo:    employeeRepo,
+		emailRepo:       emailRepo,
+		invitationURL:   invitationURL,
+
+		verificationURL:  verificationURL,
+		passwordResetURL: passwordResetURL,
+	}
+	for _, a := range admins {
+		uc.admins[a] = true
+	}
+	return uc
 }
 
-func (uc *AuthUsecase) Register(ctx context.Context, username, password, email string) error {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+// Register creates an account and emails a link to verify its address. The
+// account can't log in until the link is followed.
+func (uc *AuthUsecase) Register(ctx context.Context, username, password, email, ip string) error {
+	email = normalizeEmail(email)
+	if err := uc.limit(ctx, "register", "", ip); err != nil {
+		return err
+	}
+	hashedPassword, err := uc.newPasswordHash(ctx, nil, username, email, password)
 	if err != nil {
 		return err
 	}
 
 	user := &model.User{
 		Username: username,
-		Password: string(hashedPassword),
+		Password: hashedPassword,
 		Email:    email,
+		Role:     model.RoleEmployee,
 	}
 
-	return uc.repo.Create(ctx, user)
+	if err := uc.repo.Create(ctx, user); err != nil {
+		return err
+	}
+	if err := uc.recordPassword(ctx, user.ID, hashedPassword); err != nil {
+		return err
+	}
+	return uc.sendVerification(ctx, user)
 }
 
-func (uc *AuthUsecase) Login(ctx context.Context, username, password string) (string, error) {
+// Login checks the credentials and starts a session for the device,
+// returning its first access and refresh token, or a challenge when a second
+// factor is needed. Unknown usernames and wrong passwords fail alike, and
+// repeated failures lock the username.
+func (uc *AuthUsecase) Login(ctx context.Context, username, password, device, ip string) (*TokenPair, error) {
+	if err := uc.guard.Check(ctx, username, ip); err != nil {
+		return nil, err
+	}
 	user, err := uc.repo.GetByUsername(ctx, username)
 	if err != nil {
-		return "", errors.New("user not found")
+		user = nil
+	}
+	if !uc.guard.comparePassword(user, password) {
+		return nil, uc.guard.Failed(ctx, user, username, ip)
+	}
+	if user.EmailVerifiedAt == nil {
+		return nil, ErrEmailNotVerified
+	}
+	if err := uc.rehashPassword(ctx, user, password); err != nil {
+		return nil, err
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
-		return "", errors.New("invalid password")
+	if uc.admins[user.Username] && user.Role != model.RoleAdmin {
+		if err := uc.repo.UpdateRole(ctx, user.ID, model.RoleAdmin); err != nil {
+			return nil, err
+		}
+		user.Role = model.RoleAdmin
 	}
 
-	// Generate JWT token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"username": username,
-		"id":       user.ID,
-		"exp":      time.Now().Add(time.Minute * time.Duration(uc.tokenExp)).Unix(),
-	})
+	// Failures are only cleared once the second factor is right too, so
+	// a known password doesn't allow unlimited guesses at the code.
+	if user.TOTPEnabledAt != nil || uc.twoFactor.required(user.Role) {
+		return uc.loginChallenge(ctx, user)
+	}
+	if err := uc.guard.Succeeded(ctx, username); err != nil {
+		return nil, err
+	}
+	return uc.startSession(ctx, user, device, ip)
+}
 
-	signedToken, err := token.SignedString([]byte(uc.secret))
+// startSession creates a session for the device and issues its first tokens.
+func (uc *AuthUsecase) startSession(ctx context.Context, user *model.User, device, ip string) (*TokenPair, error) {
+	id, err := randomToken(16)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	now := time.Now()
+	session := &model.Session{
+		ID:         id,
+		UserID:     user.ID,
+		Device:     device,
+		IP:         ip,
+		CreatedAt:  now,
+		LastUsedAt: now,
 	}
+	return uc.issueTokens(ctx, user, session)
+}
 
-	// Store token in Redis for validation/revocation
-	err = uc.redis.Set(ctx, "token:"+strconv.Itoa(int(user.ID)), signedToken, time.Minute*time.Duration(uc.tokenExp))
+// SetRole changes a user's role and ends their sessions so the new role takes
+// effect on the next login.
+func (uc *AuthUsecase) SetRole(ctx context.Context, id uint, role string) (*model.User, error) {
+	if !ValidRole(role) {
+		return nil, ErrInvalidRole
+	}
+	user, err := uc.repo.Get(ctx, id)
 	if err != nil {
-		return "", err
+		return nil, errors.New("user not found")
 	}
-
-	return signedToken, nil
-}
\ No newline at end of file
+	if err := uc.repo.UpdateRole(ctx, id, role); err != nil {
+		return nil, err
+	}
+	user.Role = role
+	if err := uc.sessions.DeleteByUser(ctx, id); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
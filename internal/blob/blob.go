@@ -0,0 +1,20 @@
+// Package blob stores uploaded files outside the database. The local store
+// keeps them on disk; the S3 store works with AWS S3 and compatible servers
+// such as MinIO.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Store saves and serves opaque objects by key. Keys are slash-separated
+// relative paths chosen by the caller.
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,71 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestS3Store runs against a real S3-compatible server, e.g.
+//
+//	docker run -p 9000:9000 minio/minio server /data
+//	S3_TEST_ENDPOINT=localhost:9000 go test ./internal/blob
+//
+// Credentials default to MinIO's minioadmin/minioadmin.
+func TestS3Store(t *testing.T) {
+	endpoint := os.Getenv("S3_TEST_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("S3_TEST_ENDPOINT not set")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	store, err := NewS3Store(ctx, endpoint,
+		envOr("S3_TEST_ACCESS_KEY", "minioadmin"),
+		envOr("S3_TEST_SECRET_KEY", "minioadmin"),
+		envOr("S3_TEST_BUCKET", "myapp-test"),
+		os.Getenv("S3_TEST_REGION"),
+		os.Getenv("S3_TEST_USE_SSL") == "true")
+	if err != nil {
+		t.Fatalf("NewS3Store: %v", err)
+	}
+
+	key := fmt.Sprintf("test/%d.txt", time.Now().UnixNano())
+	content := []byte("hello from the blob store test")
+	if err := store.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "text/plain"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	t.Cleanup(func() { store.Delete(context.Background(), key) })
+
+	r, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	got, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatalf("read object: %v", err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Fatalf("Get returned %q, want %q", got, content)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get after Delete: got %v, want ErrNotFound", err)
+	}
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,106 @@
+// Package clientip finds the address of the client behind a request.
+// X-Forwarded-For is only believed when the connection comes from a
+// configured reverse proxy; anyone else could set it to any value.
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Resolver knows which peers are trusted proxies. A nil Resolver trusts none.
+type Resolver struct {
+	trusted []*net.IPNet
+}
+
+// NewResolver trusts the proxies listed as IP addresses or CIDR ranges.
+func NewResolver(proxies []string) (*Resolver, error) {
+	r := &Resolver{}
+	for _, p := range proxies {
+		p = strings.TrimSpace(p)
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("clientip: invalid trusted proxy %q", p)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			r.trusted = append(r.trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("clientip: invalid trusted proxy %q", p)
+		}
+		r.trusted = append(r.trusted, network)
+	}
+	return r, nil
+}
+
+// IP returns the client address of req. When the peer is a trusted proxy,
+// X-Forwarded-For is read from the right and the first hop that is not a
+// trusted proxy wins, so a client can't prepend addresses of its choosing.
+func (r *Resolver) IP(req *http.Request) string {
+	ip := Remote(req)
+	if !r.isTrusted(ip) {
+		return ip
+	}
+	var hops []string
+	for _, h := range req.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(h, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !r.isTrusted(hop) {
+			return hop
+		}
+	}
+	return ip
+}
+
+func (r *Resolver) isTrusted(ip string) bool {
+	if r == nil {
+		return false
+	}
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, n := range r.trusted {
+		if n.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remote returns the host part of req.RemoteAddr, the peer the connection
+// came from.
+func Remote(req *http.Request) string {
+	if host, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		return host
+	}
+	return strings.TrimSpace(req.RemoteAddr)
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the client address.
+func NewContext(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, contextKey{}, ip)
+}
+
+// FromContext returns the client address stored by NewContext.
+func FromContext(ctx context.Context) (string, bool) {
+	ip, ok := ctx.Value(contextKey{}).(string)
+	return ip, ok
+}
@@ -0,0 +1,74 @@
+package clientip
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolverIP(t *testing.T) {
+	r, err := NewResolver([]string{"10.0.0.1", "172.16.0.0/12", "2001:db8::1", "fd00::/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		remote string
+		xff    []string
+		want   string
+	}{
+		{"no proxy", "203.0.113.7:5000", nil, "203.0.113.7"},
+		{"forged header from an untrusted peer", "203.0.113.7:5000", []string{"198.51.100.1"}, "203.0.113.7"},
+		{"trusted proxy", "10.0.0.1:443", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"client-supplied hops left of the proxy are ignored", "10.0.0.1:443", []string{"1.1.1.1, 198.51.100.1"}, "198.51.100.1"},
+		{"right-most untrusted hop through a proxy chain", "10.0.0.1:443", []string{"1.1.1.1, 198.51.100.1, 172.20.0.5"}, "198.51.100.1"},
+		{"chain split over several headers", "10.0.0.1:443", []string{"1.1.1.1, 198.51.100.1", "172.20.0.5"}, "198.51.100.1"},
+		{"only trusted hops", "10.0.0.1:443", []string{"172.20.0.5, 172.16.0.9"}, "172.20.0.5"},
+		{"garbage stops the walk", "10.0.0.1:443", []string{"198.51.100.1, not-an-ip, 172.20.0.5"}, "172.20.0.5"},
+		{"trusted proxy without a header", "10.0.0.1:443", nil, "10.0.0.1"},
+		{"address outside a trusted range", "172.32.0.1:443", []string{"198.51.100.1"}, "172.32.0.1"},
+		{"IPv6 proxy", "[2001:db8::1]:443", []string{"2001:db8:abcd::9"}, "2001:db8:abcd::9"},
+		{"IPv6 range", "[fd12::3]:443", []string{"198.51.100.1, fd00::4"}, "198.51.100.1"},
+		{"IPv6 untrusted peer", "[2001:db8::2]:443", []string{"198.51.100.1"}, "2001:db8::2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tt.remote
+			for _, h := range tt.xff {
+				req.Header.Add("X-Forwarded-For", h)
+			}
+			if got := r.IP(req); got != tt.want {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNilResolverTrustsNoOne(t *testing.T) {
+	var r *Resolver
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.1:443"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	if got := r.IP(req); got != "10.0.0.1" {
+		t.Fatalf("got %s, want the peer", got)
+	}
+}
+
+func TestNewResolverRejectsInvalidEntries(t *testing.T) {
+	for _, p := range []string{"proxy.internal", "10.0.0.300", "10.0.0.0/33", "fd00::/129"} {
+		if _, err := NewResolver([]string{p}); err == nil {
+			t.Errorf("NewResolver accepted %q", p)
+		}
+	}
+}
+
+func TestContext(t *testing.T) {
+	if _, ok := FromContext(context.Background()); ok {
+		t.Fatal("empty context has an address")
+	}
+	ip, ok := FromContext(NewContext(context.Background(), "198.51.100.1"))
+	if !ok || ip != "198.51.100.1" {
+		t.Fatalf("got %q, %v", ip, ok)
+	}
+}
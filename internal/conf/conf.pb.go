@@ -26,6 +26,7 @@ type Bootstrap struct {
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	Auth          *Auth                  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
+	Overtime      *Overtime              `protobuf:"bytes,4,opt,name=overtime,proto3" json:"overtime,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +82,13 @@ func (x *Bootstrap) GetAuth() *Auth {
 	return nil
 }
 
+func (x *Bootstrap) GetOvertime() *Overtime {
+	if x != nil {
+		return x.Overtime
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *HTTP                  `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -126,80 +134,726 @@ func (x *Server) GetHttp() *HTTP {
 }
 
 type Auth struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	JwtSecret        string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`                        // no longer used; access tokens are signed with signing keys
+	TokenExp         int32                  `protobuf:"varint,2,opt,name=token_exp,json=tokenExp,proto3" json:"token_exp,omitempty"`                          // access token lifetime in minutes
+	PiiViewers       []string               `protobuf:"bytes,3,rep,name=pii_viewers,json=piiViewers,proto3" json:"pii_viewers,omitempty"`                     // usernames allowed to see unmasked bank accounts, tax IDs and salaries
+	Admins           []string               `protobuf:"bytes,4,rep,name=admins,proto3" json:"admins,omitempty"`                                               // usernames given the admin role when they log in
+	InvitationUrl    string                 `protobuf:"bytes,5,opt,name=invitation_url,json=invitationUrl,proto3" json:"invitation_url,omitempty"`            // page that accepts invitations; the token is added as ?token=
+	RefreshTokenExp  int32                  `protobuf:"varint,6,opt,name=refresh_token_exp,json=refreshTokenExp,proto3" json:"refresh_token_exp,omitempty"`   // session lifetime in minutes, renewed by each refresh
+	VerificationUrl  string                 `protobuf:"bytes,7,opt,name=verification_url,json=verificationUrl,proto3" json:"verification_url,omitempty"`      // page that verifies email addresses; the token is added as ?token=
+	PasswordResetUrl string                 `protobuf:"bytes,8,opt,name=password_reset_url,json=passwordResetUrl,proto3" json:"password_reset_url,omitempty"` // page that sets a new password; the token is added as ?token=
+	Lockout          *Lockout               `protobuf:"bytes,9,opt,name=lockout,proto3" json:"lockout,omitempty"`
+	TwoFactor        *TwoFactor             `protobuf:"bytes,10,opt,name=two_factor,json=twoFactor,proto3" json:"two_factor,omitempty"`
+	Signing          *Auth_Signing          `protobuf:"bytes,11,opt,name=signing,proto3" json:"signing,omitempty"`
+	Password         *Auth_Password         `protobuf:"bytes,12,opt,name=password,proto3" json:"password,omitempty"`
+	Oidc             *Auth_Oidc             `protobuf:"bytes,13,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Auth) Reset() {
+	*x = Auth{}
+	mi := &file_internal_conf_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth) ProtoMessage() {}
+
+func (x *Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Auth) GetJwtSecret() string {
+	if x != nil {
+		return x.JwtSecret
+	}
+	return ""
+}
+
+func (x *Auth) GetTokenExp() int32 {
+	if x != nil {
+		return x.TokenExp
+	}
+	return 0
+}
+
+func (x *Auth) GetPiiViewers() []string {
+	if x != nil {
+		return x.PiiViewers
+	}
+	return nil
+}
+
+func (x *Auth) GetAdmins() []string {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *Auth) GetInvitationUrl() string {
+	if x != nil {
+		return x.InvitationUrl
+	}
+	return ""
+}
+
+func (x *Auth) GetRefreshTokenExp() int32 {
+	if x != nil {
+		return x.RefreshTokenExp
+	}
+	return 0
+}
+
+func (x *Auth) GetVerificationUrl() string {
+	if x != nil {
+		return x.VerificationUrl
+	}
+	return ""
+}
+
+func (x *Auth) GetPasswordResetUrl() string {
+	if x != nil {
+		return x.PasswordResetUrl
+	}
+	return ""
+}
+
+func (x *Auth) GetLockout() *Lockout {
+	if x != nil {
+		return x.Lockout
+	}
+	return nil
+}
+
+func (x *Auth) GetTwoFactor() *TwoFactor {
+	if x != nil {
+		return x.TwoFactor
+	}
+	return nil
+}
+
+func (x *Auth) GetSigning() *Auth_Signing {
+	if x != nil {
+		return x.Signing
+	}
+	return nil
+}
+
+func (x *Auth) GetPassword() *Auth_Password {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *Auth) GetOidc() *Auth_Oidc {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+type TwoFactor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`                                    // name shown in authenticator apps
+	RequiredRoles []string               `protobuf:"bytes,2,rep,name=required_roles,json=requiredRoles,proto3" json:"required_roles,omitempty"` // roles that must use TOTP; others may opt in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwoFactor) Reset() {
+	*x = TwoFactor{}
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactor) ProtoMessage() {}
+
+func (x *TwoFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactor.ProtoReflect.Descriptor instead.
+func (*TwoFactor) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TwoFactor) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *TwoFactor) GetRequiredRoles() []string {
+	if x != nil {
+		return x.RequiredRoles
+	}
+	return nil
+}
+
+// Lockout sets when failed logins lock an account. Durations are in minutes;
+// zero values take the defaults shown in config.yaml.
+type Lockout struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts     int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"` // failures per username within window that lock it
+	Window          int32                  `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	LockDuration    int32                  `protobuf:"varint,3,opt,name=lock_duration,json=lockDuration,proto3" json:"lock_duration,omitempty"` // first lock, doubled by each further lockout
+	MaxLockDuration int32                  `protobuf:"varint,4,opt,name=max_lock_duration,json=maxLockDuration,proto3" json:"max_lock_duration,omitempty"`
+	IpMaxAttempts   int32                  `protobuf:"varint,5,opt,name=ip_max_attempts,json=ipMaxAttempts,proto3" json:"ip_max_attempts,omitempty"` // failures per IP within window before its logins are refused
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Lockout) Reset() {
+	*x = Lockout{}
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lockout) ProtoMessage() {}
+
+func (x *Lockout) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lockout.ProtoReflect.Descriptor instead.
+func (*Lockout) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Lockout) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Lockout) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *Lockout) GetLockDuration() int32 {
+	if x != nil {
+		return x.LockDuration
+	}
+	return 0
+}
+
+func (x *Lockout) GetMaxLockDuration() int32 {
+	if x != nil {
+		return x.MaxLockDuration
+	}
+	return 0
+}
+
+func (x *Lockout) GetIpMaxAttempts() int32 {
+	if x != nil {
+		return x.IpMaxAttempts
+	}
+	return 0
+}
+
+// Overtime caps on recorded overtime hours. A zero limit disables that cap.
+type Overtime struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JwtSecret     string                 `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
-	TokenExp      int32                  `protobuf:"varint,2,opt,name=token_exp,json=tokenExp,proto3" json:"token_exp,omitempty"`
+	DailyLimit    float64                `protobuf:"fixed64,1,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`
+	MonthlyLimit  float64                `protobuf:"fixed64,2,opt,name=monthly_limit,json=monthlyLimit,proto3" json:"monthly_limit,omitempty"`
+	YearlyLimit   float64                `protobuf:"fixed64,3,opt,name=yearly_limit,json=yearlyLimit,proto3" json:"yearly_limit,omitempty"`
+	Mode          string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`                              // "warn" or "reject"
+	WarnRatio     float64                `protobuf:"fixed64,5,opt,name=warn_ratio,json=warnRatio,proto3" json:"warn_ratio,omitempty"` // share of a limit at which the report starts listing an employee
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Auth) Reset() {
-	*x = Auth{}
-	mi := &file_internal_conf_conf_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Overtime) Reset() {
+	*x = Overtime{}
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Overtime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Overtime) ProtoMessage() {}
+
+func (x *Overtime) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Overtime.ProtoReflect.Descriptor instead.
+func (*Overtime) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Overtime) GetDailyLimit() float64 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *Overtime) GetMonthlyLimit() float64 {
+	if x != nil {
+		return x.MonthlyLimit
+	}
+	return 0
+}
+
+func (x *Overtime) GetYearlyLimit() float64 {
+	if x != nil {
+		return x.YearlyLimit
+	}
+	return 0
+}
+
+func (x *Overtime) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Overtime) GetWarnRatio() float64 {
+	if x != nil {
+		return x.WarnRatio
+	}
+	return 0
+}
+
+type HTTP struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Addr    string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout int32                  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Reverse proxies (IPs or CIDR ranges) whose X-Forwarded-For is believed.
+	// Without any, the client IP is always the connection's peer.
+	TrustedProxies []string `protobuf:"bytes,3,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HTTP) Reset() {
+	*x = HTTP{}
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTP) ProtoMessage() {}
+
+func (x *HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTP.ProtoReflect.Descriptor instead.
+func (*HTTP) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *HTTP) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *HTTP) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Email         *Data_Email            `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Encryption    *Data_Encryption       `protobuf:"bytes,4,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	Storage       *Data_Storage          `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Data) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+func (x *Data) GetEmail() *Data_Email {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *Data) GetEncryption() *Data_Encryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return nil
+}
+
+func (x *Data) GetStorage() *Data_Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+// Signing holds the keys access tokens are signed with (RS256 for RSA keys,
+// EdDSA for Ed25519). New tokens use active_key; every listed key verifies
+// and is published at /.well-known/jwks.json. To rotate, add a key, make it
+// active and remove the old one once token_exp has passed. Without keys a
+// throwaway key is generated at startup.
+type Auth_Signing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveKey     string                 `protobuf:"bytes,1,opt,name=active_key,json=activeKey,proto3" json:"active_key,omitempty"`
+	Keys          map[string]string      `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // key id -> PEM file; retired keys may be public keys
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Signing) Reset() {
+	*x = Auth_Signing{}
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Signing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Signing) ProtoMessage() {}
+
+func (x *Auth_Signing) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Signing.ProtoReflect.Descriptor instead.
+func (*Auth_Signing) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Auth_Signing) GetActiveKey() string {
+	if x != nil {
+		return x.ActiveKey
+	}
+	return ""
+}
+
+func (x *Auth_Signing) GetKeys() map[string]string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Password sets the rules for new passwords and how they are hashed.
+// Existing hashes keep working after the hasher changes and are upgraded
+// when their owner next logs in.
+type Auth_Password struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MinLength         int32                  `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength         int32                  `protobuf:"varint,2,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	RequireUpper      bool                   `protobuf:"varint,3,opt,name=require_upper,json=requireUpper,proto3" json:"require_upper,omitempty"`
+	RequireLower      bool                   `protobuf:"varint,4,opt,name=require_lower,json=requireLower,proto3" json:"require_lower,omitempty"`
+	RequireDigit      bool                   `protobuf:"varint,5,opt,name=require_digit,json=requireDigit,proto3" json:"require_digit,omitempty"`
+	RequireSymbol     bool                   `protobuf:"varint,6,opt,name=require_symbol,json=requireSymbol,proto3" json:"require_symbol,omitempty"`
+	BreachedListFile  string                 `protobuf:"bytes,7,opt,name=breached_list_file,json=breachedListFile,proto3" json:"breached_list_file,omitempty"` // passwords or SHA-1 hashes, one per line
+	History           int32                  `protobuf:"varint,8,opt,name=history,proto3" json:"history,omitempty"`                                            // latest passwords that can't be chosen again
+	Hasher            string                 `protobuf:"bytes,9,opt,name=hasher,proto3" json:"hasher,omitempty"`                                               // bcrypt (default) or argon2id
+	BcryptCost        int32                  `protobuf:"varint,10,opt,name=bcrypt_cost,json=bcryptCost,proto3" json:"bcrypt_cost,omitempty"`
+	Argon2Memory      uint32                 `protobuf:"varint,11,opt,name=argon2_memory,json=argon2Memory,proto3" json:"argon2_memory,omitempty"` // KiB
+	Argon2Iterations  uint32                 `protobuf:"varint,12,opt,name=argon2_iterations,json=argon2Iterations,proto3" json:"argon2_iterations,omitempty"`
+	Argon2Parallelism uint32                 `protobuf:"varint,13,opt,name=argon2_parallelism,json=argon2Parallelism,proto3" json:"argon2_parallelism,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Auth_Password) Reset() {
+	*x = Auth_Password{}
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Password) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Password) ProtoMessage() {}
+
+func (x *Auth_Password) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Password.ProtoReflect.Descriptor instead.
+func (*Auth_Password) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Auth_Password) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetRequireUpper() bool {
+	if x != nil {
+		return x.RequireUpper
+	}
+	return false
+}
+
+func (x *Auth_Password) GetRequireLower() bool {
+	if x != nil {
+		return x.RequireLower
+	}
+	return false
+}
+
+func (x *Auth_Password) GetRequireDigit() bool {
+	if x != nil {
+		return x.RequireDigit
+	}
+	return false
+}
+
+func (x *Auth_Password) GetRequireSymbol() bool {
+	if x != nil {
+		return x.RequireSymbol
+	}
+	return false
+}
+
+func (x *Auth_Password) GetBreachedListFile() string {
+	if x != nil {
+		return x.BreachedListFile
+	}
+	return ""
 }
 
-func (x *Auth) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Auth_Password) GetHistory() int32 {
+	if x != nil {
+		return x.History
+	}
+	return 0
 }
 
-func (*Auth) ProtoMessage() {}
+func (x *Auth_Password) GetHasher() string {
+	if x != nil {
+		return x.Hasher
+	}
+	return ""
+}
 
-func (x *Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[2]
+func (x *Auth_Password) GetBcryptCost() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BcryptCost
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
-func (*Auth) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2}
+func (x *Auth_Password) GetArgon2Memory() uint32 {
+	if x != nil {
+		return x.Argon2Memory
+	}
+	return 0
 }
 
-func (x *Auth) GetJwtSecret() string {
+func (x *Auth_Password) GetArgon2Iterations() uint32 {
 	if x != nil {
-		return x.JwtSecret
+		return x.Argon2Iterations
 	}
-	return ""
+	return 0
 }
 
-func (x *Auth) GetTokenExp() int32 {
+func (x *Auth_Password) GetArgon2Parallelism() uint32 {
 	if x != nil {
-		return x.TokenExp
+		return x.Argon2Parallelism
 	}
 	return 0
 }
 
-type HTTP struct {
+// Oidc enables login through the company's OpenID Connect provider next to
+// password login. Users are linked to accounts with the same verified
+// email; others get an account on first login. Leave issuer empty to turn
+// it off.
+type Auth_Oidc struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addr          string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       int32                  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	RedirectUrl   string                 `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`                                                                           // frontend page the provider returns to with ?code=&state=
+	Scopes        []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`                                                                                                        // requested besides openid; default email and profile
+	RoleClaim     string                 `protobuf:"bytes,6,opt,name=role_claim,json=roleClaim,proto3" json:"role_claim,omitempty"`                                                                                 // ID token claim holding e.g. the user's groups
+	RoleMapping   map[string]string      `protobuf:"bytes,7,rep,name=role_mapping,json=roleMapping,proto3" json:"role_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // claim value -> role; the most privileged match wins
+	DefaultRole   string                 `protobuf:"bytes,8,opt,name=default_role,json=defaultRole,proto3" json:"default_role,omitempty"`                                                                           // role without a match; employee when empty
+	SyncRole      bool                   `protobuf:"varint,9,opt,name=sync_role,json=syncRole,proto3" json:"sync_role,omitempty"`                                                                                   // update the role from the claims at every login
+	TrustEmail    bool                   `protobuf:"varint,10,opt,name=trust_email,json=trustEmail,proto3" json:"trust_email,omitempty"`                                                                            // accept emails the provider doesn't mark as verified
+	SkipTwoFactor bool                   `protobuf:"varint,11,opt,name=skip_two_factor,json=skipTwoFactor,proto3" json:"skip_two_factor,omitempty"`                                                                 // the provider enforces its own second factor
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HTTP) Reset() {
-	*x = HTTP{}
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+func (x *Auth_Oidc) Reset() {
+	*x = Auth_Oidc{}
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HTTP) String() string {
+func (x *Auth_Oidc) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HTTP) ProtoMessage() {}
+func (*Auth_Oidc) ProtoMessage() {}
 
-func (x *HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[3]
+func (x *Auth_Oidc) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,83 +864,86 @@ func (x *HTTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HTTP.ProtoReflect.Descriptor instead.
-func (*HTTP) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Auth_Oidc.ProtoReflect.Descriptor instead.
+func (*Auth_Oidc) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2, 2}
 }
 
-func (x *HTTP) GetAddr() string {
+func (x *Auth_Oidc) GetIssuer() string {
 	if x != nil {
-		return x.Addr
+		return x.Issuer
 	}
 	return ""
 }
 
-func (x *HTTP) GetTimeout() int32 {
+func (x *Auth_Oidc) GetClientId() string {
 	if x != nil {
-		return x.Timeout
+		return x.ClientId
 	}
-	return 0
+	return ""
 }
 
-type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
-	Email         *Data_Email            `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Auth_Oidc) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
 }
 
-func (x *Data) Reset() {
-	*x = Data{}
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Auth_Oidc) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
 }
 
-func (x *Data) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Auth_Oidc) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
 }
 
-func (*Data) ProtoMessage() {}
+func (x *Auth_Oidc) GetRoleClaim() string {
+	if x != nil {
+		return x.RoleClaim
+	}
+	return ""
+}
 
-func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[4]
+func (x *Auth_Oidc) GetRoleMapping() map[string]string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RoleMapping
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Data.ProtoReflect.Descriptor instead.
-func (*Data) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4}
+func (x *Auth_Oidc) GetDefaultRole() string {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return ""
 }
 
-func (x *Data) GetDatabase() *Data_Database {
+func (x *Auth_Oidc) GetSyncRole() bool {
 	if x != nil {
-		return x.Database
+		return x.SyncRole
 	}
-	return nil
+	return false
 }
 
-func (x *Data) GetRedis() *Data_Redis {
+func (x *Auth_Oidc) GetTrustEmail() bool {
 	if x != nil {
-		return x.Redis
+		return x.TrustEmail
 	}
-	return nil
+	return false
 }
 
-func (x *Data) GetEmail() *Data_Email {
+func (x *Auth_Oidc) GetSkipTwoFactor() bool {
 	if x != nil {
-		return x.Email
+		return x.SkipTwoFactor
 	}
-	return nil
+	return false
 }
 
 type Data_Database struct {
@@ -299,7 +956,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +968,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_internal_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +981,7 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
 func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4, 0}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *Data_Database) GetDriver() string {
@@ -352,7 +1009,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +1021,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	mi := &file_internal_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +1034,7 @@ func (x *Data_Redis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
 func (*Data_Redis) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4, 1}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7, 1}
 }
 
 func (x *Data_Redis) GetAddr() string {
@@ -415,7 +1072,7 @@ type Data_Email struct {
 
 func (x *Data_Email) Reset() {
 	*x = Data_Email{}
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -427,7 +1084,7 @@ func (x *Data_Email) String() string {
 func (*Data_Email) ProtoMessage() {}
 
 func (x *Data_Email) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	mi := &file_internal_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +1097,7 @@ func (x *Data_Email) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Data_Email.ProtoReflect.Descriptor instead.
 func (*Data_Email) Descriptor() ([]byte, []int) {
-	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4, 2}
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7, 2}
 }
 
 func (x *Data_Email) GetHost() string {
@@ -485,28 +1142,308 @@ func (x *Data_Email) GetFromEmail() string {
 	return ""
 }
 
+// Encryption holds the master keys for personal data columns. Values are
+// sealed with active_key; retired keys stay listed until rotate-keys has
+// re-encrypted every row.
+type Data_Encryption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveKey     string                 `protobuf:"bytes,1,opt,name=active_key,json=activeKey,proto3" json:"active_key,omitempty"`
+	Keys          map[string]string      `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // key id -> base64 32-byte AES key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Encryption) Reset() {
+	*x = Data_Encryption{}
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Encryption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Encryption) ProtoMessage() {}
+
+func (x *Data_Encryption) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Encryption.ProtoReflect.Descriptor instead.
+func (*Data_Encryption) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7, 3}
+}
+
+func (x *Data_Encryption) GetActiveKey() string {
+	if x != nil {
+		return x.ActiveKey
+	}
+	return ""
+}
+
+func (x *Data_Encryption) GetKeys() map[string]string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Storage selects where uploaded documents are kept.
+type Data_Storage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`                     // local (default) or s3
+	LocalDir      string                 `protobuf:"bytes,2,opt,name=local_dir,json=localDir,proto3" json:"local_dir,omitempty"` // root directory of the local driver
+	S3            *Data_Storage_S3       `protobuf:"bytes,3,opt,name=s3,proto3" json:"s3,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage) Reset() {
+	*x = Data_Storage{}
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage) ProtoMessage() {}
+
+func (x *Data_Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage.ProtoReflect.Descriptor instead.
+func (*Data_Storage) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7, 4}
+}
+
+func (x *Data_Storage) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Data_Storage) GetLocalDir() string {
+	if x != nil {
+		return x.LocalDir
+	}
+	return ""
+}
+
+func (x *Data_Storage) GetS3() *Data_Storage_S3 {
+	if x != nil {
+		return x.S3
+	}
+	return nil
+}
+
+type Data_Storage_S3 struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // host[:port] of S3 or an S3-compatible server
+	AccessKey     string                 `protobuf:"bytes,2,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey     string                 `protobuf:"bytes,3,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	Bucket        string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	UseSsl        bool                   `protobuf:"varint,6,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Storage_S3) Reset() {
+	*x = Data_Storage_S3{}
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Storage_S3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Storage_S3) ProtoMessage() {}
+
+func (x *Data_Storage_S3) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Storage_S3.ProtoReflect.Descriptor instead.
+func (*Data_Storage_S3) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7, 4, 0}
+}
+
+func (x *Data_Storage_S3) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Data_Storage_S3) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
 var File_internal_conf_conf_proto protoreflect.FileDescriptor
 
 const file_internal_conf_conf_proto_rawDesc = "" +
 	"\n" +
-	"\x18internal/conf/conf.proto\x12\vkratos.conf\"\x86\x01\n" +
+	"\x18internal/conf/conf.proto\x12\vkratos.conf\"\xb9\x01\n" +
 	"\tBootstrap\x12+\n" +
 	"\x06server\x18\x01 \x01(\v2\x13.kratos.conf.ServerR\x06server\x12%\n" +
 	"\x04data\x18\x02 \x01(\v2\x11.kratos.conf.DataR\x04data\x12%\n" +
-	"\x04auth\x18\x03 \x01(\v2\x11.kratos.conf.AuthR\x04auth\"/\n" +
+	"\x04auth\x18\x03 \x01(\v2\x11.kratos.conf.AuthR\x04auth\x121\n" +
+	"\bovertime\x18\x04 \x01(\v2\x15.kratos.conf.OvertimeR\bovertime\"/\n" +
 	"\x06Server\x12%\n" +
-	"\x04http\x18\x01 \x01(\v2\x11.kratos.conf.HTTPR\x04http\"B\n" +
+	"\x04http\x18\x01 \x01(\v2\x11.kratos.conf.HTTPR\x04http\"\xf9\f\n" +
 	"\x04Auth\x12\x1d\n" +
 	"\n" +
 	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\x12\x1b\n" +
-	"\ttoken_exp\x18\x02 \x01(\x05R\btokenExp\"4\n" +
+	"\ttoken_exp\x18\x02 \x01(\x05R\btokenExp\x12\x1f\n" +
+	"\vpii_viewers\x18\x03 \x03(\tR\n" +
+	"piiViewers\x12\x16\n" +
+	"\x06admins\x18\x04 \x03(\tR\x06admins\x12%\n" +
+	"\x0einvitation_url\x18\x05 \x01(\tR\rinvitationUrl\x12*\n" +
+	"\x11refresh_token_exp\x18\x06 \x01(\x05R\x0frefreshTokenExp\x12)\n" +
+	"\x10verification_url\x18\a \x01(\tR\x0fverificationUrl\x12,\n" +
+	"\x12password_reset_url\x18\b \x01(\tR\x10passwordResetUrl\x12.\n" +
+	"\alockout\x18\t \x01(\v2\x14.kratos.conf.LockoutR\alockout\x125\n" +
+	"\n" +
+	"two_factor\x18\n" +
+	" \x01(\v2\x16.kratos.conf.TwoFactorR\ttwoFactor\x123\n" +
+	"\asigning\x18\v \x01(\v2\x19.kratos.conf.Auth.SigningR\asigning\x126\n" +
+	"\bpassword\x18\f \x01(\v2\x1a.kratos.conf.Auth.PasswordR\bpassword\x12*\n" +
+	"\x04oidc\x18\r \x01(\v2\x16.kratos.conf.Auth.OidcR\x04oidc\x1a\x9a\x01\n" +
+	"\aSigning\x12\x1d\n" +
+	"\n" +
+	"active_key\x18\x01 \x01(\tR\tactiveKey\x127\n" +
+	"\x04keys\x18\x02 \x03(\v2#.kratos.conf.Auth.Signing.KeysEntryR\x04keys\x1a7\n" +
+	"\tKeysEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xe0\x03\n" +
+	"\bPassword\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05R\tminLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x02 \x01(\x05R\tmaxLength\x12#\n" +
+	"\rrequire_upper\x18\x03 \x01(\bR\frequireUpper\x12#\n" +
+	"\rrequire_lower\x18\x04 \x01(\bR\frequireLower\x12#\n" +
+	"\rrequire_digit\x18\x05 \x01(\bR\frequireDigit\x12%\n" +
+	"\x0erequire_symbol\x18\x06 \x01(\bR\rrequireSymbol\x12,\n" +
+	"\x12breached_list_file\x18\a \x01(\tR\x10breachedListFile\x12\x18\n" +
+	"\ahistory\x18\b \x01(\x05R\ahistory\x12\x16\n" +
+	"\x06hasher\x18\t \x01(\tR\x06hasher\x12\x1f\n" +
+	"\vbcrypt_cost\x18\n" +
+	" \x01(\x05R\n" +
+	"bcryptCost\x12#\n" +
+	"\rargon2_memory\x18\v \x01(\rR\fargon2Memory\x12+\n" +
+	"\x11argon2_iterations\x18\f \x01(\rR\x10argon2Iterations\x12-\n" +
+	"\x12argon2_parallelism\x18\r \x01(\rR\x11argon2Parallelism\x1a\xcf\x03\n" +
+	"\x04Oidc\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x12!\n" +
+	"\fredirect_url\x18\x04 \x01(\tR\vredirectUrl\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"role_claim\x18\x06 \x01(\tR\troleClaim\x12J\n" +
+	"\frole_mapping\x18\a \x03(\v2'.kratos.conf.Auth.Oidc.RoleMappingEntryR\vroleMapping\x12!\n" +
+	"\fdefault_role\x18\b \x01(\tR\vdefaultRole\x12\x1b\n" +
+	"\tsync_role\x18\t \x01(\bR\bsyncRole\x12\x1f\n" +
+	"\vtrust_email\x18\n" +
+	" \x01(\bR\n" +
+	"trustEmail\x12&\n" +
+	"\x0fskip_two_factor\x18\v \x01(\bR\rskipTwoFactor\x1a>\n" +
+	"\x10RoleMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
+	"\tTwoFactor\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12%\n" +
+	"\x0erequired_roles\x18\x02 \x03(\tR\rrequiredRoles\"\xbd\x01\n" +
+	"\aLockout\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12\x16\n" +
+	"\x06window\x18\x02 \x01(\x05R\x06window\x12#\n" +
+	"\rlock_duration\x18\x03 \x01(\x05R\flockDuration\x12*\n" +
+	"\x11max_lock_duration\x18\x04 \x01(\x05R\x0fmaxLockDuration\x12&\n" +
+	"\x0fip_max_attempts\x18\x05 \x01(\x05R\ripMaxAttempts\"\xa6\x01\n" +
+	"\bOvertime\x12\x1f\n" +
+	"\vdaily_limit\x18\x01 \x01(\x01R\n" +
+	"dailyLimit\x12#\n" +
+	"\rmonthly_limit\x18\x02 \x01(\x01R\fmonthlyLimit\x12!\n" +
+	"\fyearly_limit\x18\x03 \x01(\x01R\vyearlyLimit\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x12\x1d\n" +
+	"\n" +
+	"warn_ratio\x18\x05 \x01(\x01R\twarnRatio\"]\n" +
 	"\x04HTTP\x12\x12\n" +
 	"\x04addr\x18\x01 \x01(\tR\x04addr\x12\x18\n" +
-	"\atimeout\x18\x02 \x01(\x05R\atimeout\"\xc7\x03\n" +
+	"\atimeout\x18\x02 \x01(\x05R\atimeout\x12'\n" +
+	"\x0ftrusted_proxies\x18\x03 \x03(\tR\x0etrustedProxies\"\xf6\a\n" +
 	"\x04Data\x126\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x1a.kratos.conf.Data.DatabaseR\bdatabase\x12-\n" +
 	"\x05redis\x18\x02 \x01(\v2\x17.kratos.conf.Data.RedisR\x05redis\x12-\n" +
-	"\x05email\x18\x03 \x01(\v2\x17.kratos.conf.Data.EmailR\x05email\x1a:\n" +
+	"\x05email\x18\x03 \x01(\v2\x17.kratos.conf.Data.EmailR\x05email\x12<\n" +
+	"\n" +
+	"encryption\x18\x04 \x01(\v2\x1c.kratos.conf.Data.EncryptionR\n" +
+	"encryption\x123\n" +
+	"\astorage\x18\x05 \x01(\v2\x19.kratos.conf.Data.StorageR\astorage\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1aG\n" +
@@ -521,7 +1458,28 @@ const file_internal_conf_conf_proto_rawDesc = "" +
 	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x1b\n" +
 	"\tfrom_name\x18\x05 \x01(\tR\bfromName\x12\x1d\n" +
 	"\n" +
-	"from_email\x18\x06 \x01(\tR\tfromEmailB\x15Z\x13myapp/internal/confb\x06proto3"
+	"from_email\x18\x06 \x01(\tR\tfromEmail\x1a\xa0\x01\n" +
+	"\n" +
+	"Encryption\x12\x1d\n" +
+	"\n" +
+	"active_key\x18\x01 \x01(\tR\tactiveKey\x12:\n" +
+	"\x04keys\x18\x02 \x03(\v2&.kratos.conf.Data.Encryption.KeysEntryR\x04keys\x1a7\n" +
+	"\tKeysEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x96\x02\n" +
+	"\aStorage\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x1b\n" +
+	"\tlocal_dir\x18\x02 \x01(\tR\blocalDir\x12,\n" +
+	"\x02s3\x18\x03 \x01(\v2\x1c.kratos.conf.Data.Storage.S3R\x02s3\x1a\xa7\x01\n" +
+	"\x02S3\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x02 \x01(\tR\taccessKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x03 \x01(\tR\tsecretKey\x12\x16\n" +
+	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x17\n" +
+	"\ause_ssl\x18\x06 \x01(\bR\x06useSslB\x15Z\x13myapp/internal/confb\x06proto3"
 
 var (
 	file_internal_conf_conf_proto_rawDescOnce sync.Once
@@ -535,30 +1493,54 @@ func file_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_internal_conf_conf_proto_rawDescData
 }
 
-var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_internal_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),     // 0: kratos.conf.Bootstrap
-	(*Server)(nil),        // 1: kratos.conf.Server
-	(*Auth)(nil),          // 2: kratos.conf.Auth
-	(*HTTP)(nil),          // 3: kratos.conf.HTTP
-	(*Data)(nil),          // 4: kratos.conf.Data
-	(*Data_Database)(nil), // 5: kratos.conf.Data.Database
-	(*Data_Redis)(nil),    // 6: kratos.conf.Data.Redis
-	(*Data_Email)(nil),    // 7: kratos.conf.Data.Email
+	(*Bootstrap)(nil),       // 0: kratos.conf.Bootstrap
+	(*Server)(nil),          // 1: kratos.conf.Server
+	(*Auth)(nil),            // 2: kratos.conf.Auth
+	(*TwoFactor)(nil),       // 3: kratos.conf.TwoFactor
+	(*Lockout)(nil),         // 4: kratos.conf.Lockout
+	(*Overtime)(nil),        // 5: kratos.conf.Overtime
+	(*HTTP)(nil),            // 6: kratos.conf.HTTP
+	(*Data)(nil),            // 7: kratos.conf.Data
+	(*Auth_Signing)(nil),    // 8: kratos.conf.Auth.Signing
+	(*Auth_Password)(nil),   // 9: kratos.conf.Auth.Password
+	(*Auth_Oidc)(nil),       // 10: kratos.conf.Auth.Oidc
+	nil,                     // 11: kratos.conf.Auth.Signing.KeysEntry
+	nil,                     // 12: kratos.conf.Auth.Oidc.RoleMappingEntry
+	(*Data_Database)(nil),   // 13: kratos.conf.Data.Database
+	(*Data_Redis)(nil),      // 14: kratos.conf.Data.Redis
+	(*Data_Email)(nil),      // 15: kratos.conf.Data.Email
+	(*Data_Encryption)(nil), // 16: kratos.conf.Data.Encryption
+	(*Data_Storage)(nil),    // 17: kratos.conf.Data.Storage
+	nil,                     // 18: kratos.conf.Data.Encryption.KeysEntry
+	(*Data_Storage_S3)(nil), // 19: kratos.conf.Data.Storage.S3
 }
 var file_internal_conf_conf_proto_depIdxs = []int32{
-	1, // 0: kratos.conf.Bootstrap.server:type_name -> kratos.conf.Server
-	4, // 1: kratos.conf.Bootstrap.data:type_name -> kratos.conf.Data
-	2, // 2: kratos.conf.Bootstrap.auth:type_name -> kratos.conf.Auth
-	3, // 3: kratos.conf.Server.http:type_name -> kratos.conf.HTTP
-	5, // 4: kratos.conf.Data.database:type_name -> kratos.conf.Data.Database
-	6, // 5: kratos.conf.Data.redis:type_name -> kratos.conf.Data.Redis
-	7, // 6: kratos.conf.Data.email:type_name -> kratos.conf.Data.Email
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	1,  // 0: kratos.conf.Bootstrap.server:type_name -> kratos.conf.Server
+	7,  // 1: kratos.conf.Bootstrap.data:type_name -> kratos.conf.Data
+	2,  // 2: kratos.conf.Bootstrap.auth:type_name -> kratos.conf.Auth
+	5,  // 3: kratos.conf.Bootstrap.overtime:type_name -> kratos.conf.Overtime
+	6,  // 4: kratos.conf.Server.http:type_name -> kratos.conf.HTTP
+	4,  // 5: kratos.conf.Auth.lockout:type_name -> kratos.conf.Lockout
+	3,  // 6: kratos.conf.Auth.two_factor:type_name -> kratos.conf.TwoFactor
+	8,  // 7: kratos.conf.Auth.signing:type_name -> kratos.conf.Auth.Signing
+	9,  // 8: kratos.conf.Auth.password:type_name -> kratos.conf.Auth.Password
+	10, // 9: kratos.conf.Auth.oidc:type_name -> kratos.conf.Auth.Oidc
+	13, // 10: kratos.conf.Data.database:type_name -> kratos.conf.Data.Database
+	14, // 11: kratos.conf.Data.redis:type_name -> kratos.conf.Data.Redis
+	15, // 12: kratos.conf.Data.email:type_name -> kratos.conf.Data.Email
+	16, // 13: kratos.conf.Data.encryption:type_name -> kratos.conf.Data.Encryption
+	17, // 14: kratos.conf.Data.storage:type_name -> kratos.conf.Data.Storage
+	11, // 15: kratos.conf.Auth.Signing.keys:type_name -> kratos.conf.Auth.Signing.KeysEntry
+	12, // 16: kratos.conf.Auth.Oidc.role_mapping:type_name -> kratos.conf.Auth.Oidc.RoleMappingEntry
+	18, // 17: kratos.conf.Data.Encryption.keys:type_name -> kratos.conf.Data.Encryption.KeysEntry
+	19, // 18: kratos.conf.Data.Storage.s3:type_name -> kratos.conf.Data.Storage.S3
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_internal_conf_conf_proto_init() }
@@ -572,7 +1554,7 @@ func file_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_conf_conf_proto_rawDesc), len(file_internal_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,8 +1,12 @@
 package data
 
 import (
+	"fmt"
+	"time"
+
 	"myapp/internal/conf"
 	"myapp/internal/data/model"
+	"myapp/internal/pii"
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -18,6 +22,12 @@ func NewData(db *gorm.DB, logger interface{}) (*Data, func(), error) {
 }
 
 func NewDB(c *conf.Data) (*gorm.DB, error) {
+	keyring, err := pii.NewKeyring(c.GetEncryption().GetActiveKey(), c.GetEncryption().GetKeys())
+	if err != nil {
+		return nil, err
+	}
+	pii.Register(keyring)
+
 	dsn := "root:root@tcp(mysql:3306)/mydb?charset=utf8mb4&parseTime=True&loc=Local"
 
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
@@ -25,10 +35,82 @@ func NewDB(c *conf.Data) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	// idx_employee_date only covered work_date, which allowed a single
+	// timesheet per day across all employees.
+	if db.Migrator().HasIndex(&model.Timesheet{}, "idx_employee_date") {
+		db.Migrator().DropIndex(&model.Timesheet{}, "idx_employee_date")
+	}
+	// Timesheets recorded before approval existed were already used for
+	// payroll, so they count as approved.
+	grandfatherTimesheets := db.Migrator().HasTable(&model.Timesheet{}) && !db.Migrator().HasColumn(&model.Timesheet{}, "Status")
 	db.AutoMigrate(&model.Timesheet{})
+	if grandfatherTimesheets {
+		db.Model(&model.Timesheet{}).Where("1 = 1").Update("status", model.TimesheetApproved)
+	}
 	db.AutoMigrate(&model.Employee{})
 	db.AutoMigrate(&model.Payroll{})
+	// Accounts created before email verification existed are treated as
+	// verified, so their owners aren't locked out.
+	grandfatherUsers := db.Migrator().HasTable(&model.User{}) && !db.Migrator().HasColumn(&model.User{}, "EmailVerifiedAt")
 	db.AutoMigrate(&model.User{})
+	if grandfatherUsers {
+		db.Model(&model.User{}).Where("email_verified_at IS NULL").Update("email_verified_at", gorm.Expr("created_at"))
+	}
+	db.AutoMigrate(&model.Shift{})
+	db.AutoMigrate(&model.WorkSchedule{})
+	db.AutoMigrate(&model.ScheduleAssignment{})
+	db.AutoMigrate(&model.TimesheetPeriod{})
+	db.AutoMigrate(&model.Department{})
+	db.AutoMigrate(&model.Position{})
+	db.AutoMigrate(&model.EmployeeAssignment{})
+	db.AutoMigrate(&model.Contract{})
+	db.AutoMigrate(&model.Termination{})
+	db.AutoMigrate(&model.Dependent{})
+	if err := migrateDependentCounts(db); err != nil {
+		return nil, err
+	}
+	db.AutoMigrate(&model.Document{})
+	db.AutoMigrate(&model.CustomField{})
+	db.AutoMigrate(&model.EmployeeFieldValue{})
+	db.AutoMigrate(&model.Invitation{})
+	db.AutoMigrate(&model.AuthEvent{})
+	db.AutoMigrate(&model.RecoveryCode{})
+	db.AutoMigrate(&model.PasswordHistory{})
+	db.AutoMigrate(&model.ExternalIdentity{})
+	db.AutoMigrate(&model.ServiceAccount{})
+	db.AutoMigrate(&model.APIKey{})
 
 	return db, nil
 }
+
+// migrateDependentCounts turns the dependent counts employees carried before
+// dependents were registered individually into placeholder records valid
+// from the employee's join month, so payroll keeps deducting them until HR
+// replaces them with the real details. Counts are zeroed once migrated.
+func migrateDependentCounts(db *gorm.DB) error {
+	var employees []*model.Employee
+	if err := db.Where("dependents > 0").Find(&employees).Error; err != nil {
+		return fmt.Errorf("migrate dependent counts: %w", err)
+	}
+	for _, e := range employees {
+		from := time.Date(e.JoinDate.Year(), e.JoinDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+		err := db.Transaction(func(tx *gorm.DB) error {
+			for i := 1; i <= e.Dependents; i++ {
+				dependent := &model.Dependent{
+					EmployeeID:   e.ID,
+					Name:         fmt.Sprintf("Dependent %d (migrated)", i),
+					Relationship: model.RelationshipOther,
+					FromMonth:    from,
+				}
+				if err := tx.Create(dependent).Error; err != nil {
+					return err
+				}
+			}
+			return tx.Model(&model.Employee{}).Where("id = ?", e.ID).Update("dependents", 0).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migrate dependent counts of employee %d: %w", e.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Auth audit events.
+const (
+	AuthEventAccountLocked   = "account.locked"
+	AuthEventAccountUnlocked = "account.unlocked"
+	AuthEventIPBlocked       = "ip.blocked"
+	AuthEventTOTPReset       = "totp.reset"
+)
+
+// AuthEvent is an audit record of a security relevant change to an account.
+// UserID is nil when the event concerns a username no account uses.
+type AuthEvent struct {
+	ID        uint      `gorm:"primarykey"`
+	CreatedAt time.Time `gorm:"index"`
+	Event     string    `gorm:"type:varchar(40);index;not null"`
+	UserID    *uint     `gorm:"index"`
+	Username  string    `gorm:"type:varchar(255)"`
+	IP        string    `gorm:"type:varchar(64)"`
+	ActorID   *uint     // user who caused the event, nil for the system
+	Detail    string    `gorm:"type:varchar(500)"`
+}
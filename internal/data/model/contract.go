@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Contract types.
+const (
+	ContractProbation  = "probation"
+	ContractFixedTerm  = "fixed_term"
+	ContractIndefinite = "indefinite"
+)
+
+type Contract struct {
+	gorm.Model
+	EmployeeID             uint       `gorm:"index"`
+	Type                   string     `gorm:"type:varchar(20);not null"`
+	StartDate              time.Time  `gorm:"type:date"`
+	EndDate                *time.Time `gorm:"type:date"`                     // nil for indefinite contracts
+	ProbationSalaryPercent float64    `gorm:"type:decimal(5,2);default:100"` // share of base salary paid under a probation contract
+	Note                   string     `gorm:"type:text"`
+}
+
+// Termination is an employee's exit and the final settlement paid with it.
+type Termination struct {
+	gorm.Model
+	EmployeeID      uint      `gorm:"uniqueIndex"`
+	LastWorkingDay  time.Time `gorm:"type:date"`
+	Reason          string    `gorm:"type:varchar(20)"`
+	Note            string    `gorm:"type:text"`
+	WorkingDays     int
+	ProratedSalary  float64 `gorm:"type:decimal(15,2)"`
+	UnusedLeaveDays float64 `gorm:"type:decimal(5,2)"`
+	LeavePayout     float64 `gorm:"type:decimal(15,2)"`
+	ServiceYears    float64 `gorm:"type:decimal(5,2)"`
+	Severance       float64 `gorm:"type:decimal(15,2)"`
+	Total           float64 `gorm:"type:decimal(15,2)"`
+	ProcessedBy     *uint
+}
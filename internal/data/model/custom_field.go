@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Custom field types.
+const (
+	FieldString  = "string"
+	FieldNumber  = "number"
+	FieldBoolean = "boolean"
+	FieldDate    = "date"
+	FieldEnum    = "enum"
+)
+
+// CustomField is an admin-defined employee attribute. Values are stored as
+// text in EmployeeFieldValue, so adding a field needs no migration.
+type CustomField struct {
+	gorm.Model
+	Key        string   `gorm:"type:varchar(64);uniqueIndex;not null"`
+	Label      string   `gorm:"type:varchar(255)"`
+	Type       string   `gorm:"type:varchar(20);not null"`
+	Required   bool     `gorm:"default:false"`
+	EnumValues []string `gorm:"serializer:json"`
+	Pattern    string   `gorm:"type:varchar(255)"` // regular expression for string fields
+	Min        *float64 // lower bound of number fields
+	Max        *float64 // upper bound of number fields
+	Active     bool     `gorm:"default:true"`
+}
+
+// EmployeeFieldValue is an employee's value of a custom field in canonical
+// text form: numbers without trailing zeros, booleans as true/false and dates
+// as YYYY-MM-DD.
+type EmployeeFieldValue struct {
+	ID         uint        `gorm:"primarykey"`
+	EmployeeID uint        `gorm:"uniqueIndex:idx_employee_field"`
+	FieldID    uint        `gorm:"uniqueIndex:idx_employee_field;index:idx_field_value,priority:1"`
+	Value      string      `gorm:"type:varchar(500);index:idx_field_value,priority:2"`
+	Field      CustomField `gorm:"foreignKey:FieldID"`
+	UpdatedAt  time.Time
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Dependent relationships.
+const (
+	RelationshipChild   = "child"
+	RelationshipSpouse  = "spouse"
+	RelationshipParent  = "parent"
+	RelationshipSibling = "sibling"
+	RelationshipOther   = "other"
+)
+
+// Dependent is a family member registered for the personal income tax
+// family deduction. The deduction applies from FromMonth through ToMonth.
+type Dependent struct {
+	gorm.Model
+	EmployeeID   uint       `gorm:"index"`
+	Name         string     `gorm:"type:varchar(255);not null"`
+	Relationship string     `gorm:"type:varchar(20);not null"`
+	TaxID        string     `gorm:"type:varchar(255);serializer:pii"` // encrypted
+	DateOfBirth  *time.Time `gorm:"type:date"`
+	FromMonth    time.Time  `gorm:"type:date"` // first day of the first month
+	ToMonth      *time.Time `gorm:"type:date"` // first day of the last month, nil = still registered
+}
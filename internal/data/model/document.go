@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Document types.
+const (
+	DocumentContract    = "contract"
+	DocumentIDCard      = "id_card"
+	DocumentCertificate = "certificate"
+	DocumentOther       = "other"
+)
+
+// Document access levels.
+const (
+	DocumentRestricted = "restricted" // only users allowed to see personal data
+	DocumentInternal   = "internal"   // any signed-in user
+)
+
+// Document is a file attached to an employee. The content lives in the blob
+// store under StorageKey.
+type Document struct {
+	gorm.Model
+	EmployeeID  uint   `gorm:"index"`
+	Type        string `gorm:"type:varchar(20);not null"`
+	Title       string `gorm:"type:varchar(255)"`
+	FileName    string `gorm:"type:varchar(255)"`
+	ContentType string `gorm:"type:varchar(100)"`
+	Size        int64
+	SHA256      string     `gorm:"type:char(64)"`
+	StorageKey  string     `gorm:"type:varchar(255);uniqueIndex"`
+	ExpiresAt   *time.Time `gorm:"type:date;index"`
+	Access      string     `gorm:"type:varchar(20);default:'restricted'"`
+	UploadedBy  *uint
+}
@@ -6,14 +6,40 @@ import (
 	"gorm.io/gorm"
 )
 
+// Employment statuses.
+const (
+	EmployeeProbation  = "probation"
+	EmployeeOfficial   = "official"
+	EmployeeOnLeave    = "on_leave"
+	EmployeeTerminated = "terminated"
+)
+
+// Payslip delivery channels.
+const (
+	PayslipWorkEmail     = "work_email"
+	PayslipPersonalEmail = "personal_email"
+	PayslipPaper         = "paper"
+)
+
 type Employee struct {
 	gorm.Model
-	Name         string  `gorm:"type:varchar(255);not null"` 
-	Position     string  `gorm:"type:varchar(100)"` 
-	BaseSalary   float64 `gorm:"type:decimal(15,2);not null"` 
-	BankAccount  string  `gorm:"type:varchar(50)"` 
-	JoinDate     time.Time `gorm:"type:date"` 
-	Dependents   int     `gorm:"default:0"` 
-	Timesheets   []Timesheet 
-	Payrolls     []Payroll   
-}
\ No newline at end of file
+	Name           string    `gorm:"type:varchar(255);not null"`
+	Position       string    `gorm:"type:varchar(100)"`
+	BaseSalary     float64   `gorm:"type:varchar(255);serializer:pii;not null"` // encrypted
+	BankAccount    string    `gorm:"type:varchar(255);serializer:pii"`          // encrypted
+	JoinDate       time.Time `gorm:"type:date"`
+	Dependents     int       `gorm:"default:0"`
+	DepartmentID   *uint     `gorm:"index"`
+	PositionID     *uint
+	ManagerID      *uint      `gorm:"index"`
+	Status         string     `gorm:"type:varchar(20);default:'official';index"`
+	TerminatedAt   *time.Time `gorm:"type:date"` // last working day
+	WorkEmail      string     `gorm:"type:varchar(255)"`
+	PersonalEmail  string     `gorm:"type:varchar(255)"`
+	Phone          string     `gorm:"type:varchar(20)"`
+	Address        string     `gorm:"type:varchar(500)"`
+	PayslipChannel string     `gorm:"type:varchar(20);default:'work_email'"`
+	FieldValues    []EmployeeFieldValue
+	Timesheets     []Timesheet
+	Payrolls       []Payroll
+}
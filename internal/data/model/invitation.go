@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Invitation lets the holder of the emailed token create an account bound to
+// an employee. Only the SHA-256 of the token is stored.
+type Invitation struct {
+	gorm.Model
+	EmployeeID uint      `gorm:"index;not null"`
+	Email      string    `gorm:"type:varchar(255);not null"`
+	Role       string    `gorm:"type:varchar(20);not null"`
+	TokenHash  string    `gorm:"type:char(64);uniqueIndex;not null"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	AcceptedAt *time.Time
+	UserID     *uint // account created on acceptance
+	InvitedBy  *uint
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Department struct {
+	gorm.Model
+	Name     string `gorm:"type:varchar(255);not null"`
+	Code     string `gorm:"type:varchar(50);uniqueIndex"`
+	ParentID *uint  `gorm:"index"` // nil for top-level departments
+	HeadID   *uint  // employee heading the department
+}
+
+type Position struct {
+	gorm.Model
+	Title        string `gorm:"type:varchar(100);not null"`
+	DepartmentID *uint  `gorm:"index"` // nil for positions used across departments
+	Level        int    `gorm:"default:0"`
+}
+
+// EmployeeAssignment is one entry in an employee's department, position and
+// manager history. Assignments with a future EffectiveFrom are copied onto
+// the employee once they become due.
+type EmployeeAssignment struct {
+	gorm.Model
+	EmployeeID    uint  `gorm:"index"`
+	DepartmentID  *uint `gorm:"index"`
+	PositionID    *uint
+	ManagerID     *uint
+	EffectiveFrom time.Time  `gorm:"type:date"`
+	EffectiveTo   *time.Time `gorm:"type:date"` // nil = current
+	Reason        string     `gorm:"type:varchar(255)"`
+	Applied       bool       `gorm:"default:false"`
+}
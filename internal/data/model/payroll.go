@@ -13,10 +13,11 @@ type Payroll struct {
 	WorkingDays   int       `gorm:"default:0"`
 	OvertimeHours float64   `gorm:"type:decimal(8,2);default:0.00"`
 	LeaveDays     int       `gorm:"default:0"`
+	Dependents    int       `gorm:"default:0"` // dependents deducted for this month
 	BasicSalary   float64   `gorm:"type:decimal(15,2)"`
 	Allowances    float64   `gorm:"type:decimal(15,2);default:0.00"`
 	GrossSalary   float64   `gorm:"type:decimal(15,2)"`
 	Deductions    float64   `gorm:"type:decimal(15,2)"`
 	NetSalary     float64   `gorm:"type:decimal(15,2)"`
 	Status        string    `gorm:"type:varchar(50);default:'calculated'"`
-}
\ No newline at end of file
+}
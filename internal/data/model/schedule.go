@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Shift struct {
+	gorm.Model
+	Name         string `gorm:"type:varchar(100);not null"`
+	StartTime    string `gorm:"type:varchar(5);not null"` // HH:MM
+	EndTime      string `gorm:"type:varchar(5);not null"` // HH:MM
+	BreakMinutes int    `gorm:"default:0"`
+	IsNightShift bool   `gorm:"default:false"`
+}
+
+type WorkSchedule struct {
+	gorm.Model
+	Name       string    `gorm:"type:varchar(100);not null"`
+	CycleStart time.Time `gorm:"type:date"`                   // first day of the rotation
+	Pattern    string    `gorm:"type:varchar(1000);not null"` // comma-separated shift IDs per cycle day, 0 = day off
+}
+
+type ScheduleAssignment struct {
+	gorm.Model
+	EmployeeID    uint       `gorm:"index"`
+	ScheduleID    uint       `gorm:"index"`
+	EffectiveFrom time.Time  `gorm:"type:date"`
+	EffectiveTo   *time.Time `gorm:"type:date"` // nil = open-ended
+}
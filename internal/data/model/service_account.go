@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RoleService is the role of requests authenticated with an API key. It holds
+// no permissions of its own; the key's scopes are its permissions.
+const RoleService = "service"
+
+// ServiceAccount is a non-human identity for integrations such as attendance
+// terminals or accounting scripts. It authenticates with API keys.
+type ServiceAccount struct {
+	gorm.Model
+	Name        string `gorm:"type:varchar(100);uniqueIndex;not null"`
+	Description string `gorm:"type:varchar(500)"`
+	CreatedBy   *uint
+	DisabledAt  *time.Time // a disabled account's keys stop working
+	Keys        []APIKey
+}
+
+// APIKey authenticates a service account for the permissions in Scopes. The
+// key is "hrk_<prefix>_<secret>"; the prefix finds the row and only the
+// SHA-256 of the secret is stored.
+type APIKey struct {
+	ID               uint      `gorm:"primarykey"`
+	ServiceAccountID uint      `gorm:"index;not null"`
+	Name             string    `gorm:"type:varchar(100);not null"`
+	Prefix           string    `gorm:"type:varchar(16);uniqueIndex;not null"`
+	SecretHash       string    `gorm:"type:char(64);not null"`
+	Scopes           []string  `gorm:"type:text;serializer:json"`
+	ExpiresAt        time.Time `gorm:"not null"`
+	LastUsedAt       *time.Time
+	LastUsedIP       string `gorm:"type:varchar(64)"`
+	RevokedAt        *time.Time
+	CreatedBy        *uint
+	CreatedAt        time.Time
+
+	ServiceAccount *ServiceAccount
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// Session is one signed-in device. Sessions live in Redis as JSON, not in
+// the database; each holds the hash of its current refresh token.
+type Session struct {
+	ID          string    `json:"id"`
+	UserID      uint      `json:"user_id"`
+	Device      string    `json:"device"`
+	IP          string    `json:"ip"`
+	RefreshHash string    `json:"refresh_hash"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastUsedAt  time.Time `json:"last_used_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
@@ -8,15 +8,21 @@ import (
 
 type Timesheet struct {
 	gorm.Model
-	EmployeeID    uint      `gorm:"index"`
-	WorkDate      time.Time `gorm:"type:date;uniqueIndex:idx_employee_date"`
-	HoursWorked   float64   `gorm:"type:decimal(5,2);default:8.00"`           
-	OvertimeHours float64   `gorm:"type:decimal(5,2);default:0.00"`           
-	IsLeave       bool      `gorm:"default:false"`                           
-	LeaveType     string    `gorm:"type:varchar(50)"`                        
-	Note          string    `gorm:"type:text"`
+	EmployeeID     uint       `gorm:"index;uniqueIndex:idx_employee_work_date"`
+	WorkDate       time.Time  `gorm:"type:date;uniqueIndex:idx_employee_work_date"`
+	HoursWorked    float64    `gorm:"type:decimal(5,2);default:8.00"`
+	OvertimeHours  float64    `gorm:"type:decimal(5,2);default:0.00"`
+	IsLeave        bool       `gorm:"default:false"`
+	LeaveType      string     `gorm:"type:varchar(50)"`
+	Note           string     `gorm:"type:text"`
+	ShiftID        *uint      `gorm:"index"`
+	ScheduledHours float64    `gorm:"type:decimal(5,2);default:0.00"`
+	CheckIn        *time.Time `gorm:"type:datetime"`
+	CheckOut       *time.Time `gorm:"type:datetime"`
+	LateMinutes    int        `gorm:"default:0"`
+	Status         string     `gorm:"type:varchar(20);index;default:'draft'"`
 }
 
 func (Timesheet) TableName() string {
 	return "timesheets"
-}
\ No newline at end of file
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Timesheet and TimesheetPeriod statuses.
+const (
+	TimesheetDraft     = "draft"
+	TimesheetSubmitted = "submitted"
+	TimesheetApproved  = "approved"
+	TimesheetRejected  = "rejected"
+)
+
+// TimesheetPeriod is a week or month of an employee's timesheets submitted
+// for manager approval.
+type TimesheetPeriod struct {
+	gorm.Model
+	EmployeeID  uint       `gorm:"index"`
+	PeriodType  string     `gorm:"type:varchar(10);not null"` // week, month
+	StartDate   time.Time  `gorm:"type:date;index"`
+	EndDate     time.Time  `gorm:"type:date"`
+	Status      string     `gorm:"type:varchar(20);index;default:'submitted'"`
+	SubmittedAt time.Time  `gorm:"type:datetime"`
+	ReviewedBy  *uint      // user ID of the reviewer
+	ReviewedAt  *time.Time `gorm:"type:datetime"`
+	Comment     string     `gorm:"type:text"`
+}
@@ -1,12 +1,64 @@
 package model
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
+// User roles.
+const (
+	RoleAdmin    = "admin"
+	RoleHR       = "hr"
+	RolePayroll  = "payroll"
+	RoleManager  = "manager"
+	RoleEmployee = "employee"
+)
+
 type User struct {
 	gorm.Model
-	Username string `gorm:"type:varchar(255);not null"`
-	Email    string `gorm:"type:varchar(255);unique;not null"`
-	Password string `gorm:"type:varchar(255);not null"`
-}
\ No newline at end of file
+	Username   string `gorm:"type:varchar(255);not null"`
+	Email      string `gorm:"type:varchar(255);unique;not null"`
+	Password   string `gorm:"type:varchar(255);not null"`
+	Role       string `gorm:"type:varchar(20);not null;default:'employee'"`
+	EmployeeID *uint  `gorm:"uniqueIndex"` // employee record of the account holder, if linked
+
+	EmailVerifiedAt *time.Time // nil until the owner follows a verification or password reset link
+
+	// Two-factor authentication. The secret is set on enrollment and
+	// enabled once a first code confirms it.
+	TOTPSecret    string     `gorm:"column:totp_secret;type:varchar(255);serializer:pii"` // encrypted
+	TOTPEnabledAt *time.Time `gorm:"column:totp_enabled_at"`
+	TOTPLastStep  int64      `gorm:"column:totp_last_step;not null;default:0"` // last time step used, so codes work once
+}
+
+// RecoveryCode is a single-use code that replaces a TOTP code when the
+// authenticator is lost. Only its SHA-256 is stored.
+type RecoveryCode struct {
+	ID        uint   `gorm:"primarykey"`
+	UserID    uint   `gorm:"index;not null"`
+	CodeHash  string `gorm:"type:char(64);not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// PasswordHistory keeps the hashes of a user's recent passwords so they
+// aren't reused.
+type PasswordHistory struct {
+	ID        uint   `gorm:"primarykey"`
+	UserID    uint   `gorm:"index;not null"`
+	Hash      string `gorm:"type:varchar(255);not null"`
+	CreatedAt time.Time
+}
+
+// ExternalIdentity links a user to their account at an external identity
+// provider, identified by the provider's issuer and the subject it assigns.
+type ExternalIdentity struct {
+	ID          uint   `gorm:"primarykey"`
+	UserID      uint   `gorm:"index;not null"`
+	Issuer      string `gorm:"type:varchar(255);uniqueIndex:idx_external_identity;not null"`
+	Subject     string `gorm:"type:varchar(255);uniqueIndex:idx_external_identity;not null"`
+	Email       string `gorm:"type:varchar(255)"` // as the provider last reported it
+	LastLoginAt *time.Time
+	CreatedAt   time.Time
+}
@@ -0,0 +1,194 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testClientID = "hr-app"
+
+// testIssuer is an OpenID provider whose token endpoint answers with the ID
+// token built from claims, signed with key under kid.
+type testIssuer struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+	claims jwt.MapClaims
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iss := &testIssuer{key: key, kid: "k1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                 iss.URL,
+			"authorization_endpoint": iss.URL + "/authorize",
+			"token_endpoint":         iss.URL + "/token",
+			"jwks_uri":               iss.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		pub := &iss.key.PublicKey
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "k1",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "good-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, iss.claims)
+		token.Header["kid"] = iss.kid
+		raw, err := token.SignedString(iss.key)
+		if err != nil {
+			t.Error(err)
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": raw, "token_type": "Bearer"})
+	})
+	iss.Server = httptest.NewServer(mux)
+	t.Cleanup(iss.Close)
+	return iss
+}
+
+// validClaims are the claims of an acceptable ID token for nonce.
+func (iss *testIssuer) validClaims(nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            iss.URL,
+		"sub":            "user-1",
+		"aud":            testClientID,
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          nonce,
+		"email":          "ana@example.com",
+		"email_verified": true,
+		"name":           "Ana",
+	}
+}
+
+func (iss *testIssuer) provider(t *testing.T) *Provider {
+	t.Helper()
+	p, err := NewProvider(Config{Issuer: iss.URL, ClientID: testClientID, RedirectURL: "http://app/callback"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestExchange(t *testing.T) {
+	iss := newTestIssuer(t)
+	iss.claims = iss.validClaims("n-1")
+
+	id, err := iss.provider(t).Exchange(context.Background(), "good-code", "verifier", "n-1")
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if id.Issuer != iss.URL || id.Subject != "user-1" || id.Email != "ana@example.com" || !id.EmailVerified || id.Name != "Ana" {
+		t.Fatalf("unexpected identity %+v", id)
+	}
+}
+
+func TestExchangeRejectsInvalidTokens(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(iss *testIssuer, claims jwt.MapClaims)
+	}{
+		{"nonce mismatch", func(_ *testIssuer, c jwt.MapClaims) { c["nonce"] = "other" }},
+		{"nonce missing", func(_ *testIssuer, c jwt.MapClaims) { delete(c, "nonce") }},
+		{"other audience", func(_ *testIssuer, c jwt.MapClaims) { c["aud"] = "someone-else" }},
+		{"several audiences without azp", func(_ *testIssuer, c jwt.MapClaims) {
+			c["aud"] = []string{testClientID, "someone-else"}
+		}},
+		{"several audiences issued to another party", func(_ *testIssuer, c jwt.MapClaims) {
+			c["aud"] = []string{testClientID, "someone-else"}
+			c["azp"] = "someone-else"
+		}},
+		{"other issuer", func(_ *testIssuer, c jwt.MapClaims) { c["iss"] = "https://evil.example" }},
+		{"expired", func(_ *testIssuer, c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{"no subject", func(_ *testIssuer, c jwt.MapClaims) { delete(c, "sub") }},
+		{"unknown kid", func(iss *testIssuer, _ jwt.MapClaims) { iss.kid = "k2" }},
+		{"signed with another key", func(iss *testIssuer, _ jwt.MapClaims) {
+			other, err := rsa.GenerateKey(rand.Reader, 2048)
+			if err != nil {
+				panic(err)
+			}
+			iss.key = other
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iss := newTestIssuer(t)
+			p := iss.provider(t)
+			// Fetch the keys before the issuer changes.
+			if _, err := p.key(context.Background(), "k1"); err != nil {
+				t.Fatal(err)
+			}
+			iss.claims = iss.validClaims("n-1")
+			tt.change(iss, iss.claims)
+
+			_, err := p.Exchange(context.Background(), "good-code", "verifier", "n-1")
+			if err == nil {
+				t.Fatal("Exchange accepted the token")
+			}
+			if !errors.Is(err, ErrInvalidToken) {
+				t.Fatalf("got %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+}
+
+func TestExchangeAcceptsAuthorizedParty(t *testing.T) {
+	iss := newTestIssuer(t)
+	iss.claims = iss.validClaims("n-1")
+	iss.claims["aud"] = []string{testClientID, "someone-else"}
+	iss.claims["azp"] = testClientID
+
+	if _, err := iss.provider(t).Exchange(context.Background(), "good-code", "verifier", "n-1"); err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+}
+
+func TestExchangeStringEmailVerified(t *testing.T) {
+	iss := newTestIssuer(t)
+	iss.claims = iss.validClaims("n-1")
+	iss.claims["email_verified"] = "true"
+
+	id, err := iss.provider(t).Exchange(context.Background(), "good-code", "verifier", "n-1")
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+	if !id.EmailVerified {
+		t.Fatal("email_verified \"true\" was not accepted")
+	}
+}
+
+func TestExchangeTokenError(t *testing.T) {
+	iss := newTestIssuer(t)
+	_, err := iss.provider(t).Exchange(context.Background(), "bad-code", "verifier", "n-1")
+	if err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Fatalf("got %v, want the provider's error", err)
+	}
+}
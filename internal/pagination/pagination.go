@@ -0,0 +1,127 @@
+// Package pagination implements the page_size / page_token contract shared by
+// the list RPCs.
+//
+// Pages are keyset based: a page token holds the sort key and ID of the last
+// item returned, and the next page starts strictly after it, so rows added or
+// removed in between do not shift results. Tokens also carry a fingerprint of
+// the query they were issued for and are rejected when reused with different
+// filters or sort order. An empty next_page_token marks the last page.
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var (
+	ErrInvalidPageSize  = errors.New("page_size must not be negative")
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrTokenMismatch    = errors.New("page token was issued for different filters or sort order")
+)
+
+// cursor is the decoded form of a page token.
+type cursor struct {
+	Key   string `json:"k,omitempty"` // sort key of the last item; empty when sorting by ID
+	ID    uint   `json:"i"`
+	Query string `json:"q"`
+}
+
+// Page is a validated page request.
+type Page struct {
+	Size  int
+	after *cursor
+	query string
+}
+
+// New validates a page request. query describes the filters and sort order of
+// the list call; it is fingerprinted so a token only continues the listing it
+// came from. A zero pageSize selects DefaultPageSize and larger sizes are
+// capped at MaxPageSize.
+func New(pageSize int32, pageToken string, query interface{}) (*Page, error) {
+	if pageSize < 0 {
+		return nil, ErrInvalidPageSize
+	}
+	size := int(pageSize)
+	switch {
+	case size == 0:
+		size = DefaultPageSize
+	case size > MaxPageSize:
+		size = MaxPageSize
+	}
+
+	raw, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(raw)
+	page := &Page{Size: size, query: hex.EncodeToString(sum[:8])}
+
+	if pageToken == "" {
+		return page, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var c cursor
+	if err := json.Unmarshal(decoded, &c); err != nil || c.ID == 0 {
+		return nil, ErrInvalidPageToken
+	}
+	if c.Query != page.query {
+		return nil, ErrTokenMismatch
+	}
+	page.after = &c
+	return page, nil
+}
+
+// Apply orders db by column and then ID, restricts it to rows after the page
+// token and limits it to one row more than the page size, which tells Next
+// whether another page follows. An empty column sorts by ID alone. parseKey
+// converts a cursor's sort key back to the column's type.
+func (p *Page) Apply(db *gorm.DB, column string, desc bool, parseKey func(string) (interface{}, error)) (*gorm.DB, error) {
+	op, dir := ">", "ASC"
+	if desc {
+		op, dir = "<", "DESC"
+	}
+
+	if p.after != nil {
+		if column == "" {
+			db = db.Where("id "+op+" ?", p.after.ID)
+		} else {
+			key, err := parseKey(p.after.Key)
+			if err != nil {
+				return nil, ErrInvalidPageToken
+			}
+			db = db.Where("("+column+" "+op+" ?) OR ("+column+" = ? AND id "+op+" ?)", key, key, p.after.ID)
+		}
+	}
+
+	order := "id " + dir
+	if column != "" {
+		order = column + " " + dir + ", " + order
+	}
+	return db.Order(order).Limit(p.Size + 1), nil
+}
+
+// Next trims items fetched with Apply to the page size and returns the token
+// for the following page, or "" when this is the last one. keyOf returns an
+// item's sort key (ignored when sorting by ID) and ID.
+func Next[T any](p *Page, items []T, keyOf func(T) (string, uint)) ([]T, string) {
+	if len(items) <= p.Size {
+		return items, ""
+	}
+	items = items[:p.Size]
+	key, id := keyOf(items[len(items)-1])
+	raw, _ := json.Marshal(cursor{Key: key, ID: id, Query: p.query})
+	return items, base64.RawURLEncoding.EncodeToString(raw)
+}
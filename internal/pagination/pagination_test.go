@@ -0,0 +1,211 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type item struct {
+	ID   uint
+	Date time.Time
+}
+
+type filter struct {
+	Status     string
+	Descending bool
+}
+
+// dryRun returns a session that builds SQL without a database server.
+func dryRun(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:1)/test", SkipInitializeWithVersion: true}),
+		&gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db.Table("items")
+}
+
+func dateKey(it item) (string, uint) { return it.Date.Format("2006-01-02"), it.ID }
+
+func parseDate(k string) (interface{}, error) { return time.Parse("2006-01-02", k) }
+
+// firstPageToken lists three items with a page size of two and returns the
+// token of the second page.
+func firstPageToken(t *testing.T, query interface{}) string {
+	t.Helper()
+	page, err := New(2, "", query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	items, token := Next(page, []item{{9, day}, {7, day}, {3, day.AddDate(0, 0, -1)}}, dateKey)
+	if len(items) != 2 || token == "" {
+		t.Fatalf("got %d items and token %q, want 2 and a token", len(items), token)
+	}
+	return token
+}
+
+func TestNewPageSize(t *testing.T) {
+	tests := []struct {
+		size int32
+		want int
+	}{
+		{0, DefaultPageSize},
+		{1, 1},
+		{MaxPageSize, MaxPageSize},
+		{MaxPageSize + 1, MaxPageSize},
+	}
+	for _, tt := range tests {
+		page, err := New(tt.size, "", filter{})
+		if err != nil {
+			t.Fatalf("New(%d): %v", tt.size, err)
+		}
+		if page.Size != tt.want {
+			t.Errorf("New(%d).Size = %d, want %d", tt.size, page.Size, tt.want)
+		}
+	}
+	if _, err := New(-1, "", filter{}); !errors.Is(err, ErrInvalidPageSize) {
+		t.Fatalf("got %v, want ErrInvalidPageSize", err)
+	}
+}
+
+func TestNewRejectsBadTokens(t *testing.T) {
+	query := filter{Status: "approved", Descending: true}
+	token := firstPageToken(t, query)
+
+	tests := []struct {
+		name  string
+		token string
+		query interface{}
+		want  error
+	}{
+		{"other filter", token, filter{Status: "draft", Descending: true}, ErrTokenMismatch},
+		{"other sort order", token, filter{Status: "approved"}, ErrTokenMismatch},
+		{"not base64", "%%%", query, ErrInvalidPageToken},
+		{"not JSON", base64.RawURLEncoding.EncodeToString([]byte("{not json")), query, ErrInvalidPageToken},
+		{"no ID", base64.RawURLEncoding.EncodeToString([]byte(`{"k":"2026-01-05","q":"x"}`)), query, ErrInvalidPageToken},
+		{"truncated", token[:len(token)/2], query, ErrInvalidPageToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(2, tt.token, tt.query); !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := New(2, token, query); err != nil {
+		t.Fatalf("token rejected for its own query: %v", err)
+	}
+}
+
+func TestApply(t *testing.T) {
+	query := filter{Descending: true}
+	token := firstPageToken(t, query)
+	day := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		token     string
+		column    string
+		desc      bool
+		wantWhere string
+		wantVars  []interface{}
+		wantOrder string
+	}{
+		{
+			name:      "first page by ID",
+			wantOrder: "ORDER BY id ASC LIMIT ?",
+			wantVars:  []interface{}{3},
+		},
+		{
+			name:      "first page descending by column",
+			column:    "work_date",
+			desc:      true,
+			wantOrder: "ORDER BY work_date DESC, id DESC LIMIT ?",
+			wantVars:  []interface{}{3},
+		},
+		{
+			name:      "next page descending breaks ties on ID",
+			token:     token,
+			column:    "work_date",
+			desc:      true,
+			wantWhere: "WHERE (work_date < ?) OR (work_date = ? AND id < ?)",
+			wantOrder: "ORDER BY work_date DESC, id DESC LIMIT ?",
+			wantVars:  []interface{}{day, day, uint(7), 3},
+		},
+		{
+			name:      "next page ascending breaks ties on ID",
+			token:     token,
+			column:    "work_date",
+			wantWhere: "WHERE (work_date > ?) OR (work_date = ? AND id > ?)",
+			wantOrder: "ORDER BY work_date ASC, id ASC LIMIT ?",
+			wantVars:  []interface{}{day, day, uint(7), 3},
+		},
+		{
+			name:      "next page by ID",
+			token:     token,
+			desc:      true,
+			wantWhere: "WHERE id < ?",
+			wantOrder: "ORDER BY id DESC LIMIT ?",
+			wantVars:  []interface{}{uint(7), 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := New(2, tt.token, query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			db, err := page.Apply(dryRun(t), tt.column, tt.desc, parseDate)
+			if err != nil {
+				t.Fatalf("Apply: %v", err)
+			}
+			var items []item
+			stmt := db.Find(&items).Statement
+			sql := stmt.SQL.String()
+			if !strings.Contains(sql, tt.wantWhere) || !strings.HasSuffix(sql, tt.wantOrder) {
+				t.Fatalf("got %q, want %q ... %q", sql, tt.wantWhere, tt.wantOrder)
+			}
+			if tt.wantWhere == "" && strings.Contains(sql, "WHERE") {
+				t.Fatalf("first page is filtered: %q", sql)
+			}
+			if !reflect.DeepEqual(stmt.Vars, tt.wantVars) {
+				t.Fatalf("got vars %v, want %v", stmt.Vars, tt.wantVars)
+			}
+		})
+	}
+}
+
+func TestApplyRejectsUnparsableKey(t *testing.T) {
+	query := filter{}
+	page, err := New(2, firstPageToken(t, query), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = page.Apply(dryRun(t), "amount", false, func(k string) (interface{}, error) {
+		return nil, errors.New("not a number")
+	})
+	if !errors.Is(err, ErrInvalidPageToken) {
+		t.Fatalf("got %v, want ErrInvalidPageToken", err)
+	}
+}
+
+func TestNextLastPage(t *testing.T) {
+	page, err := New(2, "", filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, token := Next(page, []item{{ID: 2}, {ID: 1}}, dateKey)
+	if len(items) != 2 || token != "" {
+		t.Fatalf("got %d items and token %q, want 2 and no token", len(items), token)
+	}
+}
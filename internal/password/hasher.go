@@ -0,0 +1,144 @@
+// Package password hashes and checks account passwords. Hashes are stored in
+// their self-describing formats, bcrypt's "$2a$..." and the PHC string
+// "$argon2id$v=19$m=...,t=...,p=...$salt$hash", so hashes made with earlier
+// settings keep verifying and can be upgraded when the user next logs in.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Algorithms.
+const (
+	Bcrypt   = "bcrypt"
+	Argon2id = "argon2id"
+)
+
+var ErrUnknownHash = errors.New("password: unrecognised hash format")
+
+// Argon2Params tune Argon2id. Memory is in KiB.
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+// DefaultArgon2 follows the RFC 9106 second recommended option.
+var DefaultArgon2 = Argon2Params{Memory: 64 * 1024, Iterations: 3, Parallelism: 4}
+
+const (
+	argonSaltLen = 16
+	argonKeyLen  = 32
+)
+
+// Hasher makes new hashes with the configured algorithm and verifies hashes
+// of either algorithm.
+type Hasher struct {
+	algorithm  string
+	bcryptCost int
+	argon      Argon2Params
+}
+
+// NewHasher returns a hasher for algorithm. Zero settings take the defaults.
+func NewHasher(algorithm string, bcryptCost int, argon Argon2Params) (*Hasher, error) {
+	switch algorithm {
+	case "":
+		algorithm = Bcrypt
+	case Bcrypt, Argon2id:
+	default:
+		return nil, fmt.Errorf("password: unknown hasher %q", algorithm)
+	}
+	if bcryptCost == 0 {
+		bcryptCost = bcrypt.DefaultCost
+	}
+	if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("password: bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	if argon.Memory == 0 {
+		argon.Memory = DefaultArgon2.Memory
+	}
+	if argon.Iterations == 0 {
+		argon.Iterations = DefaultArgon2.Iterations
+	}
+	if argon.Parallelism == 0 {
+		argon.Parallelism = DefaultArgon2.Parallelism
+	}
+	return &Hasher{algorithm: algorithm, bcryptCost: bcryptCost, argon: argon}, nil
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	if h.algorithm == Bcrypt {
+		hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		return string(hashed), err
+	}
+	salt := make([]byte, argonSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, h.argon.Iterations, h.argon.Memory, h.argon.Parallelism, argonKeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version,
+		h.argon.Memory, h.argon.Iterations, h.argon.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify reports whether password matches hash, whichever algorithm made it.
+func (h *Hasher) Verify(hash, password string) bool {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		params, salt, key, err := decodeArgon2(hash)
+		if err != nil {
+			return false
+		}
+		got := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+		return subtle.ConstantTimeCompare(got, key) == 1
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// NeedsRehash reports whether hash was made with another algorithm or other
+// settings than the hasher's.
+func (h *Hasher) NeedsRehash(hash string) bool {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		if h.algorithm != Argon2id {
+			return true
+		}
+		params, _, _, err := decodeArgon2(hash)
+		return err != nil || params != h.argon
+	}
+	if h.algorithm != Bcrypt {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.bcryptCost
+}
+
+func decodeArgon2(hash string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return params, nil, nil, ErrUnknownHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrUnknownHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrUnknownHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrUnknownHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrUnknownHash
+	}
+	return params, salt, key, nil
+}
@@ -0,0 +1,175 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// cheapArgon keeps the tests fast; production uses DefaultArgon2.
+var cheapArgon = Argon2Params{Memory: 64, Iterations: 1, Parallelism: 1}
+
+func newTestHasher(t *testing.T, algorithm string, cost int, argon Argon2Params) *Hasher {
+	t.Helper()
+	h, err := NewHasher(algorithm, cost, argon)
+	if err != nil {
+		t.Fatalf("NewHasher: %v", err)
+	}
+	return h
+}
+
+func TestHashVerify(t *testing.T) {
+	for _, h := range []*Hasher{
+		newTestHasher(t, Bcrypt, bcrypt.MinCost, Argon2Params{}),
+		newTestHasher(t, Argon2id, 0, cheapArgon),
+	} {
+		t.Run(h.algorithm, func(t *testing.T) {
+			hash, err := h.Hash("correct horse")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !h.Verify(hash, "correct horse") {
+				t.Fatal("password doesn't verify against its hash")
+			}
+			if h.Verify(hash, "correct horsE") {
+				t.Fatal("wrong password verified")
+			}
+			if h.NeedsRehash(hash) {
+				t.Fatal("fresh hash needs a rehash")
+			}
+			again, _ := h.Hash("correct horse")
+			if again == hash {
+				t.Fatal("hashes are not salted")
+			}
+		})
+	}
+}
+
+func TestVerifyLegacyHashes(t *testing.T) {
+	legacy, err := bcrypt.GenerateFromPassword([]byte("s3cret!"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldArgon, err := newTestHasher(t, Argon2id, 0, Argon2Params{Memory: 32, Iterations: 2, Parallelism: 1}).Hash("s3cret!")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An argon2id hasher still accepts bcrypt hashes and argon2id hashes
+	// made with other settings, and asks for both to be rehashed.
+	h := newTestHasher(t, Argon2id, 0, cheapArgon)
+	for _, hash := range []string{string(legacy), oldArgon} {
+		if !h.Verify(hash, "s3cret!") {
+			t.Errorf("%s doesn't verify", hash)
+		}
+		if h.Verify(hash, "s3cret") {
+			t.Errorf("%s verified a wrong password", hash)
+		}
+		if !h.NeedsRehash(hash) {
+			t.Errorf("%s doesn't need a rehash", hash)
+		}
+	}
+
+	// A bcrypt hasher accepts argon2id hashes too.
+	b := newTestHasher(t, Bcrypt, bcrypt.MinCost, Argon2Params{})
+	if !b.Verify(oldArgon, "s3cret!") || !b.NeedsRehash(oldArgon) {
+		t.Error("bcrypt hasher doesn't handle an argon2id hash")
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	cost4, _ := bcrypt.GenerateFromPassword([]byte("pw"), 4)
+	cost5, _ := bcrypt.GenerateFromPassword([]byte("pw"), 5)
+	argon, _ := newTestHasher(t, Argon2id, 0, cheapArgon).Hash("pw")
+
+	tests := []struct {
+		name   string
+		hasher *Hasher
+		hash   string
+		want   bool
+	}{
+		{"bcrypt at the configured cost", newTestHasher(t, Bcrypt, 4, Argon2Params{}), string(cost4), false},
+		{"bcrypt at another cost", newTestHasher(t, Bcrypt, 4, Argon2Params{}), string(cost5), true},
+		{"argon2id under a bcrypt hasher", newTestHasher(t, Bcrypt, 4, Argon2Params{}), argon, true},
+		{"argon2id with the configured settings", newTestHasher(t, Argon2id, 0, cheapArgon), argon, false},
+		{"argon2id with more memory configured", newTestHasher(t, Argon2id, 0, Argon2Params{Memory: 128, Iterations: 1, Parallelism: 1}), argon, true},
+		{"argon2id with more iterations configured", newTestHasher(t, Argon2id, 0, Argon2Params{Memory: 64, Iterations: 2, Parallelism: 1}), argon, true},
+		{"bcrypt under an argon2id hasher", newTestHasher(t, Argon2id, 0, cheapArgon), string(cost4), true},
+		{"garbage", newTestHasher(t, Bcrypt, 4, Argon2Params{}), "not a hash", true},
+		{"malformed argon2id", newTestHasher(t, Argon2id, 0, cheapArgon), "$argon2id$v=19$m=64,t=1,p=1$salt", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.hash); got != tt.want {
+				t.Fatalf("NeedsRehash = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeArgon2Errors(t *testing.T) {
+	good, err := newTestHasher(t, Argon2id, 0, cheapArgon).Hash("pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(good, "$")
+	with := func(i int, v string) string {
+		p := append([]string(nil), parts...)
+		p[i] = v
+		return strings.Join(p, "$")
+	}
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{"missing part", strings.Join(parts[:5], "$")},
+		{"extra part", good + "$x"},
+		{"other version", with(2, "v=16")},
+		{"no version", with(2, "19")},
+		{"parameters out of order", with(3, "t=1,m=64,p=1")},
+		{"non-numeric parameter", with(3, "m=lots,t=1,p=1")},
+		{"salt not base64", with(4, "!!!")},
+		{"hash not base64", with(5, "!!!")},
+		{"empty hash", with(5, "")},
+	}
+	h := newTestHasher(t, Argon2id, 0, cheapArgon)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := decodeArgon2(tt.hash); err != ErrUnknownHash {
+				t.Fatalf("got %v, want ErrUnknownHash", err)
+			}
+			if h.Verify(tt.hash, "pw") {
+				t.Fatal("malformed hash verified")
+			}
+		})
+	}
+
+	params, salt, key, err := decodeArgon2(good)
+	if err != nil || params != cheapArgon || len(salt) != argonSaltLen || len(key) != argonKeyLen {
+		t.Fatalf("decodeArgon2(%q) = %+v, %d-byte salt, %d-byte key, %v", good, params, len(salt), len(key), err)
+	}
+}
+
+func TestNewHasher(t *testing.T) {
+	h, err := NewHasher("", 0, Argon2Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.algorithm != Bcrypt || h.bcryptCost != bcrypt.DefaultCost || h.argon != DefaultArgon2 {
+		t.Fatalf("unexpected defaults %+v", h)
+	}
+	for _, tt := range []struct {
+		algorithm string
+		cost      int
+	}{
+		{"scrypt", 0},
+		{Bcrypt, bcrypt.MinCost - 1},
+		{Bcrypt, bcrypt.MaxCost + 1},
+	} {
+		if _, err := NewHasher(tt.algorithm, tt.cost, Argon2Params{}); err == nil {
+			t.Errorf("NewHasher(%q, %d) accepted", tt.algorithm, tt.cost)
+		}
+	}
+}
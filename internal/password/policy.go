@@ -0,0 +1,142 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PolicyError lists every rule a password broke.
+type PolicyError struct {
+	Problems []string
+}
+
+func (e *PolicyError) Error() string {
+	return "password " + strings.Join(e.Problems, "; ")
+}
+
+// DefaultMinLength applies when a policy sets no minimum.
+const DefaultMinLength = 8
+
+// Policy sets the rules new passwords must follow.
+type Policy struct {
+	MinLength     int // in characters, DefaultMinLength when zero
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+
+	// breached holds the upper-case SHA-1 hex of passwords known from
+	// breaches.
+	breached map[string]struct{}
+}
+
+// LoadBreached reads a list of breached passwords, one per line. Lines may be
+// plain passwords or SHA-1 hashes in hex, optionally followed by ":count" as
+// in the Have I Been Pwned downloads. Blank lines and lines starting with #
+// are skipped.
+func (p *Policy) LoadBreached(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("password: open breached list: %w", err)
+	}
+	defer f.Close()
+
+	p.breached = make(map[string]struct{})
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if hash, _, _ := strings.Cut(line, ":"); isSHA1Hex(hash) {
+			p.breached[strings.ToUpper(hash)] = struct{}{}
+			continue
+		}
+		p.breached[sha1Hex(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("password: read breached list: %w", err)
+	}
+	return nil
+}
+
+// Check returns a *PolicyError when password breaks the policy. identities
+// are the username and email of the account, which the password must not
+// contain.
+func (p *Policy) Check(password string, identities ...string) error {
+	var problems []string
+	minLength := p.MinLength
+	if minLength <= 0 {
+		minLength = DefaultMinLength
+	}
+	length := utf8.RuneCountInString(password)
+	if length < minLength {
+		problems = append(problems, fmt.Sprintf("must be at least %d characters", minLength))
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		problems = append(problems, fmt.Sprintf("must be at most %d characters", p.MaxLength))
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	if p.RequireUpper && !upper {
+		problems = append(problems, "must contain an upper-case letter")
+	}
+	if p.RequireLower && !lower {
+		problems = append(problems, "must contain a lower-case letter")
+	}
+	if p.RequireDigit && !digit {
+		problems = append(problems, "must contain a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		problems = append(problems, "must contain a symbol")
+	}
+
+	lowered := strings.ToLower(password)
+	for _, id := range identities {
+		id, _, _ = strings.Cut(strings.ToLower(id), "@")
+		if len(id) >= 3 && strings.Contains(lowered, id) {
+			problems = append(problems, "must not contain your username or email")
+			break
+		}
+	}
+	if _, ok := p.breached[sha1Hex(password)]; ok {
+		problems = append(problems, "appears in a list of breached passwords")
+	}
+
+	if len(problems) > 0 {
+		return &PolicyError{Problems: problems}
+	}
+	return nil
+}
+
+func sha1Hex(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func isSHA1Hex(s string) bool {
+	if len(s) != 40 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
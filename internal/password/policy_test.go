@@ -0,0 +1,94 @@
+package password
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	strict := &Policy{MinLength: 10, MaxLength: 20, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true}
+
+	tests := []struct {
+		name       string
+		policy     *Policy
+		password   string
+		identities []string
+		want       []string // problems, in order
+	}{
+		{"default minimum", &Policy{}, "short", nil, []string{"must be at least 8 characters"}},
+		{"default policy accepts", &Policy{}, "longenough", nil, nil},
+		{"length counts characters, not bytes", &Policy{}, "ễễễễễễễễ", nil, nil},
+		{"strict policy accepts", strict, "Tr0ub4dor&3x", nil, nil},
+		{"too long", strict, "Tr0ub4dor&3xTr0ub4dor&3x", nil, []string{"must be at most 20 characters"}},
+		{"every class missing", strict, "          ", nil, []string{
+			"must contain an upper-case letter",
+			"must contain a lower-case letter",
+			"must contain a digit",
+			"must contain a symbol",
+		}},
+		{"several rules at once", strict, "abc", nil, []string{
+			"must be at least 10 characters",
+			"must contain an upper-case letter",
+			"must contain a digit",
+			"must contain a symbol",
+		}},
+		{"contains the username", &Policy{}, "xxAnaMaria99", []string{"anamaria", "other@example.com"}, []string{"must not contain your username or email"}},
+		{"contains the email's local part", &Policy{}, "my-an.nguyen-pw", []string{"bob", "An.Nguyen@example.com"}, []string{"must not contain your username or email"}},
+		{"short identities are ignored", &Policy{}, "abracadabra", []string{"ab"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Check(tt.password, tt.identities...)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("rejected: %v", err)
+				}
+				return
+			}
+			var policyErr *PolicyError
+			if !errors.As(err, &policyErr) {
+				t.Fatalf("got %v, want a *PolicyError", err)
+			}
+			if strings.Join(policyErr.Problems, "|") != strings.Join(tt.want, "|") {
+				t.Fatalf("got problems %q, want %q", policyErr.Problems, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyBreached(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	list := strings.Join([]string{
+		"# plain passwords and Have I Been Pwned lines",
+		"",
+		"password123",
+		sha1Hex("letmein2024") + ":4312",
+		strings.ToLower(sha1Hex("qwertyuiop")),
+	}, "\n")
+	if err := os.WriteFile(path, []byte(list), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Policy{}
+	if err := p.LoadBreached(path); err != nil {
+		t.Fatal(err)
+	}
+	for _, pw := range []string{"password123", "letmein2024", "qwertyuiop"} {
+		var policyErr *PolicyError
+		if err := p.Check(pw); !errors.As(err, &policyErr) || policyErr.Problems[0] != "appears in a list of breached passwords" {
+			t.Errorf("breached %q: got %v", pw, err)
+		}
+	}
+	for _, pw := range []string{"Password123", "# plain passwords and Have I Been Pwned lines"} {
+		if err := p.Check(pw); err != nil {
+			t.Errorf("%q rejected: %v", pw, err)
+		}
+	}
+
+	if err := p.LoadBreached(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Fatal("missing list loaded")
+	}
+}
@@ -0,0 +1,154 @@
+// Package pii encrypts personal data columns with envelope encryption: each
+// value is sealed with its own random data key, and the data key is sealed
+// with a named master key from the keyring. Rotating the master key only
+// needs the data keys re-sealed, which happens when a row is written again.
+package pii
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix marks an encrypted value. Values without it are legacy plaintext
+// and are read as is until they are rewritten.
+const prefix = "enc:v1:"
+
+var (
+	ErrNoActiveKey = errors.New("pii: active encryption key is not configured")
+	ErrUnknownKey  = errors.New("pii: value is encrypted with an unknown key")
+	ErrCorrupt     = errors.New("pii: malformed encrypted value")
+)
+
+// sampleKey is the key the sample config once shipped with; it is public, so
+// data sealed with it is not protected.
+const sampleKey = "Y2hhbmdlLW1lLXBpaS1rZXktMzItYnl0ZXMtbG9uZyE="
+
+// Keyring holds the master keys by ID. New values are sealed with the active
+// key; older keys stay in the ring so existing values can still be opened.
+type Keyring struct {
+	active string
+	keys   map[string]cipher.AEAD
+}
+
+// NewKeyring builds a keyring from base64-encoded 32-byte AES keys.
+func NewKeyring(active string, keys map[string]string) (*Keyring, error) {
+	if _, ok := keys[active]; !ok || active == "" {
+		return nil, ErrNoActiveKey
+	}
+	kr := &Keyring{active: active, keys: make(map[string]cipher.AEAD, len(keys))}
+	for id, encoded := range keys {
+		if strings.Contains(id, ":") {
+			return nil, fmt.Errorf("pii: key id %q must not contain ':'", id)
+		}
+		if encoded == "" {
+			return nil, fmt.Errorf("pii: key %q is not set", id)
+		}
+		if encoded == sampleKey {
+			return nil, fmt.Errorf("pii: key %q is the published sample key; generate a new one", id)
+		}
+		raw, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(raw) != 32 {
+			return nil, fmt.Errorf("pii: key %q must be 32 bytes, base64 encoded", id)
+		}
+		aead, err := newAEAD(raw)
+		if err != nil {
+			return nil, err
+		}
+		kr.keys[id] = aead
+	}
+	return kr, nil
+}
+
+// ActiveKey returns the ID of the key new values are sealed with.
+func (k *Keyring) ActiveKey() string {
+	return k.active
+}
+
+// Encrypt seals plaintext as "enc:v1:<key id>:<sealed data key>:<sealed value>".
+func (k *Keyring) Encrypt(plaintext []byte) (string, error) {
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	sealedKey, err := seal(k.keys[k.active], dataKey)
+	if err != nil {
+		return "", err
+	}
+	sealedValue, err := seal(dataAEAD, plaintext)
+	if err != nil {
+		return "", err
+	}
+	return prefix + k.active + ":" +
+		base64.RawStdEncoding.EncodeToString(sealedKey) + ":" +
+		base64.RawStdEncoding.EncodeToString(sealedValue), nil
+}
+
+// Decrypt opens a value produced by Encrypt. Legacy plaintext is returned
+// unchanged.
+func (k *Keyring) Decrypt(value string) ([]byte, error) {
+	if !strings.HasPrefix(value, prefix) {
+		return []byte(value), nil
+	}
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return nil, ErrCorrupt
+	}
+	master, ok := k.keys[parts[0]]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, parts[0])
+	}
+	sealedKey, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+	sealedValue, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+	dataKey, err := open(master, sealedKey)
+	if err != nil {
+		return nil, err
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return open(dataAEAD, sealedValue)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrCorrupt
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,151 @@
+package pii
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newKey(t *testing.T) string {
+	t.Helper()
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+func newTestKeyring(t *testing.T, active string, keys map[string]string) *Keyring {
+	t.Helper()
+	kr, err := NewKeyring(active, keys)
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	return kr
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	kr := newTestKeyring(t, "k1", map[string]string{"k1": newKey(t)})
+	for _, plaintext := range []string{"0123456789", "Nguyễn Văn An", ""} {
+		sealed, err := kr.Encrypt([]byte(plaintext))
+		if err != nil {
+			t.Fatalf("Encrypt(%q): %v", plaintext, err)
+		}
+		if !strings.HasPrefix(sealed, prefix+"k1:") || (plaintext != "" && strings.Contains(sealed, plaintext)) {
+			t.Fatalf("Encrypt(%q) = %q", plaintext, sealed)
+		}
+		got, err := kr.Decrypt(sealed)
+		if err != nil {
+			t.Fatalf("Decrypt: %v", err)
+		}
+		if string(got) != plaintext {
+			t.Fatalf("got %q, want %q", got, plaintext)
+		}
+	}
+
+	a, _ := kr.Encrypt([]byte("same"))
+	b, _ := kr.Encrypt([]byte("same"))
+	if a == b {
+		t.Fatal("equal plaintexts sealed to the same value")
+	}
+}
+
+func TestDecryptLegacyPlaintext(t *testing.T) {
+	kr := newTestKeyring(t, "k1", map[string]string{"k1": newKey(t)})
+	got, err := kr.Decrypt("9704 0000 1234")
+	if err != nil || string(got) != "9704 0000 1234" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+}
+
+func TestDecryptRetiredKey(t *testing.T) {
+	k1, k2 := newKey(t), newKey(t)
+	old := newTestKeyring(t, "k1", map[string]string{"k1": k1})
+	sealed, err := old.Encrypt([]byte("12000000"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated := newTestKeyring(t, "k2", map[string]string{"k1": k1, "k2": k2})
+	got, err := rotated.Decrypt(sealed)
+	if err != nil || string(got) != "12000000" {
+		t.Fatalf("retired key: got %q, %v", got, err)
+	}
+	resealed, err := rotated.Encrypt(got)
+	if err != nil || !strings.HasPrefix(resealed, prefix+"k2:") {
+		t.Fatalf("new values are not sealed with the active key: %q, %v", resealed, err)
+	}
+
+	dropped := newTestKeyring(t, "k2", map[string]string{"k2": k2})
+	if _, err := dropped.Decrypt(sealed); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("removed key: got %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestDecryptRejectsTampering(t *testing.T) {
+	k1, k2 := newKey(t), newKey(t)
+	kr := newTestKeyring(t, "k1", map[string]string{"k1": k1, "k2": k2})
+	sealed, err := kr.Encrypt([]byte("12000000"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(strings.TrimPrefix(sealed, prefix), ":")
+
+	flip := func(encoded string) string {
+		raw, err := base64.RawStdEncoding.DecodeString(encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		raw[len(raw)-1] ^= 1
+		return base64.RawStdEncoding.EncodeToString(raw)
+	}
+	join := func(p ...string) string { return prefix + strings.Join(p, ":") }
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"value auth tag", join(parts[0], parts[1], flip(parts[2]))},
+		{"data key auth tag", join(parts[0], flip(parts[1]), parts[2])},
+		{"other master key", join("k2", parts[1], parts[2])},
+		{"data key of another value", func() string {
+			other, _ := kr.Encrypt([]byte("12000000"))
+			return join(parts[0], strings.Split(other, ":")[3], parts[2])
+		}()},
+		{"truncated", join(parts[0], parts[1], parts[2][:8])},
+		{"missing part", join(parts[0], parts[2])},
+		{"not base64", join(parts[0], parts[1], "!!!")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := kr.Decrypt(tt.value); !errors.Is(err, ErrCorrupt) {
+				t.Fatalf("got %q, %v; want ErrCorrupt", got, err)
+			}
+		})
+	}
+}
+
+func TestNewKeyringRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		active string
+		keys   map[string]string
+	}{
+		{"no active key", "", map[string]string{"k1": newKey(t)}},
+		{"active key missing", "k2", map[string]string{"k1": newKey(t)}},
+		{"empty key", "k1", map[string]string{"k1": ""}},
+		{"sample key", "k1", map[string]string{"k1": sampleKey}},
+		{"short key", "k1", map[string]string{"k1": base64.StdEncoding.EncodeToString([]byte("too short"))}},
+		{"not base64", "k1", map[string]string{"k1": "not base64!"}},
+		{"colon in key id", "k:1", map[string]string{"k:1": newKey(t)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewKeyring(tt.active, tt.keys); err == nil {
+				t.Fatal("keyring accepted")
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package pii
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"gorm.io/gorm/schema"
+)
+
+// Serializer is a gorm serializer that encrypts string and float64 fields,
+// registered under the name used in `gorm:"serializer:pii"` tags.
+type Serializer struct {
+	Keyring *Keyring
+}
+
+// Register makes the keyring available to models tagged serializer:pii.
+func Register(k *Keyring) {
+	schema.RegisterSerializer("pii", Serializer{Keyring: k})
+}
+
+func (s Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var stored string
+	switch v := dbValue.(type) {
+	case nil:
+	case []byte:
+		stored = string(v)
+	case string:
+		stored = v
+	default:
+		stored = fmt.Sprint(v)
+	}
+
+	fieldValue := reflect.New(field.FieldType).Elem()
+	if stored != "" {
+		plaintext, err := s.Keyring.Decrypt(stored)
+		if err != nil {
+			return fmt.Errorf("decrypt %s: %w", field.Name, err)
+		}
+		switch field.FieldType.Kind() {
+		case reflect.String:
+			fieldValue.SetString(string(plaintext))
+		case reflect.Float64:
+			f, err := strconv.ParseFloat(string(plaintext), 64)
+			if err != nil {
+				return fmt.Errorf("decrypt %s: %w", field.Name, err)
+			}
+			fieldValue.SetFloat(f)
+		default:
+			return fmt.Errorf("pii serializer does not support %s", field.FieldType)
+		}
+	}
+	field.ReflectValueOf(ctx, dst).Set(fieldValue)
+	return nil
+}
+
+func (s Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var plaintext string
+	switch v := fieldValue.(type) {
+	case string:
+		if v == "" {
+			return "", nil
+		}
+		plaintext = v
+	case float64:
+		plaintext = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return nil, fmt.Errorf("pii serializer does not support %T", fieldValue)
+	}
+	return s.Keyring.Encrypt([]byte(plaintext))
+}
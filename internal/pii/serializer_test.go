@@ -0,0 +1,138 @@
+package pii
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/gorm/schema"
+)
+
+type record struct {
+	ID          uint
+	BankAccount string  `gorm:"serializer:pii"`
+	BaseSalary  float64 `gorm:"serializer:pii"`
+}
+
+// fields returns the parsed pii fields of record.
+func fields(t *testing.T, kr *Keyring) (bankAccount, baseSalary *schema.Field) {
+	t.Helper()
+	Register(kr)
+	s, err := schema.Parse(&record{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.LookUpField("BankAccount"), s.LookUpField("BaseSalary")
+}
+
+func TestSerializerRoundTrip(t *testing.T) {
+	kr := newTestKeyring(t, "k1", map[string]string{"k1": newKey(t)})
+	s := Serializer{Keyring: kr}
+	bankField, salaryField := fields(t, kr)
+	ctx := context.Background()
+
+	in := record{BankAccount: "0123456789", BaseSalary: 15_500_000.5}
+	storedBank, err := s.Value(ctx, bankField, reflect.ValueOf(&in).Elem(), in.BankAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storedSalary, err := s.Value(ctx, salaryField, reflect.ValueOf(&in).Elem(), in.BaseSalary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []interface{}{storedBank, storedSalary} {
+		if !strings.HasPrefix(v.(string), prefix) {
+			t.Fatalf("stored %q unencrypted", v)
+		}
+	}
+
+	var out record
+	dst := reflect.ValueOf(&out).Elem()
+	if err := s.Scan(ctx, bankField, dst, []byte(storedBank.(string))); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Scan(ctx, salaryField, dst, storedSalary); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Fatalf("got %+v, want %+v", out, in)
+	}
+}
+
+func TestSerializerEmptyValues(t *testing.T) {
+	kr := newTestKeyring(t, "k1", map[string]string{"k1": newKey(t)})
+	s := Serializer{Keyring: kr}
+	bankField, salaryField := fields(t, kr)
+	ctx := context.Background()
+
+	// An empty string is stored empty rather than as a sealed empty value.
+	var in record
+	stored, err := s.Value(ctx, bankField, reflect.ValueOf(&in).Elem(), "")
+	if err != nil || stored != "" {
+		t.Fatalf("got %q, %v; want an empty value", stored, err)
+	}
+	// A zero salary is a value like any other.
+	stored, err = s.Value(ctx, salaryField, reflect.ValueOf(&in).Elem(), 0.0)
+	if err != nil || !strings.HasPrefix(stored.(string), prefix) {
+		t.Fatalf("got %q, %v; want a sealed zero", stored, err)
+	}
+
+	out := record{BankAccount: "stale", BaseSalary: 1}
+	dst := reflect.ValueOf(&out).Elem()
+	for _, dbValue := range []interface{}{nil, "", []byte{}} {
+		if err := s.Scan(ctx, bankField, dst, dbValue); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Scan(ctx, salaryField, dst, dbValue); err != nil {
+			t.Fatal(err)
+		}
+		if out.BankAccount != "" || out.BaseSalary != 0 {
+			t.Fatalf("scanning %#v left %+v", dbValue, out)
+		}
+	}
+}
+
+func TestSerializerLegacyPlaintext(t *testing.T) {
+	kr := newTestKeyring(t, "k1", map[string]string{"k1": newKey(t)})
+	s := Serializer{Keyring: kr}
+	bankField, salaryField := fields(t, kr)
+	ctx := context.Background()
+
+	var out record
+	dst := reflect.ValueOf(&out).Elem()
+	if err := s.Scan(ctx, bankField, dst, "0123456789"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Scan(ctx, salaryField, dst, "12000000"); err != nil {
+		t.Fatal(err)
+	}
+	if out.BankAccount != "0123456789" || out.BaseSalary != 12_000_000 {
+		t.Fatalf("got %+v", out)
+	}
+}
+
+func TestSerializerTampered(t *testing.T) {
+	kr := newTestKeyring(t, "k1", map[string]string{"k1": newKey(t)})
+	s := Serializer{Keyring: kr}
+	bankField, _ := fields(t, kr)
+	ctx := context.Background()
+
+	var in record
+	stored, err := s.Value(ctx, bankField, reflect.ValueOf(&in).Elem(), "0123456789")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed := stored.(string)
+	tampered := sealed[:len(sealed)-2] + "AA"
+	if tampered == sealed {
+		tampered = sealed[:len(sealed)-2] + "BB"
+	}
+
+	var out record
+	if err := s.Scan(ctx, bankField, reflect.ValueOf(&out).Elem(), tampered); !errors.Is(err, ErrCorrupt) {
+		t.Fatalf("got %v, want ErrCorrupt", err)
+	}
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+	"myapp/internal/pagination"
+)
+
+type AuthEventFilter struct {
+	UserID uint
+	Event  string
+}
+
+type AuthEventRepo interface {
+	Create(ctx context.Context, event *model.AuthEvent) error
+
+	// List returns events newest first.
+	List(ctx context.Context, filter AuthEventFilter, page *pagination.Page) ([]*model.AuthEvent, string, error)
+}
+
+type authEventRepo struct {
+	data *data.Data
+}
+
+func NewAuthEventRepo(data *data.Data) AuthEventRepo {
+	return &authEventRepo{data: data}
+}
+
+func (r *authEventRepo) Create(ctx context.Context, event *model.AuthEvent) error {
+	return r.data.DB.WithContext(ctx).Create(event).Error
+}
+
+func (r *authEventRepo) List(ctx context.Context, filter AuthEventFilter, page *pagination.Page) ([]*model.AuthEvent, string, error) {
+	query := r.data.DB.WithContext(ctx).Model(&model.AuthEvent{})
+	if filter.UserID != 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Event != "" {
+		query = query.Where("event = ?", filter.Event)
+	}
+
+	query, err := page.Apply(query, "", true, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	var rows []*model.AuthEvent
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, "", fmt.Errorf("list auth events: %w", err)
+	}
+	rows, nextToken := pagination.Next(page, rows, func(e *model.AuthEvent) (string, uint) {
+		return "", e.ID
+	})
+	return rows, nextToken, nil
+}
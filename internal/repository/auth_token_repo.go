@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrAuthTokenNotFound = errors.New("token not found or expired")
+
+// AuthTokenRepo keeps single-use tokens such as email verification and
+// password reset links or login challenges. Only the token hash is stored, under
+// auth_token:<purpose>:<hash>, and a user holds at most one live token per
+// purpose so a new one invalidates the previous.
+type AuthTokenRepo interface {
+	Issue(ctx context.Context, purpose, hash string, userID uint, ttl time.Duration) error
+
+	// Lookup returns the user the token was issued to without using it up.
+	Lookup(ctx context.Context, purpose, hash string) (uint, error)
+
+	// Consume returns the user the token was issued to and deletes it, so
+	// a token works once.
+	Consume(ctx context.Context, purpose, hash string) (uint, error)
+
+	// Hit counts an attempt against key and reports whether it stays within
+	// limit attempts per window.
+	Hit(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+}
+
+type authTokenRepo struct {
+	redis *RedisRepo
+}
+
+func NewAuthTokenRepo(redis *RedisRepo) AuthTokenRepo {
+	return &authTokenRepo{redis: redis}
+}
+
+func authTokenKey(purpose, hash string) string {
+	return "auth_token:" + purpose + ":" + hash
+}
+
+func userAuthTokenKey(purpose string, userID uint) string {
+	return "auth_token_user:" + purpose + ":" + strconv.FormatUint(uint64(userID), 10)
+}
+
+func (r *authTokenRepo) Issue(ctx context.Context, purpose, hash string, userID uint, ttl time.Duration) error {
+	userKey := userAuthTokenKey(purpose, userID)
+	previous, err := r.redis.Get(ctx, userKey)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	if previous != "" {
+		if err := r.redis.Del(ctx, authTokenKey(purpose, previous)); err != nil {
+			return err
+		}
+	}
+	if err := r.redis.Set(ctx, authTokenKey(purpose, hash), strconv.FormatUint(uint64(userID), 10), ttl); err != nil {
+		return err
+	}
+	return r.redis.Set(ctx, userKey, hash, ttl)
+}
+
+func (r *authTokenRepo) Lookup(ctx context.Context, purpose, hash string) (uint, error) {
+	raw, err := r.redis.Get(ctx, authTokenKey(purpose, hash))
+	if errors.Is(err, redis.Nil) {
+		return 0, ErrAuthTokenNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	userID, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, ErrAuthTokenNotFound
+	}
+	return uint(userID), nil
+}
+
+func (r *authTokenRepo) Consume(ctx context.Context, purpose, hash string) (uint, error) {
+	raw, err := r.redis.GetDel(ctx, authTokenKey(purpose, hash))
+	if errors.Is(err, redis.Nil) {
+		return 0, ErrAuthTokenNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	userID, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, ErrAuthTokenNotFound
+	}
+	userKey := userAuthTokenKey(purpose, uint(userID))
+	if current, err := r.redis.Get(ctx, userKey); err == nil && current == hash {
+		if err := r.redis.Del(ctx, userKey); err != nil {
+			return 0, err
+		}
+	}
+	return uint(userID), nil
+}
+
+func (r *authTokenRepo) Hit(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	n, err := r.redis.Incr(ctx, "rate:"+key, window)
+	if err != nil {
+		return false, err
+	}
+	return n <= int64(limit), nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+type ContractRepo interface {
+	CreateContract(ctx context.Context, contract *model.Contract) error
+
+	// ListContracts returns the employee's contracts overlapping [from, to],
+	// ordered by StartDate. Zero times leave that end of the range open.
+	ListContracts(
+		ctx context.Context,
+		employeeID uint,
+		from, to time.Time,
+	) ([]*model.Contract, error)
+
+	GetTermination(ctx context.Context, employeeID uint) (*model.Termination, error)
+
+	// Terminate stores the termination, marks the employee terminated and ends
+	// their open contracts on the last working day, in one transaction.
+	Terminate(ctx context.Context, termination *model.Termination) error
+}
+
+type contractRepo struct {
+	data *data.Data
+}
+
+func NewContractRepo(data *data.Data) *contractRepo {
+	return &contractRepo{data: data}
+}
+
+func (r *contractRepo) CreateContract(ctx context.Context, contract *model.Contract) error {
+	return r.data.DB.WithContext(ctx).Create(contract).Error
+}
+
+func (r *contractRepo) ListContracts(
+	ctx context.Context,
+	employeeID uint,
+	from, to time.Time,
+) ([]*model.Contract, error) {
+	query := r.data.DB.WithContext(ctx).Where("employee_id = ?", employeeID)
+	if !to.IsZero() {
+		query = query.Where("start_date <= ?", to)
+	}
+	if !from.IsZero() {
+		query = query.Where("(end_date IS NULL OR end_date >= ?)", from)
+	}
+	var contracts []*model.Contract
+	if err := query.Order("start_date, id").Find(&contracts).Error; err != nil {
+		return nil, fmt.Errorf("list contracts: %w", err)
+	}
+	return contracts, nil
+}
+
+func (r *contractRepo) GetTermination(ctx context.Context, employeeID uint) (*model.Termination, error) {
+	var termination model.Termination
+	err := r.data.DB.WithContext(ctx).Where("employee_id = ?", employeeID).First(&termination).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("termination not found")
+		}
+		return nil, fmt.Errorf("query termination: %w", err)
+	}
+	return &termination, nil
+}
+
+func (r *contractRepo) Terminate(ctx context.Context, termination *model.Termination) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(termination).Error; err != nil {
+			return err
+		}
+		err := tx.Model(&model.Employee{}).
+			Where("id = ?", termination.EmployeeID).
+			Updates(map[string]interface{}{
+				"status":        model.EmployeeTerminated,
+				"terminated_at": termination.LastWorkingDay,
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&model.Contract{}).
+			Where("employee_id = ? AND (end_date IS NULL OR end_date > ?)", termination.EmployeeID, termination.LastWorkingDay).
+			Update("end_date", termination.LastWorkingDay).Error
+	})
+}
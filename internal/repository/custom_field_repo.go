@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CustomFieldRepo interface {
+	CreateField(ctx context.Context, field *model.CustomField) error
+	GetField(ctx context.Context, id uint) (*model.CustomField, error)
+	UpdateField(ctx context.Context, field *model.CustomField) error
+
+	// ListFields returns the field definitions ordered by key, optionally
+	// only the active ones.
+	ListFields(ctx context.Context, activeOnly bool) ([]*model.CustomField, error)
+
+	// SetValues stores the employee's values by field ID in one transaction.
+	// An empty value removes the field from the employee.
+	SetValues(ctx context.Context, employeeID uint, values map[uint]string) error
+}
+
+type customFieldRepo struct {
+	data *data.Data
+}
+
+func NewCustomFieldRepo(data *data.Data) *customFieldRepo {
+	return &customFieldRepo{data: data}
+}
+
+func (r *customFieldRepo) CreateField(ctx context.Context, field *model.CustomField) error {
+	return r.data.DB.WithContext(ctx).Create(field).Error
+}
+
+func (r *customFieldRepo) GetField(ctx context.Context, id uint) (*model.CustomField, error) {
+	var field model.CustomField
+	if err := r.data.DB.WithContext(ctx).First(&field, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("custom field not found")
+		}
+		return nil, fmt.Errorf("query custom field: %w", err)
+	}
+	return &field, nil
+}
+
+func (r *customFieldRepo) UpdateField(ctx context.Context, field *model.CustomField) error {
+	return r.data.DB.WithContext(ctx).Save(field).Error
+}
+
+func (r *customFieldRepo) ListFields(ctx context.Context, activeOnly bool) ([]*model.CustomField, error) {
+	query := r.data.DB.WithContext(ctx)
+	if activeOnly {
+		query = query.Where("active = ?", true)
+	}
+	var fields []*model.CustomField
+	if err := query.Order("`key`").Find(&fields).Error; err != nil {
+		return nil, fmt.Errorf("list custom fields: %w", err)
+	}
+	return fields, nil
+}
+
+func (r *customFieldRepo) SetValues(ctx context.Context, employeeID uint, values map[uint]string) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return setFieldValues(tx, employeeID, values)
+	})
+}
+
+// setFieldValues is SetValues inside the caller's transaction.
+func setFieldValues(tx *gorm.DB, employeeID uint, values map[uint]string) error {
+	for fieldID, value := range values {
+		if value == "" {
+			err := tx.Where("employee_id = ? AND field_id = ?", employeeID, fieldID).
+				Delete(&model.EmployeeFieldValue{}).Error
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "employee_id"}, {Name: "field_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+		}).Omit(clause.Associations).Create(&model.EmployeeFieldValue{EmployeeID: employeeID, FieldID: fieldID, Value: value}).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
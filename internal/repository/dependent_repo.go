@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+type DependentRepo interface {
+	Create(ctx context.Context, dependent *model.Dependent) error
+	Get(ctx context.Context, id uint) (*model.Dependent, error)
+	Update(ctx context.Context, dependent *model.Dependent) error
+
+	// List returns the employee's dependents ordered by FromMonth.
+	List(ctx context.Context, employeeID uint) ([]*model.Dependent, error)
+
+	// CountValid counts the employee's dependents registered for month.
+	CountValid(ctx context.Context, employeeID uint, month time.Time) (int, error)
+
+	// ListOverlapping returns the dependents of all employees registered for
+	// any month between from and to, ordered by employee.
+	ListOverlapping(ctx context.Context, from, to time.Time) ([]*model.Dependent, error)
+}
+
+type dependentRepo struct {
+	data *data.Data
+}
+
+func NewDependentRepo(data *data.Data) *dependentRepo {
+	return &dependentRepo{data: data}
+}
+
+func (r *dependentRepo) Create(ctx context.Context, dependent *model.Dependent) error {
+	return r.data.DB.WithContext(ctx).Create(dependent).Error
+}
+
+func (r *dependentRepo) Get(ctx context.Context, id uint) (*model.Dependent, error) {
+	var dependent model.Dependent
+	if err := r.data.DB.WithContext(ctx).First(&dependent, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("dependent not found")
+		}
+		return nil, fmt.Errorf("query dependent: %w", err)
+	}
+	return &dependent, nil
+}
+
+func (r *dependentRepo) Update(ctx context.Context, dependent *model.Dependent) error {
+	return r.data.DB.WithContext(ctx).Save(dependent).Error
+}
+
+func (r *dependentRepo) List(ctx context.Context, employeeID uint) ([]*model.Dependent, error) {
+	var dependents []*model.Dependent
+	err := r.data.DB.WithContext(ctx).
+		Where("employee_id = ?", employeeID).
+		Order("from_month, id").
+		Find(&dependents).Error
+	if err != nil {
+		return nil, fmt.Errorf("list dependents: %w", err)
+	}
+	return dependents, nil
+}
+
+func (r *dependentRepo) CountValid(ctx context.Context, employeeID uint, month time.Time) (int, error) {
+	var count int64
+	err := r.data.DB.WithContext(ctx).
+		Model(&model.Dependent{}).
+		Where("employee_id = ? AND from_month <= ? AND (to_month IS NULL OR to_month >= ?)", employeeID, month, month).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("count dependents: %w", err)
+	}
+	return int(count), nil
+}
+
+func (r *dependentRepo) ListOverlapping(ctx context.Context, from, to time.Time) ([]*model.Dependent, error) {
+	var dependents []*model.Dependent
+	err := r.data.DB.WithContext(ctx).
+		Where("from_month <= ? AND (to_month IS NULL OR to_month >= ?)", to, from).
+		Order("employee_id, from_month, id").
+		Find(&dependents).Error
+	if err != nil {
+		return nil, fmt.Errorf("list dependents: %w", err)
+	}
+	return dependents, nil
+}
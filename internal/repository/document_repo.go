@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+type DocumentRepo interface {
+	Create(ctx context.Context, doc *model.Document) error
+	Get(ctx context.Context, id uint) (*model.Document, error)
+	Delete(ctx context.Context, id uint) error
+
+	// List returns the employee's documents, newest first. An empty docType
+	// includes every type.
+	List(ctx context.Context, employeeID uint, docType string) ([]*model.Document, error)
+
+	// ListExpiring returns documents of current employees expiring on or
+	// before until, soonest first.
+	ListExpiring(ctx context.Context, until time.Time) ([]*model.Document, error)
+}
+
+type documentRepo struct {
+	data *data.Data
+}
+
+func NewDocumentRepo(data *data.Data) *documentRepo {
+	return &documentRepo{data: data}
+}
+
+func (r *documentRepo) Create(ctx context.Context, doc *model.Document) error {
+	return r.data.DB.WithContext(ctx).Create(doc).Error
+}
+
+func (r *documentRepo) Get(ctx context.Context, id uint) (*model.Document, error) {
+	var doc model.Document
+	if err := r.data.DB.WithContext(ctx).First(&doc, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("document not found")
+		}
+		return nil, fmt.Errorf("query document: %w", err)
+	}
+	return &doc, nil
+}
+
+func (r *documentRepo) Delete(ctx context.Context, id uint) error {
+	return r.data.DB.WithContext(ctx).Delete(&model.Document{}, id).Error
+}
+
+func (r *documentRepo) List(ctx context.Context, employeeID uint, docType string) ([]*model.Document, error) {
+	query := r.data.DB.WithContext(ctx).Where("employee_id = ?", employeeID)
+	if docType != "" {
+		query = query.Where("type = ?", docType)
+	}
+	var docs []*model.Document
+	if err := query.Order("created_at DESC, id DESC").Find(&docs).Error; err != nil {
+		return nil, fmt.Errorf("list documents: %w", err)
+	}
+	return docs, nil
+}
+
+func (r *documentRepo) ListExpiring(ctx context.Context, until time.Time) ([]*model.Document, error) {
+	var docs []*model.Document
+	err := r.data.DB.WithContext(ctx).
+		Joins("JOIN employees ON employees.id = documents.employee_id AND employees.deleted_at IS NULL").
+		Where("documents.expires_at IS NOT NULL AND documents.expires_at <= ?", until).
+		Where("employees.status <> ?", model.EmployeeTerminated).
+		Order("documents.expires_at, documents.id").
+		Find(&docs).Error
+	if err != nil {
+		return nil, fmt.Errorf("list expiring documents: %w", err)
+	}
+	return docs, nil
+}
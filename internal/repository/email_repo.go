@@ -5,12 +5,16 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io"
+	"time"
 
 	"gopkg.in/gomail.v2"
 )
 
 type EmailRepo interface {
 	SendPayslip(ctx context.Context, toEmail, employeeName, monthYear string, pdfData []byte) error
+	SendInvitation(ctx context.Context, toEmail, employeeName, link string, expiresAt time.Time) error
+	SendEmailVerification(ctx context.Context, toEmail, username, link string, expiresAt time.Time) error
+	SendPasswordReset(ctx context.Context, toEmail, username, link string, expiresAt time.Time) error
 }
 
 type emailRepo struct {
@@ -56,4 +60,74 @@ HR Team
 	}))
 
 	return r.dialer.DialAndSend(m)
-}
\ No newline at end of file
+}
+
+func (r *emailRepo) SendInvitation(ctx context.Context, toEmail, employeeName, link string, expiresAt time.Time) error {
+	m := gomail.NewMessage()
+	m.SetHeader("From", m.FormatAddress(r.from, r.name))
+	m.SetHeader("To", toEmail)
+	m.SetHeader("Subject", "Your HR account invitation")
+
+	body := fmt.Sprintf(`Dear %s,
+
+You have been invited to create your HR account. Open the link below to choose
+a username and password:
+
+%s
+
+The link can be used once and expires on %s.
+
+Best regards,
+HR Team
+`, employeeName, link, expiresAt.Format("2006-01-02 15:04 MST"))
+
+	m.SetBody("text/plain", body)
+	return r.dialer.DialAndSend(m)
+}
+
+func (r *emailRepo) SendEmailVerification(ctx context.Context, toEmail, username, link string, expiresAt time.Time) error {
+	m := gomail.NewMessage()
+	m.SetHeader("From", m.FormatAddress(r.from, r.name))
+	m.SetHeader("To", toEmail)
+	m.SetHeader("Subject", "Confirm your email address")
+
+	body := fmt.Sprintf(`Dear %s,
+
+Please confirm the email address of your HR account by opening the link below:
+
+%s
+
+The link can be used once and expires on %s. If you did not create an
+account, you can ignore this email.
+
+Best regards,
+HR Team
+`, username, link, expiresAt.Format("2006-01-02 15:04 MST"))
+
+	m.SetBody("text/plain", body)
+	return r.dialer.DialAndSend(m)
+}
+
+func (r *emailRepo) SendPasswordReset(ctx context.Context, toEmail, username, link string, expiresAt time.Time) error {
+	m := gomail.NewMessage()
+	m.SetHeader("From", m.FormatAddress(r.from, r.name))
+	m.SetHeader("To", toEmail)
+	m.SetHeader("Subject", "Reset your password")
+
+	body := fmt.Sprintf(`Dear %s,
+
+A password reset was requested for your HR account. Open the link below to
+choose a new password:
+
+%s
+
+The link can be used once and expires on %s. Resetting the password signs you
+out on all devices. If you did not ask for this, you can ignore this email.
+
+Best regards,
+HR Team
+`, username, link, expiresAt.Format("2006-01-02 15:04 MST"))
+
+	m.SetBody("text/plain", body)
+	return r.dialer.DialAndSend(m)
+}
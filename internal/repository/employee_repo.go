@@ -3,25 +3,68 @@ package repository
 import (
 	"context"
 	"errors"
-	"strconv"
+	"fmt"
+	"strings"
+	"time"
 
 	"myapp/internal/data"
 	"myapp/internal/data/model"
+	"myapp/internal/pagination"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// EmployeeFilter narrows EmployeeRepo.List; zero fields match everything.
+type EmployeeFilter struct {
+	Name         string // case-insensitive substring
+	DepartmentID uint
+	PositionID   uint
+	JoinedFrom   *time.Time
+	JoinedTo     *time.Time
+	Status       string
+	SortBy       string // id, name or join_date
+	Descending   bool
+	Custom       map[uint]string // custom field ID -> canonical value
+}
+
+// employeeSortKeys maps the sortable columns to the parser of their page
+// token keys. The empty column sorts by ID. base_salary is encrypted and
+// cannot be ordered by the database.
+var employeeSortKeys = map[string]func(string) (interface{}, error){
+	"":     nil,
+	"name": func(k string) (interface{}, error) { return k, nil },
+	"join_date": func(k string) (interface{}, error) {
+		return time.Parse(time.RFC3339, k)
+	},
+}
+
 type employeeRepo struct {
 	data *data.Data
 }
 
 type EmployeeRepo interface {
-	List(ctx context.Context, pageSize int, pageToken string) ([]*model.Employee, string, error)
+	// List returns a page of employees matching filter, the token of the next
+	// page ("" on the last page) and the number of matches across all pages.
+	List(ctx context.Context, filter EmployeeFilter, page *pagination.Page) ([]*model.Employee, string, int64, error)
 	Get(ctx context.Context, id uint32) (*model.Employee, error)
+
+	// Create inserts the employee and its custom field values in a single
+	// transaction.
 	Create(ctx context.Context, employee *model.Employee) error
-	Update(ctx context.Context, employee *model.Employee) error
+
+	// CreateBatch inserts all employees and their custom field values in a
+	// single transaction.
+	CreateBatch(ctx context.Context, employees []*model.Employee) error
+
+	// Update saves the employee and sets its custom field values by field ID
+	// in a single transaction. An empty value removes the field.
+	Update(ctx context.Context, employee *model.Employee, values map[uint]string) error
+
 	Delete(ctx context.Context, id uint32) error
 	GetEmployeeByID(ctx context.Context, id uint) (*model.Employee, error)
+	ListByIDs(ctx context.Context, ids []uint) ([]*model.Employee, error)
+	ListAll(ctx context.Context) ([]*model.Employee, error)
 }
 
 func NewEmployeeRepo(data *data.Data) *employeeRepo {
@@ -39,29 +82,90 @@ func (r *employeeRepo) GetEmployeeByID(ctx context.Context, id uint) (*model.Emp
 	return &emp, nil
 }
 
-func (r *employeeRepo) List(ctx context.Context, pageSize int, pageToken string) ([]*model.Employee, string, error) {
-	var offset int
-	if pageToken != "" {
-		offset, _ = strconv.Atoi(pageToken) // Simple error handling; improve in production
+func (r *employeeRepo) ListByIDs(ctx context.Context, ids []uint) ([]*model.Employee, error) {
+	var employees []*model.Employee
+	if len(ids) == 0 {
+		return employees, nil
 	}
+	err := r.data.DB.WithContext(ctx).Where("id IN ?", ids).Find(&employees).Error
+	return employees, err
+}
 
+func (r *employeeRepo) ListAll(ctx context.Context) ([]*model.Employee, error) {
 	var employees []*model.Employee
-	err := r.data.DB.WithContext(ctx).Limit(pageSize).Offset(offset).Find(&employees).Error
-	if err != nil {
-		return nil, "", err
+	err := r.data.DB.WithContext(ctx).Order("id").Find(&employees).Error
+	return employees, err
+}
+
+func (r *employeeRepo) List(ctx context.Context, filter EmployeeFilter, page *pagination.Page) ([]*model.Employee, string, int64, error) {
+	column := filter.SortBy
+	if column == "id" {
+		column = ""
+	}
+	parseKey, ok := employeeSortKeys[column]
+	if !ok {
+		return nil, "", 0, fmt.Errorf("unsupported sort field %q", filter.SortBy)
+	}
+
+	query := r.data.DB.WithContext(ctx).Model(&model.Employee{})
+	if filter.Name != "" {
+		query = query.Where("LOWER(name) LIKE ?", "%"+escapeLike(strings.ToLower(filter.Name))+"%")
+	}
+	if filter.DepartmentID != 0 {
+		query = query.Where("department_id = ?", filter.DepartmentID)
+	}
+	if filter.PositionID != 0 {
+		query = query.Where("position_id = ?", filter.PositionID)
+	}
+	if filter.JoinedFrom != nil {
+		query = query.Where("join_date >= ?", *filter.JoinedFrom)
+	}
+	if filter.JoinedTo != nil {
+		query = query.Where("join_date <= ?", *filter.JoinedTo)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	for fieldID, value := range filter.Custom {
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM employee_field_values v WHERE v.employee_id = employees.id AND v.field_id = ? AND v.value = ?)",
+			fieldID, value,
+		)
 	}
 
-	nextToken := ""
-	if len(employees) == pageSize {
-		nextToken = strconv.Itoa(offset + pageSize)
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, "", 0, fmt.Errorf("count employees: %w", err)
+	}
+
+	query, err := page.Apply(query, column, filter.Descending, parseKey)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	var employees []*model.Employee
+	if err := query.Preload("FieldValues.Field").Find(&employees).Error; err != nil {
+		return nil, "", 0, fmt.Errorf("list employees: %w", err)
 	}
 
-	return employees, nextToken, nil
+	employees, nextToken := pagination.Next(page, employees, func(e *model.Employee) (string, uint) {
+		switch column {
+		case "name":
+			return e.Name, e.ID
+		case "join_date":
+			return e.JoinDate.Format(time.RFC3339), e.ID
+		}
+		return "", e.ID
+	})
+	return employees, nextToken, total, nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
 }
 
 func (r *employeeRepo) Get(ctx context.Context, id uint32) (*model.Employee, error) {
 	var employee model.Employee
-	err := r.data.DB.WithContext(ctx).First(&employee, id).Error
+	err := r.data.DB.WithContext(ctx).Preload("FieldValues.Field").First(&employee, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -69,11 +173,36 @@ func (r *employeeRepo) Get(ctx context.Context, id uint32) (*model.Employee, err
 }
 
 func (r *employeeRepo) Create(ctx context.Context, employee *model.Employee) error {
-	return r.data.DB.WithContext(ctx).Create(employee).Error
+	return r.CreateBatch(ctx, []*model.Employee{employee})
 }
 
-func (r *employeeRepo) Update(ctx context.Context, employee *model.Employee) error {
-	return r.data.DB.WithContext(ctx).Save(employee).Error
+func (r *employeeRepo) CreateBatch(ctx context.Context, employees []*model.Employee) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).CreateInBatches(employees, 200).Error; err != nil {
+			return err
+		}
+		var values []*model.EmployeeFieldValue
+		for _, e := range employees {
+			for i := range e.FieldValues {
+				v := &e.FieldValues[i]
+				v.EmployeeID = e.ID
+				values = append(values, v)
+			}
+		}
+		if len(values) == 0 {
+			return nil
+		}
+		return tx.Omit(clause.Associations).CreateInBatches(values, 200).Error
+	})
+}
+
+func (r *employeeRepo) Update(ctx context.Context, employee *model.Employee, values map[uint]string) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(employee).Error; err != nil {
+			return err
+		}
+		return setFieldValues(tx, employee.ID, values)
+	})
 }
 
 func (r *employeeRepo) Delete(ctx context.Context, id uint32) error {
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+var ErrExternalIdentityNotFound = errors.New("external identity not found")
+
+type ExternalIdentityRepo interface {
+	Get(ctx context.Context, issuer, subject string) (*model.ExternalIdentity, error)
+	Create(ctx context.Context, identity *model.ExternalIdentity) error
+
+	// Touch records a login through the identity and the email it came with.
+	Touch(ctx context.Context, id uint, email string, at time.Time) error
+}
+
+type externalIdentityRepo struct {
+	data *data.Data
+}
+
+func NewExternalIdentityRepo(data *data.Data) ExternalIdentityRepo {
+	return &externalIdentityRepo{data: data}
+}
+
+func (r *externalIdentityRepo) Get(ctx context.Context, issuer, subject string) (*model.ExternalIdentity, error) {
+	var identity model.ExternalIdentity
+	err := r.data.DB.WithContext(ctx).Where("issuer = ? AND subject = ?", issuer, subject).First(&identity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrExternalIdentityNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *externalIdentityRepo) Create(ctx context.Context, identity *model.ExternalIdentity) error {
+	return r.data.DB.WithContext(ctx).Create(identity).Error
+}
+
+func (r *externalIdentityRepo) Touch(ctx context.Context, id uint, email string, at time.Time) error {
+	return r.data.DB.WithContext(ctx).Model(&model.ExternalIdentity{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"email": email, "last_login_at": at}).Error
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+type InvitationRepo interface {
+	Create(ctx context.Context, invitation *model.Invitation) error
+	GetByTokenHash(ctx context.Context, hash string) (*model.Invitation, error)
+
+	// Accept creates the user and marks the invitation accepted in one
+	// transaction.
+	Accept(ctx context.Context, invitation *model.Invitation, user *model.User) error
+}
+
+type invitationRepo struct {
+	data *data.Data
+}
+
+func NewInvitationRepo(data *data.Data) *invitationRepo {
+	return &invitationRepo{data: data}
+}
+
+func (r *invitationRepo) Create(ctx context.Context, invitation *model.Invitation) error {
+	return r.data.DB.WithContext(ctx).Create(invitation).Error
+}
+
+func (r *invitationRepo) GetByTokenHash(ctx context.Context, hash string) (*model.Invitation, error) {
+	var invitation model.Invitation
+	if err := r.data.DB.WithContext(ctx).Where("token_hash = ?", hash).First(&invitation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("invitation not found")
+		}
+		return nil, fmt.Errorf("query invitation: %w", err)
+	}
+	return &invitation, nil
+}
+
+func (r *invitationRepo) Accept(ctx context.Context, invitation *model.Invitation, user *model.User) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		invitation.UserID = &user.ID
+		// Guard on accepted_at so a token cannot be redeemed twice
+		// concurrently.
+		result := tx.Model(invitation).Where("accepted_at IS NULL").
+			Updates(map[string]interface{}{"accepted_at": invitation.AcceptedAt, "user_id": user.ID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("invitation already accepted")
+		}
+		return nil
+	})
+}
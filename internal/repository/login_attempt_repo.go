@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// LoginAttemptRepo counts failed logins in Redis. Counters are keyed by the
+// username as typed, whether or not an account uses it, so locking behaves
+// the same for unknown usernames.
+type LoginAttemptRepo interface {
+	// AddUserFailure and AddIPFailure count a failed login and return the
+	// failures within window, which starts at the first one.
+	AddUserFailure(ctx context.Context, username string, window time.Duration) (int64, error)
+	AddIPFailure(ctx context.Context, ip string, window time.Duration) (int64, error)
+	IPFailures(ctx context.Context, ip string) (int64, error)
+	ClearUserFailures(ctx context.Context, username string) error
+
+	// LockedFor returns how long the username stays locked, zero if it
+	// isn't.
+	LockedFor(ctx context.Context, username string) (time.Duration, error)
+
+	// AddLockout counts a lockout of the username and returns the lockouts
+	// within memory, this one included.
+	AddLockout(ctx context.Context, username string, memory time.Duration) (int64, error)
+
+	// Lock locks the username for d and clears its failures.
+	Lock(ctx context.Context, username string, d time.Duration) error
+
+	// Unlock lifts the lock and forgets failures and earlier lockouts.
+	Unlock(ctx context.Context, username string) error
+}
+
+type loginAttemptRepo struct {
+	redis *RedisRepo
+}
+
+func NewLoginAttemptRepo(redis *RedisRepo) LoginAttemptRepo {
+	return &loginAttemptRepo{redis: redis}
+}
+
+func loginUserKey(prefix, username string) string {
+	return prefix + ":" + strings.ToLower(username)
+}
+
+func (r *loginAttemptRepo) AddUserFailure(ctx context.Context, username string, window time.Duration) (int64, error) {
+	return r.redis.Incr(ctx, loginUserKey("login_fail:user", username), window)
+}
+
+func (r *loginAttemptRepo) AddIPFailure(ctx context.Context, ip string, window time.Duration) (int64, error) {
+	return r.redis.Incr(ctx, "login_fail:ip:"+ip, window)
+}
+
+func (r *loginAttemptRepo) IPFailures(ctx context.Context, ip string) (int64, error) {
+	raw, err := r.redis.Get(ctx, "login_fail:ip:"+ip)
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(raw, 10, 64)
+}
+
+func (r *loginAttemptRepo) ClearUserFailures(ctx context.Context, username string) error {
+	return r.redis.Del(ctx, loginUserKey("login_fail:user", username))
+}
+
+func (r *loginAttemptRepo) LockedFor(ctx context.Context, username string) (time.Duration, error) {
+	ttl, err := r.redis.TTL(ctx, loginUserKey("login_lock", username))
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (r *loginAttemptRepo) AddLockout(ctx context.Context, username string, memory time.Duration) (int64, error) {
+	key := loginUserKey("login_lockouts", username)
+	n, err := r.redis.Incr(ctx, key, memory)
+	if err != nil {
+		return 0, err
+	}
+	// Each lockout restarts the memory, so repeated lockouts keep escalating.
+	return n, r.redis.Expire(ctx, key, memory)
+}
+
+func (r *loginAttemptRepo) Lock(ctx context.Context, username string, d time.Duration) error {
+	if err := r.redis.Set(ctx, loginUserKey("login_lock", username), "1", d); err != nil {
+		return err
+	}
+	return r.ClearUserFailures(ctx, username)
+}
+
+func (r *loginAttemptRepo) Unlock(ctx context.Context, username string) error {
+	for _, prefix := range []string{"login_lock", "login_lockouts", "login_fail:user"} {
+		if err := r.redis.Del(ctx, loginUserKey(prefix, username)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrOIDCStateNotFound = errors.New("login state not found or expired")
+
+// OIDCState is what a pending external login needs to finish: the nonce the
+// ID token must carry and the PKCE verifier of the code.
+type OIDCState struct {
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// OIDCStateRepo keeps pending external logins under oidc_state:<hash>,
+// where hash is the SHA-256 of the state parameter.
+type OIDCStateRepo interface {
+	Save(ctx context.Context, hash string, state *OIDCState, ttl time.Duration) error
+
+	// Consume returns the state and deletes it, so a login finishes once.
+	Consume(ctx context.Context, hash string) (*OIDCState, error)
+}
+
+type oidcStateRepo struct {
+	redis *RedisRepo
+}
+
+func NewOIDCStateRepo(redis *RedisRepo) OIDCStateRepo {
+	return &oidcStateRepo{redis: redis}
+}
+
+func (r *oidcStateRepo) Save(ctx context.Context, hash string, state *OIDCState, ttl time.Duration) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return r.redis.Set(ctx, "oidc_state:"+hash, string(raw), ttl)
+}
+
+func (r *oidcStateRepo) Consume(ctx context.Context, hash string) (*OIDCState, error) {
+	raw, err := r.redis.GetDel(ctx, "oidc_state:"+hash)
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrOIDCStateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state OIDCState
+	if err := json.Unmarshal([]byte(raw), &state); err != nil {
+		return nil, ErrOIDCStateNotFound
+	}
+	return &state, nil
+}
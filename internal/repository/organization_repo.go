@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+type OrganizationRepo interface {
+	CreateDepartment(ctx context.Context, department *model.Department) error
+	GetDepartment(ctx context.Context, id uint) (*model.Department, error)
+	ListDepartments(ctx context.Context) ([]*model.Department, error)
+
+	// Headcount returns the number of employees per department.
+	Headcount(ctx context.Context) (map[uint]int, error)
+
+	CreatePosition(ctx context.Context, position *model.Position) error
+	GetPosition(ctx context.Context, id uint) (*model.Position, error)
+	ListPositions(ctx context.Context, departmentID uint) ([]*model.Position, error)
+
+	// CreateAssignment stores a new assignment and closes the employee's
+	// current one the day before it takes effect, in one transaction.
+	CreateAssignment(ctx context.Context, assignment *model.EmployeeAssignment) error
+
+	// ListAssignments returns the employee's assignment history ordered by
+	// EffectiveFrom.
+	ListAssignments(ctx context.Context, employeeID uint) ([]*model.EmployeeAssignment, error)
+
+	// ListPendingAssignments returns the assignments not yet applied, ordered
+	// by EffectiveFrom.
+	ListPendingAssignments(ctx context.Context) ([]*model.EmployeeAssignment, error)
+
+	// ApplyDueAssignments copies assignments effective on or before asOf onto
+	// their employees. Fields an assignment leaves nil keep their value.
+	ApplyDueAssignments(ctx context.Context, asOf time.Time) error
+
+	// ListReports returns the employees whose manager is one of managerIDs.
+	ListReports(ctx context.Context, managerIDs []uint) ([]*model.Employee, error)
+}
+
+type organizationRepo struct {
+	data *data.Data
+}
+
+func NewOrganizationRepo(data *data.Data) *organizationRepo {
+	return &organizationRepo{data: data}
+}
+
+func (r *organizationRepo) CreateDepartment(ctx context.Context, department *model.Department) error {
+	return r.data.DB.WithContext(ctx).Create(department).Error
+}
+
+func (r *organizationRepo) GetDepartment(ctx context.Context, id uint) (*model.Department, error) {
+	var department model.Department
+	if err := r.data.DB.WithContext(ctx).First(&department, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("department not found")
+		}
+		return nil, fmt.Errorf("query department: %w", err)
+	}
+	return &department, nil
+}
+
+func (r *organizationRepo) ListDepartments(ctx context.Context) ([]*model.Department, error) {
+	var departments []*model.Department
+	if err := r.data.DB.WithContext(ctx).Order("name").Find(&departments).Error; err != nil {
+		return nil, fmt.Errorf("list departments: %w", err)
+	}
+	return departments, nil
+}
+
+func (r *organizationRepo) Headcount(ctx context.Context) (map[uint]int, error) {
+	var rows []struct {
+		DepartmentID uint
+		Total        int
+	}
+	err := r.data.DB.WithContext(ctx).
+		Model(&model.Employee{}).
+		Select("department_id, COUNT(*) AS total").
+		Where("department_id IS NOT NULL").
+		Group("department_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("count employees per department: %w", err)
+	}
+	counts := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		counts[row.DepartmentID] = row.Total
+	}
+	return counts, nil
+}
+
+func (r *organizationRepo) CreatePosition(ctx context.Context, position *model.Position) error {
+	return r.data.DB.WithContext(ctx).Create(position).Error
+}
+
+func (r *organizationRepo) GetPosition(ctx context.Context, id uint) (*model.Position, error) {
+	var position model.Position
+	if err := r.data.DB.WithContext(ctx).First(&position, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("position not found")
+		}
+		return nil, fmt.Errorf("query position: %w", err)
+	}
+	return &position, nil
+}
+
+func (r *organizationRepo) ListPositions(ctx context.Context, departmentID uint) ([]*model.Position, error) {
+	query := r.data.DB.WithContext(ctx)
+	if departmentID != 0 {
+		query = query.Where("department_id = ? OR department_id IS NULL", departmentID)
+	}
+	var positions []*model.Position
+	if err := query.Order("level DESC, title").Find(&positions).Error; err != nil {
+		return nil, fmt.Errorf("list positions: %w", err)
+	}
+	return positions, nil
+}
+
+func (r *organizationRepo) CreateAssignment(ctx context.Context, assignment *model.EmployeeAssignment) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.EmployeeAssignment{}).
+			Where("employee_id = ? AND effective_to IS NULL", assignment.EmployeeID).
+			Update("effective_to", assignment.EffectiveFrom.AddDate(0, 0, -1)).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(assignment).Error
+	})
+}
+
+func (r *organizationRepo) ListAssignments(ctx context.Context, employeeID uint) ([]*model.EmployeeAssignment, error) {
+	var assignments []*model.EmployeeAssignment
+	err := r.data.DB.WithContext(ctx).
+		Where("employee_id = ?", employeeID).
+		Order("effective_from, id").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, fmt.Errorf("list employee assignments: %w", err)
+	}
+	return assignments, nil
+}
+
+func (r *organizationRepo) ListPendingAssignments(ctx context.Context) ([]*model.EmployeeAssignment, error) {
+	var assignments []*model.EmployeeAssignment
+	err := r.data.DB.WithContext(ctx).
+		Where("applied = ?", false).
+		Order("effective_from, id").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, fmt.Errorf("list pending assignments: %w", err)
+	}
+	return assignments, nil
+}
+
+func (r *organizationRepo) ApplyDueAssignments(ctx context.Context, asOf time.Time) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var due []*model.EmployeeAssignment
+		err := tx.Where("applied = ? AND effective_from <= ?", false, asOf).
+			Order("effective_from, id").
+			Find(&due).Error
+		if err != nil {
+			return err
+		}
+		for _, a := range due {
+			updates := map[string]interface{}{}
+			if a.DepartmentID != nil {
+				updates["department_id"] = *a.DepartmentID
+			}
+			if a.ManagerID != nil {
+				updates["manager_id"] = *a.ManagerID
+			}
+			if a.PositionID != nil {
+				var position model.Position
+				if err := tx.First(&position, *a.PositionID).Error; err != nil {
+					return err
+				}
+				updates["position_id"] = *a.PositionID
+				updates["position"] = position.Title
+			}
+			if len(updates) > 0 {
+				if err := tx.Model(&model.Employee{}).Where("id = ?", a.EmployeeID).Updates(updates).Error; err != nil {
+					return err
+				}
+			}
+			if err := tx.Model(a).Update("applied", true).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *organizationRepo) ListReports(ctx context.Context, managerIDs []uint) ([]*model.Employee, error) {
+	var employees []*model.Employee
+	if len(managerIDs) == 0 {
+		return employees, nil
+	}
+	err := r.data.DB.WithContext(ctx).
+		Where("manager_id IN ?", managerIDs).
+		Order("id").
+		Find(&employees).Error
+	if err != nil {
+		return nil, fmt.Errorf("list reports: %w", err)
+	}
+	return employees, nil
+}
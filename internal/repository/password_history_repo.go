@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+type PasswordHistoryRepo interface {
+	// Add records a password hash and keeps only the user's latest keep
+	// entries.
+	Add(ctx context.Context, userID uint, hash string, keep int) error
+
+	// Recent returns the user's latest n password hashes, newest first.
+	Recent(ctx context.Context, userID uint, n int) ([]string, error)
+}
+
+type passwordHistoryRepo struct {
+	data *data.Data
+}
+
+func NewPasswordHistoryRepo(data *data.Data) PasswordHistoryRepo {
+	return &passwordHistoryRepo{data: data}
+}
+
+func (r *passwordHistoryRepo) Add(ctx context.Context, userID uint, hash string, keep int) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model.PasswordHistory{UserID: userID, Hash: hash}).Error; err != nil {
+			return err
+		}
+		var ids []uint
+		err := tx.Model(&model.PasswordHistory{}).Where("user_id = ?", userID).
+			Order("id DESC").Pluck("id", &ids).Error
+		if err != nil || len(ids) <= keep {
+			return err
+		}
+		return tx.Delete(&model.PasswordHistory{}, ids[keep:]).Error
+	})
+}
+
+func (r *passwordHistoryRepo) Recent(ctx context.Context, userID uint, n int) ([]string, error) {
+	var hashes []string
+	err := r.data.DB.WithContext(ctx).Model(&model.PasswordHistory{}).Where("user_id = ?", userID).
+		Order("id DESC").Limit(n).Pluck("hash", &hashes).Error
+	return hashes, err
+}
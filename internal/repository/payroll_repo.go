@@ -6,19 +6,33 @@ import (
 	"fmt"
 	"myapp/internal/data"
 	"myapp/internal/data/model"
+	"myapp/internal/pagination"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+// PayrollFilter narrows PayrollRepo.List; zero fields match everything.
+type PayrollFilter struct {
+	EmployeeID uint
+	MonthYear  *time.Time // first day of the month
+}
+
 type PayrollRepo interface {
 	SavePayroll(ctx context.Context, p *model.Payroll) error
 
+	// List returns a page of payrolls matching filter, latest month first, and
+	// the token of the next page ("" on the last page).
+	List(ctx context.Context, filter PayrollFilter, page *pagination.Page) ([]*model.Payroll, string, error)
+
 	GetPayrollByEmployeeAndMonth(
 		ctx context.Context,
 		employeeID uint,
 		monthYear time.Time,
 	) (*model.Payroll, error)
+
+	// ListByMonth returns every payroll of the month ordered by employee.
+	ListByMonth(ctx context.Context, monthYear time.Time) ([]*model.Payroll, error)
 }
 
 type payrollRepo struct {
@@ -50,3 +64,40 @@ func (r *payrollRepo) GetPayrollByEmployeeAndMonth(
 	}
 	return &payroll, nil
 }
+
+func (r *payrollRepo) ListByMonth(ctx context.Context, monthYear time.Time) ([]*model.Payroll, error) {
+	var payrolls []*model.Payroll
+	err := r.data.DB.WithContext(ctx).
+		Where("month_year = ?", monthYear).
+		Order("employee_id").
+		Find(&payrolls).Error
+	if err != nil {
+		return nil, fmt.Errorf("list payrolls: %w", err)
+	}
+	return payrolls, nil
+}
+
+func (r *payrollRepo) List(ctx context.Context, filter PayrollFilter, page *pagination.Page) ([]*model.Payroll, string, error) {
+	query := r.data.DB.WithContext(ctx).Model(&model.Payroll{})
+	if filter.EmployeeID != 0 {
+		query = query.Where("employee_id = ?", filter.EmployeeID)
+	}
+	if filter.MonthYear != nil {
+		query = query.Where("month_year = ?", *filter.MonthYear)
+	}
+
+	query, err := page.Apply(query, "month_year", true, func(k string) (interface{}, error) {
+		return time.Parse("2006-01-02", k)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	var payrolls []*model.Payroll
+	if err := query.Find(&payrolls).Error; err != nil {
+		return nil, "", fmt.Errorf("list payrolls: %w", err)
+	}
+	payrolls, nextToken := pagination.Next(page, payrolls, func(p *model.Payroll) (string, uint) {
+		return p.MonthYear.Format("2006-01-02"), p.ID
+	})
+	return payrolls, nextToken, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+const reencryptBatchSize = 200
+
+type PIIRepo interface {
+	// Reencrypt rewrites every encrypted column with the active key and
+	// returns the number of employee and dependent rows rewritten.
+	Reencrypt(ctx context.Context) (employees, dependents int, err error)
+}
+
+type piiRepo struct {
+	data *data.Data
+}
+
+func NewPIIRepo(data *data.Data) *piiRepo {
+	return &piiRepo{data: data}
+}
+
+func (r *piiRepo) Reencrypt(ctx context.Context) (int, int, error) {
+	db := r.data.DB.WithContext(ctx)
+
+	employees := 0
+	var employeeBatch []*model.Employee
+	err := db.FindInBatches(&employeeBatch, reencryptBatchSize, func(tx *gorm.DB, batch int) error {
+		for _, e := range employeeBatch {
+			if err := db.Model(e).Select("base_salary", "bank_account").UpdateColumns(e).Error; err != nil {
+				return err
+			}
+			employees++
+		}
+		return nil
+	}).Error
+	if err != nil {
+		return employees, 0, fmt.Errorf("re-encrypt employees: %w", err)
+	}
+
+	dependents := 0
+	var dependentBatch []*model.Dependent
+	err = db.FindInBatches(&dependentBatch, reencryptBatchSize, func(tx *gorm.DB, batch int) error {
+		for _, d := range dependentBatch {
+			if err := db.Model(d).Select("tax_id").UpdateColumns(d).Error; err != nil {
+				return err
+			}
+			dependents++
+		}
+		return nil
+	}).Error
+	if err != nil {
+		return employees, dependents, fmt.Errorf("re-encrypt dependents: %w", err)
+	}
+	return employees, dependents, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+type RecoveryCodeRepo interface {
+	// Replace drops the user's codes and stores new ones.
+	Replace(ctx context.Context, userID uint, hashes []string) error
+
+	// Use marks the unused code with hash as used and reports whether there
+	// was one.
+	Use(ctx context.Context, userID uint, hash string) (bool, error)
+
+	CountUnused(ctx context.Context, userID uint) (int64, error)
+	DeleteByUser(ctx context.Context, userID uint) error
+}
+
+type recoveryCodeRepo struct {
+	data *data.Data
+}
+
+func NewRecoveryCodeRepo(data *data.Data) RecoveryCodeRepo {
+	return &recoveryCodeRepo{data: data}
+}
+
+func (r *recoveryCodeRepo) Replace(ctx context.Context, userID uint, hashes []string) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		codes := make([]*model.RecoveryCode, len(hashes))
+		for i, h := range hashes {
+			codes[i] = &model.RecoveryCode{UserID: userID, CodeHash: h}
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+func (r *recoveryCodeRepo) Use(ctx context.Context, userID uint, hash string) (bool, error) {
+	result := r.data.DB.WithContext(ctx).Model(&model.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, hash).
+		Update("used_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *recoveryCodeRepo) CountUnused(ctx context.Context, userID uint) (int64, error) {
+	var n int64
+	err := r.data.DB.WithContext(ctx).Model(&model.RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).Count(&n).Error
+	return n, err
+}
+
+func (r *recoveryCodeRepo) DeleteByUser(ctx context.Context, userID uint) error {
+	return r.data.DB.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error
+}
@@ -25,4 +25,58 @@ func (r *RedisRepo) Get(ctx context.Context, key string) (string, error) {
 
 func (r *RedisRepo) Del(ctx context.Context, key string) error {
 	return r.client.Del(ctx, key).Err()
-}
\ No newline at end of file
+}
+
+// SetNX sets key only if it does not exist yet and reports whether it did.
+func (r *RedisRepo) SetNX(ctx context.Context, key string, value string, exp time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, key, value, exp).Result()
+}
+
+func (r *RedisRepo) SAdd(ctx context.Context, key string, members ...string) error {
+	args := make([]interface{}, len(members))
+	for i, m := range members {
+		args[i] = m
+	}
+	return r.client.SAdd(ctx, key, args...).Err()
+}
+
+func (r *RedisRepo) SRem(ctx context.Context, key string, members ...string) error {
+	args := make([]interface{}, len(members))
+	for i, m := range members {
+		args[i] = m
+	}
+	return r.client.SRem(ctx, key, args...).Err()
+}
+
+func (r *RedisRepo) SMembers(ctx context.Context, key string) ([]string, error) {
+	return r.client.SMembers(ctx, key).Result()
+}
+
+func (r *RedisRepo) Expire(ctx context.Context, key string, exp time.Duration) error {
+	return r.client.Expire(ctx, key, exp).Err()
+}
+
+// GetDel returns the value of key and deletes it in one step.
+func (r *RedisRepo) GetDel(ctx context.Context, key string) (string, error) {
+	return r.client.GetDel(ctx, key).Result()
+}
+
+// Incr increments the counter at key, starting its expiry on the first hit.
+func (r *RedisRepo) Incr(ctx context.Context, key string, exp time.Duration) (int64, error) {
+	n, err := r.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if n == 1 {
+		if err := r.client.Expire(ctx, key, exp).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+// TTL returns the remaining lifetime of key, negative if it has none or does
+// not exist.
+func (r *RedisRepo) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return r.client.TTL(ctx, key).Result()
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+type ScheduleRepo interface {
+	CreateShift(ctx context.Context, shift *model.Shift) error
+	GetShift(ctx context.Context, id uint) (*model.Shift, error)
+	ListShifts(ctx context.Context) ([]*model.Shift, error)
+
+	CreateSchedule(ctx context.Context, schedule *model.WorkSchedule) error
+	GetSchedule(ctx context.Context, id uint) (*model.WorkSchedule, error)
+	ListSchedules(ctx context.Context) ([]*model.WorkSchedule, error)
+
+	// SaveAssignments updates the changed assignments and creates the new
+	// ones in one transaction.
+	SaveAssignments(ctx context.Context, updated, created []*model.ScheduleAssignment) error
+
+	// ListAssignments returns the employee's assignments overlapping [from, to],
+	// ordered by EffectiveFrom.
+	ListAssignments(
+		ctx context.Context,
+		employeeID uint,
+		from, to time.Time,
+	) ([]*model.ScheduleAssignment, error)
+}
+
+type scheduleRepo struct {
+	data *data.Data
+}
+
+func NewScheduleRepo(data *data.Data) *scheduleRepo {
+	return &scheduleRepo{data: data}
+}
+
+func (r *scheduleRepo) CreateShift(ctx context.Context, shift *model.Shift) error {
+	return r.data.DB.WithContext(ctx).Create(shift).Error
+}
+
+func (r *scheduleRepo) GetShift(ctx context.Context, id uint) (*model.Shift, error) {
+	var shift model.Shift
+	if err := r.data.DB.WithContext(ctx).First(&shift, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("shift not found")
+		}
+		return nil, fmt.Errorf("query shift: %w", err)
+	}
+	return &shift, nil
+}
+
+func (r *scheduleRepo) ListShifts(ctx context.Context) ([]*model.Shift, error) {
+	var shifts []*model.Shift
+	err := r.data.DB.WithContext(ctx).Order("id").Find(&shifts).Error
+	return shifts, err
+}
+
+func (r *scheduleRepo) CreateSchedule(ctx context.Context, schedule *model.WorkSchedule) error {
+	return r.data.DB.WithContext(ctx).Create(schedule).Error
+}
+
+func (r *scheduleRepo) GetSchedule(ctx context.Context, id uint) (*model.WorkSchedule, error) {
+	var schedule model.WorkSchedule
+	if err := r.data.DB.WithContext(ctx).First(&schedule, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("schedule not found")
+		}
+		return nil, fmt.Errorf("query schedule: %w", err)
+	}
+	return &schedule, nil
+}
+
+func (r *scheduleRepo) ListSchedules(ctx context.Context) ([]*model.WorkSchedule, error) {
+	var schedules []*model.WorkSchedule
+	err := r.data.DB.WithContext(ctx).Order("id").Find(&schedules).Error
+	return schedules, err
+}
+
+func (r *scheduleRepo) SaveAssignments(ctx context.Context, updated, created []*model.ScheduleAssignment) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, a := range updated {
+			if err := tx.Save(a).Error; err != nil {
+				return fmt.Errorf("update schedule assignment: %w", err)
+			}
+		}
+		for _, a := range created {
+			if err := tx.Create(a).Error; err != nil {
+				return fmt.Errorf("create schedule assignment: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *scheduleRepo) ListAssignments(
+	ctx context.Context,
+	employeeID uint,
+	from, to time.Time,
+) ([]*model.ScheduleAssignment, error) {
+	var assignments []*model.ScheduleAssignment
+	err := r.data.DB.WithContext(ctx).
+		Where("employee_id = ? AND effective_from <= ?", employeeID, to).
+		Where("effective_to IS NULL OR effective_to >= ?", from).
+		Order("effective_from").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, fmt.Errorf("query schedule assignments: %w", err)
+	}
+	return assignments, nil
+}
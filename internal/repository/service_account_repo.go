@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	ErrAPIKeyNotFound         = errors.New("api key not found")
+)
+
+type ServiceAccountRepo interface {
+	Create(ctx context.Context, account *model.ServiceAccount) error
+	Get(ctx context.Context, id uint) (*model.ServiceAccount, error)
+
+	// List returns every service account with its keys.
+	List(ctx context.Context) ([]*model.ServiceAccount, error)
+	Disable(ctx context.Context, id uint, at time.Time) error
+
+	CreateKey(ctx context.Context, key *model.APIKey) error
+
+	// GetKeyByPrefix returns the key with its service account.
+	GetKeyByPrefix(ctx context.Context, prefix string) (*model.APIKey, error)
+	GetKey(ctx context.Context, id uint) (*model.APIKey, error)
+	RevokeKey(ctx context.Context, id uint, at time.Time) error
+
+	// TouchKey records that the key was used.
+	TouchKey(ctx context.Context, id uint, at time.Time, ip string) error
+}
+
+type serviceAccountRepo struct {
+	data *data.Data
+}
+
+func NewServiceAccountRepo(data *data.Data) ServiceAccountRepo {
+	return &serviceAccountRepo{data: data}
+}
+
+func (r *serviceAccountRepo) Create(ctx context.Context, account *model.ServiceAccount) error {
+	return r.data.DB.WithContext(ctx).Create(account).Error
+}
+
+func (r *serviceAccountRepo) Get(ctx context.Context, id uint) (*model.ServiceAccount, error) {
+	var account model.ServiceAccount
+	if err := r.data.DB.WithContext(ctx).Preload("Keys").First(&account, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrServiceAccountNotFound
+		}
+		return nil, fmt.Errorf("query service account: %w", err)
+	}
+	return &account, nil
+}
+
+func (r *serviceAccountRepo) List(ctx context.Context) ([]*model.ServiceAccount, error) {
+	var accounts []*model.ServiceAccount
+	if err := r.data.DB.WithContext(ctx).Preload("Keys").Order("name").Find(&accounts).Error; err != nil {
+		return nil, fmt.Errorf("list service accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+func (r *serviceAccountRepo) Disable(ctx context.Context, id uint, at time.Time) error {
+	return r.data.DB.WithContext(ctx).Model(&model.ServiceAccount{}).
+		Where("id = ? AND disabled_at IS NULL", id).Update("disabled_at", at).Error
+}
+
+func (r *serviceAccountRepo) CreateKey(ctx context.Context, key *model.APIKey) error {
+	return r.data.DB.WithContext(ctx).Omit("ServiceAccount").Create(key).Error
+}
+
+func (r *serviceAccountRepo) GetKeyByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	var key model.APIKey
+	if err := r.data.DB.WithContext(ctx).Preload("ServiceAccount").Where("prefix = ?", prefix).First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("query api key: %w", err)
+	}
+	return &key, nil
+}
+
+func (r *serviceAccountRepo) GetKey(ctx context.Context, id uint) (*model.APIKey, error) {
+	var key model.APIKey
+	if err := r.data.DB.WithContext(ctx).First(&key, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("query api key: %w", err)
+	}
+	return &key, nil
+}
+
+func (r *serviceAccountRepo) RevokeKey(ctx context.Context, id uint, at time.Time) error {
+	return r.data.DB.WithContext(ctx).Model(&model.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).Update("revoked_at", at).Error
+}
+
+func (r *serviceAccountRepo) TouchKey(ctx context.Context, id uint, at time.Time, ip string) error {
+	return r.data.DB.WithContext(ctx).Model(&model.APIKey{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"last_used_at": at, "last_used_ip": ip}).Error
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"myapp/internal/data/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionRepo keeps sessions in Redis under session:<id>, indexed per user
+// in the set sessions:<user id>. Both expire with the session.
+type SessionRepo interface {
+	Save(ctx context.Context, session *model.Session) error
+	Get(ctx context.Context, id string) (*model.Session, error)
+	Delete(ctx context.Context, session *model.Session) error
+
+	// ListByUser returns the user's live sessions, most recently used
+	// first.
+	ListByUser(ctx context.Context, userID uint) ([]*model.Session, error)
+	DeleteByUser(ctx context.Context, userID uint) error
+
+	// ClaimRefresh records that the refresh token with hash was spent and
+	// reports false if it had been spent before.
+	ClaimRefresh(ctx context.Context, hash string, ttl time.Duration) (bool, error)
+
+	// RefreshSpent reports whether the refresh token with hash was spent.
+	RefreshSpent(ctx context.Context, hash string) (bool, error)
+}
+
+type sessionRepo struct {
+	redis *RedisRepo
+}
+
+func NewSessionRepo(redis *RedisRepo) *sessionRepo {
+	return &sessionRepo{redis: redis}
+}
+
+func sessionKey(id string) string {
+	return "session:" + id
+}
+
+func userSessionsKey(userID uint) string {
+	return "sessions:" + strconv.FormatUint(uint64(userID), 10)
+}
+
+func (r *sessionRepo) Save(ctx context.Context, session *model.Session) error {
+	ttl := time.Until(session.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("session already expired")
+	}
+	raw, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	if err := r.redis.Set(ctx, sessionKey(session.ID), string(raw), ttl); err != nil {
+		return fmt.Errorf("save session: %w", err)
+	}
+	key := userSessionsKey(session.UserID)
+	if err := r.redis.SAdd(ctx, key, session.ID); err != nil {
+		return fmt.Errorf("index session: %w", err)
+	}
+	// Sessions are saved with the full refresh lifetime, so the one saved
+	// last expires last and the index can share its TTL.
+	return r.redis.Expire(ctx, key, ttl)
+}
+
+func (r *sessionRepo) Get(ctx context.Context, id string) (*model.Session, error) {
+	raw, err := r.redis.Get(ctx, sessionKey(id))
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query session: %w", err)
+	}
+	var session model.Session
+	if err := json.Unmarshal([]byte(raw), &session); err != nil {
+		return nil, fmt.Errorf("decode session: %w", err)
+	}
+	return &session, nil
+}
+
+func (r *sessionRepo) Delete(ctx context.Context, session *model.Session) error {
+	if err := r.redis.Del(ctx, sessionKey(session.ID)); err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+	return r.redis.SRem(ctx, userSessionsKey(session.UserID), session.ID)
+}
+
+func (r *sessionRepo) ListByUser(ctx context.Context, userID uint) ([]*model.Session, error) {
+	ids, err := r.redis.SMembers(ctx, userSessionsKey(userID))
+	if err != nil {
+		return nil, fmt.Errorf("list sessions: %w", err)
+	}
+	var sessions []*model.Session
+	var expired []string
+	for _, id := range ids {
+		s, err := r.Get(ctx, id)
+		if errors.Is(err, ErrSessionNotFound) {
+			expired = append(expired, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	if len(expired) > 0 {
+		r.redis.SRem(ctx, userSessionsKey(userID), expired...)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+	return sessions, nil
+}
+
+func (r *sessionRepo) DeleteByUser(ctx context.Context, userID uint) error {
+	ids, err := r.redis.SMembers(ctx, userSessionsKey(userID))
+	if err != nil {
+		return fmt.Errorf("list sessions: %w", err)
+	}
+	for _, id := range ids {
+		if err := r.redis.Del(ctx, sessionKey(id)); err != nil {
+			return fmt.Errorf("delete session: %w", err)
+		}
+	}
+	return r.redis.Del(ctx, userSessionsKey(userID))
+}
+
+func (r *sessionRepo) ClaimRefresh(ctx context.Context, hash string, ttl time.Duration) (bool, error) {
+	return r.redis.SetNX(ctx, "refresh_spent:"+hash, "1", ttl)
+}
+
+func (r *sessionRepo) RefreshSpent(ctx context.Context, hash string) (bool, error) {
+	_, err := r.redis.Get(ctx, "refresh_spent:"+hash)
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	return err == nil, err
+}
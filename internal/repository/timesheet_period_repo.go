@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"myapp/internal/data"
+	"myapp/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+type TimesheetPeriodRepo interface {
+	Get(ctx context.Context, id uint) (*model.TimesheetPeriod, error)
+
+	// Save creates or updates the period and sets the status of the
+	// employee's timesheets inside it, in one transaction.
+	Save(ctx context.Context, period *model.TimesheetPeriod, timesheetStatus string) error
+
+	// FindOverlapping returns the employee's periods that overlap [from, to].
+	FindOverlapping(
+		ctx context.Context,
+		employeeID uint,
+		from, to time.Time,
+	) ([]*model.TimesheetPeriod, error)
+
+	// List returns periods filtered by status and employee; empty filters match all.
+	List(ctx context.Context, status string, employeeIDs []uint) ([]*model.TimesheetPeriod, error)
+}
+
+type timesheetPeriodRepo struct {
+	data *data.Data
+}
+
+func NewTimesheetPeriodRepo(data *data.Data) *timesheetPeriodRepo {
+	return &timesheetPeriodRepo{data: data}
+}
+
+func (r *timesheetPeriodRepo) Get(ctx context.Context, id uint) (*model.TimesheetPeriod, error) {
+	var period model.TimesheetPeriod
+	if err := r.data.DB.WithContext(ctx).First(&period, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("timesheet period not found")
+		}
+		return nil, fmt.Errorf("query timesheet period: %w", err)
+	}
+	return &period, nil
+}
+
+func (r *timesheetPeriodRepo) Save(ctx context.Context, period *model.TimesheetPeriod, timesheetStatus string) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(period).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Timesheet{}).
+			Where("employee_id = ? AND work_date BETWEEN ? AND ?", period.EmployeeID, period.StartDate, period.EndDate).
+			Update("status", timesheetStatus).Error
+	})
+}
+
+func (r *timesheetPeriodRepo) FindOverlapping(
+	ctx context.Context,
+	employeeID uint,
+	from, to time.Time,
+) ([]*model.TimesheetPeriod, error) {
+	var periods []*model.TimesheetPeriod
+	err := r.data.DB.WithContext(ctx).
+		Where("employee_id = ? AND start_date <= ? AND end_date >= ?", employeeID, to, from).
+		Order("start_date").
+		Find(&periods).Error
+	if err != nil {
+		return nil, fmt.Errorf("query timesheet periods: %w", err)
+	}
+	return periods, nil
+}
+
+func (r *timesheetPeriodRepo) List(ctx context.Context, status string, employeeIDs []uint) ([]*model.TimesheetPeriod, error) {
+	query := r.data.DB.WithContext(ctx).Model(&model.TimesheetPeriod{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if employeeIDs != nil {
+		query = query.Where("employee_id IN ?", employeeIDs)
+	}
+	var periods []*model.TimesheetPeriod
+	if err := query.Order("start_date, employee_id").Find(&periods).Error; err != nil {
+		return nil, fmt.Errorf("list timesheet periods: %w", err)
+	}
+	return periods, nil
+}
@@ -2,20 +2,70 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"myapp/internal/data"
 	"myapp/internal/data/model"
+	"myapp/internal/pagination"
+
+	"gorm.io/gorm"
 )
 
 type timesheetRepo struct {
 	data *data.Data
 }
 
+// PendingTimesheets counts an employee's timesheets that are not approved yet.
+type PendingTimesheets struct {
+	EmployeeID uint `gorm:"column:employee_id"`
+	Draft      int  `gorm:"column:draft"`
+	Submitted  int  `gorm:"column:submitted"`
+}
+
+// TimesheetFilter narrows TimesheetRepo.List; zero fields match everything.
+type TimesheetFilter struct {
+	EmployeeID uint
+	From       *time.Time
+	To         *time.Time
+	Status     string
+}
 
 type TimesheetRepo interface {
 	Create(ctx context.Context, ts *model.Timesheet) error
 
+	// CreateBatch inserts all rows in a single transaction.
+	CreateBatch(ctx context.Context, rows []*model.Timesheet) error
+
+	Get(ctx context.Context, id uint) (*model.Timesheet, error)
+	Update(ctx context.Context, ts *model.Timesheet) error
+
+	// List returns a page of timesheets matching filter, newest first, and the
+	// token of the next page ("" on the last page).
+	List(ctx context.Context, filter TimesheetFilter, page *pagination.Page) ([]*model.Timesheet, string, error)
+
+	// ListRange returns timesheets between from and to ordered by employee
+	// and date. A nil employeeIDs includes every employee.
+	ListRange(ctx context.Context, from, to time.Time, employeeIDs []uint) ([]*model.Timesheet, error)
+
+	// SumOvertime totals the overtime an employee recorded between from and to,
+	// ignoring the timesheet excludeID (0 to include all).
+	SumOvertime(
+		ctx context.Context,
+		employeeID uint,
+		from, to time.Time,
+		excludeID uint,
+	) (float64, error)
+
+	// OvertimeTotals returns overtime hours per employee between from and to.
+	OvertimeTotals(ctx context.Context, from, to time.Time) (map[uint]float64, error)
+
+	// ListPending returns, per employee, the unapproved timesheets between
+	// from and to. employeeID 0 includes every employee.
+	ListPending(ctx context.Context, from, to time.Time, employeeID uint) ([]*PendingTimesheets, error)
+
+	// GetMonthlySummary only counts approved timesheets.
 	GetMonthlySummary(
 		ctx context.Context,
 		employeeID uint,
@@ -38,6 +88,127 @@ func (r *timesheetRepo) Create(ctx context.Context, ts *model.Timesheet) error {
 	return r.data.DB.WithContext(ctx).Create(ts).Error
 }
 
+func (r *timesheetRepo) CreateBatch(ctx context.Context, rows []*model.Timesheet) error {
+	return r.data.DB.WithContext(ctx).CreateInBatches(rows, 200).Error
+}
+
+func (r *timesheetRepo) Get(ctx context.Context, id uint) (*model.Timesheet, error) {
+	var ts model.Timesheet
+	if err := r.data.DB.WithContext(ctx).First(&ts, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("timesheet not found")
+		}
+		return nil, fmt.Errorf("query timesheet: %w", err)
+	}
+	return &ts, nil
+}
+
+func (r *timesheetRepo) Update(ctx context.Context, ts *model.Timesheet) error {
+	return r.data.DB.WithContext(ctx).Save(ts).Error
+}
+
+func (r *timesheetRepo) List(ctx context.Context, filter TimesheetFilter, page *pagination.Page) ([]*model.Timesheet, string, error) {
+	query := r.data.DB.WithContext(ctx).Model(&model.Timesheet{})
+	if filter.EmployeeID != 0 {
+		query = query.Where("employee_id = ?", filter.EmployeeID)
+	}
+	if filter.From != nil {
+		query = query.Where("work_date >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("work_date <= ?", *filter.To)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	query, err := page.Apply(query, "work_date", true, func(k string) (interface{}, error) {
+		return time.Parse(time.RFC3339, k)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	var rows []*model.Timesheet
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, "", fmt.Errorf("list timesheets: %w", err)
+	}
+	rows, nextToken := pagination.Next(page, rows, func(ts *model.Timesheet) (string, uint) {
+		return ts.WorkDate.Format(time.RFC3339), ts.ID
+	})
+	return rows, nextToken, nil
+}
+
+func (r *timesheetRepo) ListRange(ctx context.Context, from, to time.Time, employeeIDs []uint) ([]*model.Timesheet, error) {
+	query := r.data.DB.WithContext(ctx).Where("work_date BETWEEN ? AND ?", from, to)
+	if employeeIDs != nil {
+		query = query.Where("employee_id IN ?", employeeIDs)
+	}
+	var rows []*model.Timesheet
+	if err := query.Order("employee_id, work_date").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("list timesheets: %w", err)
+	}
+	return rows, nil
+}
+
+func (r *timesheetRepo) SumOvertime(
+	ctx context.Context,
+	employeeID uint,
+	from, to time.Time,
+	excludeID uint,
+) (float64, error) {
+	var total float64
+	err := r.data.DB.WithContext(ctx).
+		Model(&model.Timesheet{}).
+		Select("COALESCE(SUM(overtime_hours), 0)").
+		Where("employee_id = ? AND is_leave = ? AND work_date BETWEEN ? AND ? AND id <> ?",
+			employeeID, false, from, to, excludeID).
+		Scan(&total).Error
+	if err != nil {
+		return 0, fmt.Errorf("sum overtime: %w", err)
+	}
+	return total, nil
+}
+
+func (r *timesheetRepo) OvertimeTotals(ctx context.Context, from, to time.Time) (map[uint]float64, error) {
+	var results []struct {
+		EmployeeID uint    `gorm:"column:employee_id"`
+		Total      float64 `gorm:"column:total"`
+	}
+	err := r.data.DB.WithContext(ctx).
+		Model(&model.Timesheet{}).
+		Select("employee_id, SUM(overtime_hours) AS total").
+		Where("is_leave = ? AND work_date BETWEEN ? AND ?", false, from, to).
+		Group("employee_id").
+		Having("SUM(overtime_hours) > 0").
+		Scan(&results).Error
+	if err != nil {
+		return nil, fmt.Errorf("overtime totals: %w", err)
+	}
+	totals := make(map[uint]float64, len(results))
+	for _, row := range results {
+		totals[row.EmployeeID] = row.Total
+	}
+	return totals, nil
+}
+
+func (r *timesheetRepo) ListPending(ctx context.Context, from, to time.Time, employeeID uint) ([]*PendingTimesheets, error) {
+	query := r.data.DB.WithContext(ctx).
+		Model(&model.Timesheet{}).
+		Select("employee_id, "+
+			"SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS draft, "+
+			"SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS submitted",
+			model.TimesheetDraft, model.TimesheetSubmitted).
+		Where("status <> ? AND work_date BETWEEN ? AND ?", model.TimesheetApproved, from, to)
+	if employeeID != 0 {
+		query = query.Where("employee_id = ?", employeeID)
+	}
+	var pending []*PendingTimesheets
+	if err := query.Group("employee_id").Order("employee_id").Scan(&pending).Error; err != nil {
+		return nil, fmt.Errorf("list pending timesheets: %w", err)
+	}
+	return pending, nil
+}
+
 func (r *timesheetRepo) ExistsByEmployeeAndDate(
 	ctx context.Context,
 	employeeID uint,
@@ -74,6 +245,7 @@ func (r *timesheetRepo) GetMonthlySummary(
 		Model(&model.Timesheet{}).
 		Select("is_leave, overtime_hours").
 		Where("employee_id = ? AND work_date BETWEEN ? AND ?", employeeID, start, end).
+		Where("status = ?", model.TimesheetApproved).
 		Scan(&results).Error
 	if err != nil {
 		return 0, 0, 0, err
@@ -93,4 +265,4 @@ func (r *timesheetRepo) GetMonthlySummary(
 	}
 
 	return workingDays, overtimeHours, leaveDays, nil
-}
\ No newline at end of file
+}
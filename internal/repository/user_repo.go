@@ -2,28 +2,119 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"myapp/internal/data"
 	"myapp/internal/data/model"
 )
 
-type UserRepo struct {
+type UserRepo interface {
+	Create(ctx context.Context, user *model.User) error
+	Get(ctx context.Context, id uint) (*model.User, error)
+	GetByUsername(ctx context.Context, username string) (*model.User, error)
+	GetByEmail(ctx context.Context, email string) (*model.User, error)
+	GetByEmployeeID(ctx context.Context, employeeID uint) (*model.User, error)
+	UpdateRole(ctx context.Context, id uint, role string) error
+
+	// SetEmployee links the user to employeeID, or unlinks them when it is
+	// nil.
+	SetEmployee(ctx context.Context, id uint, employeeID *uint) error
+
+	UpdatePassword(ctx context.Context, id uint, hashed string) error
+
+	// MarkEmailVerified records that the user proved they own their email
+	// address. An earlier verification time is kept.
+	MarkEmailVerified(ctx context.Context, id uint, at time.Time) error
+
+	// SetTOTP stores the user's TOTP enrollment. An empty secret removes it.
+	SetTOTP(ctx context.Context, id uint, secret string, enabledAt *time.Time) error
+
+	// UseTOTPStep records step as the last one used and reports false if it,
+	// or a later one, was used before.
+	UseTOTPStep(ctx context.Context, id uint, step int64) (bool, error)
+}
+
+type userRepo struct {
 	data *data.Data
 }
 
-func NewUserRepo(data *data.Data) *UserRepo {
-	return &UserRepo{data: data}
+func NewUserRepo(data *data.Data) UserRepo {
+	return &userRepo{data: data}
 }
 
-func (r *UserRepo) Create(ctx context.Context, user *model.User) error {
+func (r *userRepo) Create(ctx context.Context, user *model.User) error {
 	return r.data.DB.WithContext(ctx).Create(user).Error
 }
 
-func (r *UserRepo) GetByUsername(ctx context.Context, username string) (*model.User, error) {
+func (r *userRepo) GetByUsername(ctx context.Context, username string) (*model.User, error) {
 	var user model.User
 	err := r.data.DB.WithContext(ctx).Where("username = ?", username).First(&user).Error
 	if err != nil {
 		return nil, err
 	}
 	return &user, nil
-}
\ No newline at end of file
+}
+
+func (r *userRepo) Get(ctx context.Context, id uint) (*model.User, error) {
+	var user model.User
+	err := r.data.DB.WithContext(ctx).First(&user, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepo) UpdateRole(ctx context.Context, id uint, role string) error {
+	return r.data.DB.WithContext(ctx).Model(&model.User{}).Where("id = ?", id).Update("role", role).Error
+}
+
+// SetEmployee links the user to employeeID, or unlinks them when it is nil.
+func (r *userRepo) SetEmployee(ctx context.Context, id uint, employeeID *uint) error {
+	return r.data.DB.WithContext(ctx).Model(&model.User{}).Where("id = ?", id).Update("employee_id", employeeID).Error
+}
+
+func (r *userRepo) GetByEmployeeID(ctx context.Context, employeeID uint) (*model.User, error) {
+	var user model.User
+	err := r.data.DB.WithContext(ctx).Where("employee_id = ?", employeeID).First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepo) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	var user model.User
+	err := r.data.DB.WithContext(ctx).Where("LOWER(email) = LOWER(?)", email).First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepo) UpdatePassword(ctx context.Context, id uint, hashed string) error {
+	return r.data.DB.WithContext(ctx).Model(&model.User{}).Where("id = ?", id).Update("password", hashed).Error
+}
+
+// MarkEmailVerified records that the user proved they own their email
+// address. An earlier verification time is kept.
+func (r *userRepo) MarkEmailVerified(ctx context.Context, id uint, at time.Time) error {
+	return r.data.DB.WithContext(ctx).Model(&model.User{}).
+		Where("id = ? AND email_verified_at IS NULL", id).
+		Update("email_verified_at", at).Error
+}
+
+// SetTOTP stores the user's TOTP enrollment. An empty secret removes it.
+func (r *userRepo) SetTOTP(ctx context.Context, id uint, secret string, enabledAt *time.Time) error {
+	return r.data.DB.WithContext(ctx).Model(&model.User{}).Where("id = ?", id).
+		Select("totp_secret", "totp_enabled_at", "totp_last_step").
+		Updates(&model.User{TOTPSecret: secret, TOTPEnabledAt: enabledAt}).Error
+}
+
+// UseTOTPStep records step as the last one used and reports false if it, or
+// a later one, was used before.
+func (r *userRepo) UseTOTPStep(ctx context.Context, id uint, step int64) (bool, error) {
+	result := r.data.DB.WithContext(ctx).Model(&model.User{}).
+		Where("id = ? AND totp_last_step < ?", id, step).
+		Update("totp_last_step", step)
+	return result.RowsAffected == 1, result.Error
+}
@@ -0,0 +1,229 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	authv1 "myapp/api/auth/v1"
+	documentv1 "myapp/api/document/v1"
+	employeev1 "myapp/api/employee/v1"
+	mev1 "myapp/api/me/v1"
+	organizationv1 "myapp/api/organization/v1"
+	payrollv1 "myapp/api/payroll/v1"
+	schedulev1 "myapp/api/schedule/v1"
+	timesheetv1 "myapp/api/timesheet/v1"
+	"myapp/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// targetFunc returns the employee a request acts on.
+type targetFunc func(ctx context.Context, access *biz.AccessPolicy, req interface{}) (uint, error)
+
+// operationRule is the permission an operation needs; without one any
+// signed-in user may call it. Managers and employees may only call operations
+// that are open or whose target they can access.
+type operationRule struct {
+	permission  string
+	open        bool // carries no employee data, e.g. the department list
+	target      targetFunc
+	reportsOnly bool // a manager's own record is not a valid target
+}
+
+// publicOperations are served without a token; AuthMiddleware and
+// Authorization both let them through.
+var publicOperations = map[string]bool{
+	authv1.OperationAuthLogin:              true,
+	authv1.OperationAuthLoginTwoFactor:     true,
+	authv1.OperationAuthEnrollTOTPForLogin: true,
+	authv1.OperationAuthOIDCLogin:          true,
+	authv1.OperationAuthOIDCCallback:       true,
+	authv1.OperationAuthRegister:           true,
+	authv1.OperationAuthAcceptInvitation:   true,
+	authv1.OperationAuthRefreshToken:       true,
+	authv1.OperationAuthVerifyEmail:        true,
+	authv1.OperationAuthResendVerification: true,
+	authv1.OperationAuthForgotPassword:     true,
+	authv1.OperationAuthResetPassword:      true,
+}
+
+// operationRules maps every RPC operation to its rule. Operations missing
+// from the map are denied.
+var operationRules = map[string]operationRule{
+	authv1.OperationAuthLogout:                  {open: true},
+	authv1.OperationAuthLogoutAll:               {open: true},
+	authv1.OperationAuthListSessions:            {open: true},
+	authv1.OperationAuthRevokeSession:           {open: true},
+	authv1.OperationAuthChangePassword:          {open: true},
+	authv1.OperationAuthEnrollTOTP:              {open: true},
+	authv1.OperationAuthConfirmTOTP:             {open: true},
+	authv1.OperationAuthDisableTOTP:             {open: true},
+	authv1.OperationAuthRegenerateRecoveryCodes: {open: true},
+	authv1.OperationAuthSetUserRole:             {permission: biz.PermUsersManage},
+	authv1.OperationAuthLinkEmployee:            {permission: biz.PermUsersManage},
+	authv1.OperationAuthUnlockUser:              {permission: biz.PermUsersManage},
+	authv1.OperationAuthListAuthEvents:          {permission: biz.PermUsersManage},
+	authv1.OperationAuthResetUserTOTP:           {permission: biz.PermUsersManage},
+	authv1.OperationAuthInviteUser:              {permission: biz.PermUsersInvite},
+
+	authv1.OperationAuthCreateServiceAccount:  {permission: biz.PermUsersManage},
+	authv1.OperationAuthListServiceAccounts:   {permission: biz.PermUsersManage},
+	authv1.OperationAuthDisableServiceAccount: {permission: biz.PermUsersManage},
+	authv1.OperationAuthCreateAPIKey:          {permission: biz.PermUsersManage},
+	authv1.OperationAuthRevokeAPIKey:          {permission: biz.PermUsersManage},
+
+	mev1.OperationMeGetProfile:        {permission: biz.PermSelfService, target: self},
+	mev1.OperationMeListMyTimesheets:  {permission: biz.PermSelfService, target: self},
+	mev1.OperationMeListMyPayslips:    {permission: biz.PermSelfService, target: self},
+	mev1.OperationMeGetMyPayslipPDF:   {permission: biz.PermSelfService, target: self},
+	mev1.OperationMeGetMyLeaveBalance: {permission: biz.PermSelfService, target: self},
+
+	employeev1.OperationEmployeeList:                 {permission: biz.PermEmployeesRead},
+	employeev1.OperationEmployeeExportEmployees:      {permission: biz.PermEmployeesRead},
+	employeev1.OperationEmployeeGet:                  {permission: biz.PermEmployeesRead, target: field((*employeev1.GetRequest).GetId)},
+	employeev1.OperationEmployeeListContracts:        {permission: biz.PermEmployeesRead, target: field((*employeev1.ListContractsRequest).GetEmployeeId)},
+	employeev1.OperationEmployeeListDependents:       {permission: biz.PermEmployeesRead, target: field((*employeev1.ListDependentsRequest).GetEmployeeId)},
+	employeev1.OperationEmployeeGetTermination:       {permission: biz.PermEmployeesRead, target: field((*employeev1.GetTerminationRequest).GetId)},
+	employeev1.OperationEmployeeListCustomFields:     {permission: biz.PermEmployeesRead, open: true},
+	employeev1.OperationEmployeeImportEmployees:      {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeCreate:               {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeUpdate:               {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeDelete:               {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeCreateContract:       {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeChangeStatus:         {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeTerminate:            {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeAddDependent:         {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeEndDependent:         {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeCreateCustomField:    {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeUpdateCustomField:    {permission: biz.PermEmployeesWrite},
+	employeev1.OperationEmployeeRotateEncryptionKeys: {permission: biz.PermRotateKeys},
+
+	organizationv1.OperationOrganizationListDepartments:  {permission: biz.PermOrgRead, open: true},
+	organizationv1.OperationOrganizationListPositions:    {permission: biz.PermOrgRead, open: true},
+	organizationv1.OperationOrganizationGetOrgTree:       {permission: biz.PermOrgRead, open: true},
+	organizationv1.OperationOrganizationListAssignments:  {permission: biz.PermOrgRead, target: field((*organizationv1.ListAssignmentsRequest).GetEmployeeId)},
+	organizationv1.OperationOrganizationListReports:      {permission: biz.PermOrgRead, target: field((*organizationv1.ListReportsRequest).GetEmployeeId)},
+	organizationv1.OperationOrganizationCreateDepartment: {permission: biz.PermOrgWrite},
+	organizationv1.OperationOrganizationCreatePosition:   {permission: biz.PermOrgWrite},
+	organizationv1.OperationOrganizationMoveEmployee:     {permission: biz.PermOrgWrite},
+
+	schedulev1.OperationScheduleListSchedules:       {permission: biz.PermSchedulesRead, open: true},
+	schedulev1.OperationScheduleListShifts:          {permission: biz.PermSchedulesRead, open: true},
+	schedulev1.OperationScheduleGetEmployeeSchedule: {permission: biz.PermSchedulesRead, target: field((*schedulev1.GetEmployeeScheduleRequest).GetEmployeeId)},
+	schedulev1.OperationScheduleCreateShift:         {permission: biz.PermSchedulesWrite},
+	schedulev1.OperationScheduleCreateSchedule:      {permission: biz.PermSchedulesWrite},
+	schedulev1.OperationScheduleAssignSchedule:      {permission: biz.PermSchedulesWrite},
+
+	timesheetv1.OperationTimesheetListTimesheets: {permission: biz.PermTimesheetsRead, target: field((*timesheetv1.ListTimesheetsRequest).GetEmployeeId)},
+	timesheetv1.OperationTimesheetListPeriods: {permission: biz.PermTimesheetsRead, target: field(func(r *timesheetv1.ListPeriodsRequest) uint32 {
+		if r.EmployeeId != 0 {
+			return r.EmployeeId
+		}
+		return r.ManagerId
+	})},
+	timesheetv1.OperationTimesheetAnomalyReport: {permission: biz.PermTimesheetsRead, target: field(func(r *timesheetv1.AnomalyReportRequest) uint32 {
+		if r.EmployeeId != 0 {
+			return r.EmployeeId
+		}
+		return r.ManagerId
+	})},
+	timesheetv1.OperationTimesheetOvertimeReport:   {permission: biz.PermTimesheetsRead},
+	timesheetv1.OperationTimesheetCreate:           {permission: biz.PermTimesheetsWrite, target: field((*timesheetv1.CreateTimesheetRequest).GetEmployeeId)},
+	timesheetv1.OperationTimesheetUpdate:           {permission: biz.PermTimesheetsWrite, target: owner((*timesheetv1.UpdateTimesheetRequest).GetId, (*biz.AccessPolicy).TimesheetOwner)},
+	timesheetv1.OperationTimesheetImportTimesheets: {permission: biz.PermTimesheetsWrite},
+	timesheetv1.OperationTimesheetSubmitPeriod:     {permission: biz.PermTimesheetsWrite, target: field((*timesheetv1.SubmitPeriodRequest).GetEmployeeId)},
+	timesheetv1.OperationTimesheetApprovePeriod:    {permission: biz.PermTimesheetsApprove, target: owner((*timesheetv1.ReviewPeriodRequest).GetId, (*biz.AccessPolicy).PeriodOwner), reportsOnly: true},
+	timesheetv1.OperationTimesheetRejectPeriod:     {permission: biz.PermTimesheetsApprove, target: owner((*timesheetv1.ReviewPeriodRequest).GetId, (*biz.AccessPolicy).PeriodOwner), reportsOnly: true},
+
+	payrollv1.OperationPayrollListPayrolls:          {permission: biz.PermPayrollRead, target: field((*payrollv1.ListPayrollsRequest).GetEmployeeId)},
+	payrollv1.OperationPayrollExportPayrollPDF:      {permission: biz.PermPayrollRead, target: field((*payrollv1.ExportPayrollPDFRequest).GetEmployeeId)},
+	payrollv1.OperationPayrollListPendingTimesheets: {permission: biz.PermPayrollRead},
+	payrollv1.OperationPayrollDependentReport:       {permission: biz.PermPayrollRead},
+	payrollv1.OperationPayrollCalculatePayroll:      {permission: biz.PermPayrollRun},
+	payrollv1.OperationPayrollSendPayslipEmail:      {permission: biz.PermPayrollRun},
+	payrollv1.OperationPayrollExportBankTransfer:    {permission: biz.PermPayrollRun},
+
+	documentv1.OperationDocumentListDocuments:     {permission: biz.PermDocumentsRead, target: field((*documentv1.ListDocumentsRequest).GetEmployeeId)},
+	documentv1.OperationDocumentDownloadDocument:  {permission: biz.PermDocumentsRead, target: owner((*documentv1.DownloadDocumentRequest).GetId, (*biz.AccessPolicy).DocumentOwner)},
+	documentv1.OperationDocumentExpiringDocuments: {permission: biz.PermDocumentsRead},
+	documentv1.OperationDocumentUploadDocument:    {permission: biz.PermDocumentsWrite},
+	documentv1.OperationDocumentDeleteDocument:    {permission: biz.PermDocumentsWrite},
+}
+
+var errBadRequest = errors.New("unexpected request type")
+
+// self targets the caller's own employee record.
+func self(ctx context.Context, _ *biz.AccessPolicy, _ interface{}) (uint, error) {
+	return biz.CurrentEmployeeID(ctx)
+}
+
+// field targets the employee ID read from the request by get.
+func field[T any](get func(T) uint32) targetFunc {
+	return func(_ context.Context, _ *biz.AccessPolicy, req interface{}) (uint, error) {
+		r, ok := req.(T)
+		if !ok {
+			return 0, errBadRequest
+		}
+		return uint(get(r)), nil
+	}
+}
+
+// owner targets the employee owning the record whose ID get reads from the
+// request.
+func owner[T any](get func(T) uint32, lookup func(*biz.AccessPolicy, context.Context, uint) (uint, error)) targetFunc {
+	return func(ctx context.Context, access *biz.AccessPolicy, req interface{}) (uint, error) {
+		r, ok := req.(T)
+		if !ok {
+			return 0, errBadRequest
+		}
+		return lookup(access, ctx, uint(get(r)))
+	}
+}
+
+// Authorization enforces operationRules for the caller placed on the context
+// by AuthMiddleware.
+func Authorization(access *biz.AccessPolicy) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, errors.New("transport not found")
+			}
+			operation := tr.Operation()
+			if publicOperations[operation] {
+				return handler(ctx, req)
+			}
+			user, ok := biz.UserFromContext(ctx)
+			if !ok {
+				return nil, status.Error(codes.Unauthenticated, "authentication required")
+			}
+			rule, ok := operationRules[operation]
+			if !ok || (rule.permission != "" && !user.Can(rule.permission)) {
+				return nil, status.Error(codes.PermissionDenied, "permission denied")
+			}
+			// Open operations act on the caller's own account, which service
+			// accounts don't have.
+			if rule.permission == "" && user.ServiceAccountID != 0 {
+				return nil, status.Error(codes.PermissionDenied, "permission denied")
+			}
+			if !biz.ScopedRole(user.Role) || rule.open {
+				return handler(ctx, req)
+			}
+			if rule.target == nil {
+				return nil, status.Error(codes.PermissionDenied, "permission denied")
+			}
+			employeeID, err := rule.target(ctx, access, req)
+			if err != nil {
+				return nil, status.Error(codes.PermissionDenied, "permission denied")
+			}
+			allowed, err := access.CanAccessEmployee(ctx, employeeID, rule.reportsOnly)
+			if err != nil || !allowed {
+				return nil, status.Error(codes.PermissionDenied, "not allowed to access this employee")
+			}
+			return handler(ctx, req)
+		}
+	}
+}
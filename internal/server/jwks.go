@@ -0,0 +1,20 @@
+package server
+
+import (
+	"encoding/json"
+	nethttp "net/http"
+
+	"myapp/internal/signing"
+)
+
+// JWKSHandler serves the public signing keys at /.well-known/jwks.json so
+// other services can verify our access tokens. Keys change only on rotation,
+// which keeps the old key listed, so verifiers may cache the set briefly.
+func JWKSHandler(keys *signing.Keyring) nethttp.HandlerFunc {
+	body, _ := json.Marshal(keys.JWKS())
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.Write(body)
+	}
+}
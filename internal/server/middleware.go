@@ -3,10 +3,12 @@ package server
 import (
 	"context"
 	"errors"
-	"strconv"
 	"strings"
 
+	"myapp/internal/biz"
+	"myapp/internal/clientip"
 	"myapp/internal/repository"
+	"myapp/internal/signing"
 
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
@@ -14,10 +16,27 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func AuthMiddleware(secret string, redisRepo *repository.RedisRepo) middleware.Middleware {
+// ClientIP resolves the client address once per request and puts it in the
+// context for rate limits, audit records and API key usage.
+func ClientIP(resolver *clientip.Resolver) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			// Skip for login and register
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if ht, ok := tr.(http.Transporter); ok {
+					ctx = clientip.NewContext(ctx, resolver.IP(ht.Request()))
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// AuthMiddleware verifies the bearer token against the signing keys, or the
+// API key of a service account, and puts the caller in the context.
+func AuthMiddleware(keys *signing.Keyring, sessions repository.SessionRepo, apiKeys *biz.ServiceAccountUsecase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			// Skip for the endpoints used before signing in
 			tr, ok := transport.FromServerContext(ctx)
 			if !ok {
 				return nil, errors.New("transport not found")
@@ -26,40 +45,65 @@ func AuthMiddleware(secret string, redisRepo *repository.RedisRepo) middleware.M
 			if !ok {
 				return nil, errors.New("http transport not found")
 			}
-			path := httpTr.Request().URL.Path
-			if path == "/auth/login" || path == "/auth/register" {
+			if publicOperations[tr.Operation()] {
 				return handler(ctx, req)
 			}
 
-			// Get token from header
+			// API keys come in X-API-Key or as a bearer token
 			authHeader := httpTr.Request().Header.Get("Authorization")
+			apiKey := httpTr.Request().Header.Get("X-API-Key")
+			if apiKey == "" && strings.HasPrefix(authHeader, "Bearer "+biz.APIKeyPrefix) {
+				apiKey = strings.TrimPrefix(authHeader, "Bearer ")
+			}
+			if apiKey != "" && apiKeys != nil {
+				ip, ok := clientip.FromContext(ctx)
+				if !ok {
+					ip = clientip.Remote(httpTr.Request())
+				}
+				user, err := apiKeys.Authenticate(ctx, apiKey, ip)
+				if err != nil {
+					return nil, errors.New("invalid api key")
+				}
+				return handler(biz.NewUserContext(ctx, user), req)
+			}
+
+			// Get token from header
 			if authHeader == "" {
 				return nil, errors.New("authorization header missing")
 			}
 			tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
 
 			// Parse JWT
-			token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-				return []byte(secret), nil
-			})
+			claims := jwt.MapClaims{}
+			token, err := keys.Parse(tokenStr, claims)
 			if err != nil || !token.Valid {
 				return nil, errors.New("invalid token")
 			}
 
-			claims, ok := token.Claims.(jwt.MapClaims)
+			id, ok := claims["id"].(float64)
 			if !ok {
 				return nil, errors.New("invalid claims")
 			}
-
-			userID := int(claims["id"].(float64))
-			// Check if token exists in Redis (for revocation)
-			storedToken, err := redisRepo.Get(ctx, "token:"+strconv.Itoa(userID))
-			if err != nil || storedToken != tokenStr {
+			userID := int(id)
+			// The session must still exist: logout and refresh token reuse
+			// end it before the access token expires.
+			sessionID, _ := claims["sid"].(string)
+			session, err := sessions.Get(ctx, sessionID)
+			if err != nil || session.UserID != uint(userID) {
 				return nil, errors.New("token revoked or invalid")
 			}
 
-			// Add user info to context if needed
+			username, _ := claims["username"].(string)
+			role, _ := claims["role"].(string)
+			employeeID, _ := claims["employee_id"].(float64)
+			ctx = biz.NewUserContext(ctx, &biz.CurrentUser{
+				ID:         uint(userID),
+				Username:   username,
+				Role:       role,
+				EmployeeID: uint(employeeID),
+				SessionID:  sessionID,
+			})
 			return handler(ctx, req)
 		}
 	}
-}
\ No newline at end of file
+}
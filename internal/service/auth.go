@@ -3,32 +3,371 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	pb "myapp/api/auth/v1"
 	"myapp/internal/biz"
+	"myapp/internal/clientip"
+	"myapp/internal/data/model"
+	"myapp/internal/password"
+	"myapp/internal/repository"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AuthService struct {
 	pb.UnimplementedAuthServer
-	uc *biz.AuthUsecase
+	uc              *biz.AuthUsecase
+	serviceAccounts *biz.ServiceAccountUsecase
 }
 
-func NewAuthService(uc *biz.AuthUsecase) *AuthService {
-	return &AuthService{uc: uc}
+func NewAuthService(uc *biz.AuthUsecase, serviceAccounts *biz.ServiceAccountUsecase) *AuthService {
+	return &AuthService{uc: uc, serviceAccounts: serviceAccounts}
 }
 
 func (s *AuthService) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterReply, error) {
-	err := s.uc.Register(ctx, req.Username, req.Password, req.Email)
+	_, ip := clientInfo(ctx)
+	err := s.uc.Register(ctx, req.Username, req.Password, req.Email, ip)
 	if err != nil {
-		return nil, err
+		return nil, accountError(err)
 	}
-	return &pb.RegisterReply{Message: "registered"}, nil
+	return &pb.RegisterReply{Message: "registered; check your email to verify your address"}, nil
 }
 
 func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginReply, error) {
-	token, err := s.uc.Login(ctx, req.Username, req.Password)
+	userAgent, ip := clientInfo(ctx)
+	device := strings.TrimSpace(req.DeviceName)
+	if device == "" {
+		device = userAgent
+	}
+	tokens, err := s.uc.Login(ctx, req.Username, req.Password, device, ip)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return toLoginReply(tokens), nil
+}
+
+func (s *AuthService) LoginTwoFactor(ctx context.Context, req *pb.LoginTwoFactorRequest) (*pb.LoginReply, error) {
+	userAgent, ip := clientInfo(ctx)
+	device := strings.TrimSpace(req.DeviceName)
+	if device == "" {
+		device = userAgent
+	}
+	tokens, err := s.uc.LoginSecondFactor(ctx, req.ChallengeToken, req.Code, device, ip)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return toLoginReply(tokens), nil
+}
+
+func (s *AuthService) OIDCLogin(ctx context.Context, req *pb.OIDCLoginRequest) (*pb.OIDCLoginReply, error) {
+	authURL, state, err := s.uc.StartOIDCLogin(ctx)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.OIDCLoginReply{AuthorizationUrl: authURL, State: state}, nil
+}
+
+func (s *AuthService) OIDCCallback(ctx context.Context, req *pb.OIDCCallbackRequest) (*pb.LoginReply, error) {
+	userAgent, ip := clientInfo(ctx)
+	device := strings.TrimSpace(req.DeviceName)
+	if device == "" {
+		device = userAgent
+	}
+	tokens, err := s.uc.FinishOIDCLogin(ctx, req.Code, req.State, device, ip)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return toLoginReply(tokens), nil
+}
+
+func (s *AuthService) EnrollTOTPForLogin(ctx context.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPReply, error) {
+	enrollment, err := s.uc.EnrollTOTPForLogin(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.EnrollTOTPReply{Secret: enrollment.Secret, OtpauthUri: enrollment.URI}, nil
+}
+
+func (s *AuthService) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPReply, error) {
+	enrollment, err := s.uc.EnrollTOTP(ctx)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.EnrollTOTPReply{Secret: enrollment.Secret, OtpauthUri: enrollment.URI}, nil
+}
+
+func (s *AuthService) ConfirmTOTP(ctx context.Context, req *pb.TOTPCodeRequest) (*pb.RecoveryCodesReply, error) {
+	codes, err := s.uc.ConfirmTOTP(ctx, req.Code)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.RecoveryCodesReply{RecoveryCodes: codes}, nil
+}
+
+func (s *AuthService) DisableTOTP(ctx context.Context, req *pb.TOTPCodeRequest) (*pb.AccountReply, error) {
+	if err := s.uc.DisableTOTP(ctx, req.Code); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AccountReply{Message: "two-factor authentication disabled"}, nil
+}
+
+func (s *AuthService) RegenerateRecoveryCodes(ctx context.Context, req *pb.TOTPCodeRequest) (*pb.RecoveryCodesReply, error) {
+	codes, err := s.uc.RegenerateRecoveryCodes(ctx, req.Code)
+	if err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.RecoveryCodesReply{RecoveryCodes: codes}, nil
+}
+
+func (s *AuthService) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.AccountReply, error) {
+	if err := s.uc.ChangePassword(ctx, req.CurrentPassword, req.NewPassword); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AccountReply{Message: "password changed; your other sessions were ended"}, nil
+}
+
+func (s *AuthService) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.AccountReply, error) {
+	if err := s.uc.VerifyEmail(ctx, req.Token); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AccountReply{Message: "email verified"}, nil
+}
+
+func (s *AuthService) ResendVerification(ctx context.Context, req *pb.ResendVerificationRequest) (*pb.AccountReply, error) {
+	_, ip := clientInfo(ctx)
+	if err := s.uc.ResendVerification(ctx, req.Email, ip); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AccountReply{Message: "if an unverified account uses this address, a new link has been sent"}, nil
+}
+
+func (s *AuthService) ForgotPassword(ctx context.Context, req *pb.ForgotPasswordRequest) (*pb.AccountReply, error) {
+	_, ip := clientInfo(ctx)
+	if err := s.uc.ForgotPassword(ctx, req.Email, ip); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AccountReply{Message: "if an account uses this address, a reset link has been sent"}, nil
+}
+
+func (s *AuthService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.AccountReply, error) {
+	if err := s.uc.ResetPassword(ctx, req.Token, req.Password); err != nil {
+		return nil, accountError(err)
+	}
+	return &pb.AccountReply{Message: "password changed; sign in again on your devices"}, nil
+}
+
+// accountError maps the errors of the account flows to status codes.
+func accountError(err error) error {
+	var policyErr *password.PolicyError
+	switch {
+	case errors.As(err, &policyErr), errors.Is(err, biz.ErrPasswordReused), errors.Is(err, biz.ErrWrongCurrentPassword):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, biz.ErrInvalidCredentials), errors.Is(err, biz.ErrInvalidChallenge), errors.Is(err, biz.ErrInvalidTOTPCode),
+		errors.Is(err, biz.ErrOIDCFailed):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, biz.ErrOIDCDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, biz.ErrOIDCEmailMissing), errors.Is(err, biz.ErrOIDCEmailUnverified), errors.Is(err, biz.ErrOIDCAccountConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, biz.ErrTOTPNotEnrolled), errors.Is(err, biz.ErrTOTPAlreadyEnabled), errors.Is(err, biz.ErrTOTPRequiredForRole):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, biz.ErrTooManyRequests), errors.Is(err, biz.ErrAccountLocked):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, biz.ErrEmailNotVerified):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, biz.ErrAuthTokenInvalid), errors.Is(err, biz.ErrCredentialsMissing), errors.Is(err, biz.ErrOIDCStateInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.LoginReply, error) {
+	tokens, err := s.uc.Refresh(ctx, req.RefreshToken)
+	if errors.Is(err, biz.ErrInvalidRefreshToken) || errors.Is(err, biz.ErrRefreshTokenReused) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toLoginReply(tokens), nil
+}
+
+func (s *AuthService) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutReply, error) {
+	if err := s.uc.Logout(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.LogoutReply{}, nil
+}
+
+func (s *AuthService) LogoutAll(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutReply, error) {
+	if err := s.uc.LogoutAll(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.LogoutReply{}, nil
+}
+
+func (s *AuthService) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsReply, error) {
+	sessions, err := s.uc.ListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, _ := biz.UserFromContext(ctx)
+	items := make([]*pb.SessionItem, 0, len(sessions))
+	for _, session := range sessions {
+		items = append(items, &pb.SessionItem{
+			Id:         session.ID,
+			Device:     session.Device,
+			Ip:         session.IP,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastUsedAt: timestamppb.New(session.LastUsedAt),
+			ExpiresAt:  timestamppb.New(session.ExpiresAt),
+			Current:    user != nil && session.ID == user.SessionID,
+		})
+	}
+	return &pb.ListSessionsReply{Items: items}, nil
+}
+
+func (s *AuthService) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.LogoutReply, error) {
+	err := s.uc.RevokeSession(ctx, req.Id)
+	if errors.Is(err, repository.ErrSessionNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LogoutReply{}, nil
+}
+
+func toLoginReply(tokens *biz.TokenPair) *pb.LoginReply {
+	return &pb.LoginReply{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    int32(tokens.ExpiresIn),
+		SessionId:    tokens.SessionID,
+
+		ChallengeToken:     tokens.ChallengeToken,
+		EnrollmentRequired: tokens.EnrollmentRequired,
+		RecoveryCodes:      tokens.RecoveryCodes,
+	}
+}
+
+// clientInfo returns the User-Agent and client IP of an HTTP request. The IP
+// comes from the ClientIP middleware, which only trusts forwarded headers
+// from configured proxies; without it the connection's peer is used.
+func clientInfo(ctx context.Context) (string, string) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	ht, ok := tr.(http.Transporter)
+	if !ok {
+		return "", ""
+	}
+	r := ht.Request()
+	ip, ok := clientip.FromContext(ctx)
+	if !ok {
+		ip = clientip.Remote(r)
+	}
+	return r.UserAgent(), ip
+}
+
+func (s *AuthService) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.SetUserRoleReply, error) {
+	user, err := s.uc.SetRole(ctx, uint(req.Id), req.Role)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetUserRoleReply{Item: toUserItem(user)}, nil
+}
+
+func (s *AuthService) InviteUser(ctx context.Context, req *pb.InviteUserRequest) (*pb.InviteUserReply, error) {
+	invitation, err := s.uc.Invite(ctx, uint(req.EmployeeId), req.Email, req.Role)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.LoginReply{Token: token}, nil
-}
\ No newline at end of file
+	return &pb.InviteUserReply{
+		Id:        uint32(invitation.ID),
+		Email:     invitation.Email,
+		Role:      invitation.Role,
+		ExpiresAt: timestamppb.New(invitation.ExpiresAt),
+	}, nil
+}
+
+func (s *AuthService) AcceptInvitation(ctx context.Context, req *pb.AcceptInvitationRequest) (*pb.AcceptInvitationReply, error) {
+	user, err := s.uc.AcceptInvitation(ctx, req.Token, req.Username, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AcceptInvitationReply{Item: toUserItem(user)}, nil
+}
+
+func (s *AuthService) LinkEmployee(ctx context.Context, req *pb.LinkEmployeeRequest) (*pb.LinkEmployeeReply, error) {
+	user, err := s.uc.LinkEmployee(ctx, uint(req.Id), uint(req.EmployeeId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LinkEmployeeReply{Item: toUserItem(user)}, nil
+}
+
+func (s *AuthService) UnlockUser(ctx context.Context, req *pb.UnlockUserRequest) (*pb.UnlockUserReply, error) {
+	user, err := s.uc.UnlockUser(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnlockUserReply{Item: toUserItem(user)}, nil
+}
+
+func (s *AuthService) ListAuthEvents(ctx context.Context, req *pb.ListAuthEventsRequest) (*pb.ListAuthEventsReply, error) {
+	filter := repository.AuthEventFilter{UserID: uint(req.UserId), Event: req.Event}
+	events, nextToken, err := s.uc.ListAuthEvents(ctx, filter, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListAuthEventsReply{NextPageToken: nextToken}
+	for _, e := range events {
+		item := &pb.AuthEventItem{
+			Id:        uint32(e.ID),
+			Event:     e.Event,
+			Username:  e.Username,
+			Ip:        e.IP,
+			Detail:    e.Detail,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		}
+		if e.UserID != nil {
+			item.UserId = uint32(*e.UserID)
+		}
+		if e.ActorID != nil {
+			item.ActorId = uint32(*e.ActorID)
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return resp, nil
+}
+
+func (s *AuthService) ResetUserTOTP(ctx context.Context, req *pb.ResetUserTOTPRequest) (*pb.ResetUserTOTPReply, error) {
+	user, err := s.uc.ResetUserTOTP(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResetUserTOTPReply{Item: toUserItem(user)}, nil
+}
+
+func toUserItem(u *model.User) *pb.UserItem {
+	item := &pb.UserItem{
+		Id:       uint32(u.ID),
+		Username: u.Username,
+		Email:    u.Email,
+		Role:     u.Role,
+
+		TwoFactorEnabled: u.TOTPEnabledAt != nil,
+	}
+	if u.EmployeeID != nil {
+		item.EmployeeId = uint32(*u.EmployeeID)
+	}
+	return item
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"time"
+
+	pb "myapp/api/document/v1"
+	"myapp/internal/biz"
+	"myapp/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type DocumentService struct {
+	pb.UnimplementedDocumentServer
+	uc *biz.DocumentUsecase
+}
+
+func NewDocumentService(uc *biz.DocumentUsecase) *DocumentService {
+	return &DocumentService{uc: uc}
+}
+
+func (s *DocumentService) UploadDocument(ctx context.Context, req *pb.UploadDocumentRequest) (*pb.UploadDocumentReply, error) {
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+	doc, err := s.uc.Upload(ctx,
+		uint(req.EmployeeId),
+		req.Type,
+		req.Title,
+		req.FileName,
+		req.Access,
+		expiresAt,
+		req.Content,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UploadDocumentReply{Item: toDocumentItem(doc)}, nil
+}
+
+func (s *DocumentService) ListDocuments(ctx context.Context, req *pb.ListDocumentsRequest) (*pb.ListDocumentsReply, error) {
+	docs, err := s.uc.List(ctx, uint(req.EmployeeId), req.Type)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListDocumentsReply{}
+	for _, d := range docs {
+		resp.Items = append(resp.Items, toDocumentItem(d))
+	}
+	return resp, nil
+}
+
+func (s *DocumentService) DownloadDocument(ctx context.Context, req *pb.DownloadDocumentRequest) (*pb.DownloadDocumentReply, error) {
+	doc, content, err := s.uc.Download(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	fileName := doc.FileName
+	if fileName == "" {
+		fileName = fmt.Sprintf("document_%d", doc.ID)
+	}
+
+	hctx, ok := ctx.(http.Context)
+	if !ok {
+		return &pb.DownloadDocumentReply{Content: content, FileName: fileName, ContentType: doc.ContentType}, nil
+	}
+
+	w := hctx.Response()
+	w.Header().Set("Content-Type", doc.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(content)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	if _, err := w.Write(content); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write document")
+	}
+
+	return &pb.DownloadDocumentReply{}, nil
+}
+
+func (s *DocumentService) DeleteDocument(ctx context.Context, req *pb.DeleteDocumentRequest) (*pb.DeleteDocumentReply, error) {
+	if err := s.uc.Delete(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteDocumentReply{}, nil
+}
+
+func (s *DocumentService) ExpiringDocuments(ctx context.Context, req *pb.ExpiringDocumentsRequest) (*pb.ExpiringDocumentsReply, error) {
+	items, err := s.uc.Expiring(ctx, int(req.WithinDays))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ExpiringDocumentsReply{}
+	for _, it := range items {
+		resp.Items = append(resp.Items, &pb.ExpiringDocumentItem{
+			Document:     toDocumentItem(it.Document),
+			EmployeeName: it.EmployeeName,
+			DaysLeft:     int32(it.DaysLeft),
+		})
+	}
+	return resp, nil
+}
+
+func toDocumentItem(d *model.Document) *pb.DocumentItem {
+	item := &pb.DocumentItem{
+		Id:          uint32(d.ID),
+		EmployeeId:  uint32(d.EmployeeID),
+		Type:        d.Type,
+		Title:       d.Title,
+		FileName:    d.FileName,
+		ContentType: d.ContentType,
+		Size:        d.Size,
+		Sha256:      d.SHA256,
+		Access:      d.Access,
+		UploadedAt:  timestamppb.New(d.CreatedAt),
+	}
+	if d.ExpiresAt != nil {
+		item.ExpiresAt = timestamppb.New(*d.ExpiresAt)
+	}
+	return item
+}
@@ -2,41 +2,135 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	pb "myapp/api/employee/v1"
 	"myapp/internal/biz"
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
 
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type EmployeeService struct {
 	pb.UnimplementedEmployeeServer
-	uc *biz.EmployeeUsecase
+	uc         *biz.EmployeeUsecase
+	employment *biz.EmploymentUsecase
 }
 
-func NewEmployeeService(uc *biz.EmployeeUsecase) *EmployeeService {
-	return &EmployeeService{uc: uc}
+func NewEmployeeService(uc *biz.EmployeeUsecase, employment *biz.EmploymentUsecase) *EmployeeService {
+	return &EmployeeService{uc: uc, employment: employment}
 }
 
 func (s *EmployeeService) List(ctx context.Context, req *pb.ListRequest) (*pb.ListReply, error) {
-	employees, nextToken, err := s.uc.List(ctx, int(req.PageSize), req.PageToken)
+	filter := repository.EmployeeFilter{
+		Name:         req.Name,
+		DepartmentID: uint(req.DepartmentId),
+		PositionID:   uint(req.PositionId),
+		Status:       req.Status,
+		SortBy:       req.SortBy,
+		Descending:   req.Descending,
+	}
+	if req.JoinedFrom != nil {
+		from := req.JoinedFrom.AsTime()
+		filter.JoinedFrom = &from
+	}
+	if req.JoinedTo != nil {
+		to := req.JoinedTo.AsTime()
+		filter.JoinedTo = &to
+	}
+	employees, nextToken, total, err := s.uc.List(ctx, filter, req.CustomFields, req.PageSize, req.PageToken)
 	if err != nil {
 		return nil, err
 	}
 
 	resp := &pb.ListReply{
 		NextPageToken: nextToken,
+		TotalCount:    total,
 	}
+	reveal := s.uc.CanViewPII(ctx)
 	for _, e := range employees {
-		resp.Items = append(resp.Items, &pb.EmployeeItem{
-			Id:          uint32(e.ID),
-			Name:        e.Name,
-			Position:    e.Position,
-			BaseSalary:  e.BaseSalary,
-			BankAccount: e.BankAccount,
-			JoinDate:    timestamppb.New(e.JoinDate),
-			Dependents:  int32(e.Dependents),
-		})
+		resp.Items = append(resp.Items, toEmployeeItem(e, reveal))
+	}
+	return resp, nil
+}
+
+func (s *EmployeeService) ExportEmployees(ctx context.Context, req *pb.ExportEmployeesRequest) (*pb.ExportEmployeesReply, error) {
+	filter := repository.EmployeeFilter{
+		Name:         req.Name,
+		DepartmentID: uint(req.DepartmentId),
+		PositionID:   uint(req.PositionId),
+		Status:       req.Status,
+		SortBy:       req.SortBy,
+		Descending:   req.Descending,
+	}
+	if req.JoinedFrom != nil {
+		from := req.JoinedFrom.AsTime()
+		filter.JoinedFrom = &from
+	}
+	if req.JoinedTo != nil {
+		to := req.JoinedTo.AsTime()
+		filter.JoinedTo = &to
+	}
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = "csv"
+	}
+	content, err := s.uc.Export(ctx, filter, req.CustomFields, format)
+	if err != nil {
+		return nil, err
+	}
+	filename := fmt.Sprintf("employees_%s.%s", time.Now().Format("20060102"), format)
+
+	hctx, ok := ctx.(http.Context)
+	if !ok {
+		return &pb.ExportEmployeesReply{Content: content, Filename: filename}, nil
+	}
+
+	w := hctx.Response()
+	contentType := "text/csv; charset=utf-8"
+	if format == "xlsx" {
+		contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(content)))
+
+	if _, err := w.Write(content); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write export")
+	}
+
+	return &pb.ExportEmployeesReply{}, nil
+}
+
+func (s *EmployeeService) ImportEmployees(ctx context.Context, req *pb.ImportEmployeesRequest) (*pb.ImportEmployeesReply, error) {
+	result, err := s.uc.Import(ctx, req.Format, req.Content, req.ColumnMap, req.Mode, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ImportEmployeesReply{
+		TotalRows:    int32(len(result.Rows)),
+		ValidRows:    int32(result.Valid),
+		ImportedRows: int32(result.Imported),
+		DryRun:       result.DryRun,
+		Mode:         result.Mode,
+	}
+	for _, r := range result.Rows {
+		row := &pb.EmployeeImportRow{
+			Row:    int32(r.Row),
+			Errors: r.Errors,
+		}
+		if r.Employee != nil {
+			row.Name = r.Employee.Name
+		}
+		resp.Rows = append(resp.Rows, row)
 	}
 	return resp, nil
 }
@@ -47,51 +141,39 @@ func (s *EmployeeService) Get(ctx context.Context, req *pb.GetRequest) (*pb.GetR
 		return nil, err
 	}
 	return &pb.GetReply{
-		Item: &pb.EmployeeItem{
-			Id:          uint32(employee.ID),
-			Name:        employee.Name,
-			Position:    employee.Position,
-			BaseSalary:  employee.BaseSalary,
-			BankAccount: employee.BankAccount,
-			JoinDate:    timestamppb.New(employee.JoinDate),
-			Dependents:  int32(employee.Dependents),
-		},
+		Item: toEmployeeItem(employee, s.uc.CanViewPII(ctx)),
 	}, nil
 }
 
 func (s *EmployeeService) Create(ctx context.Context, req *pb.CreateRequest) (*pb.CreateReply, error) {
-	employee, err := s.uc.Create(ctx, req.Name, req.Position, req.BaseSalary, req.BankAccount, req.JoinDate.AsTime(), int(req.Dependents))
+	employee, err := s.uc.Create(ctx, req.Name, req.Position, req.BaseSalary, req.BankAccount, req.JoinDate.AsTime(), int(req.Dependents), req.Status, biz.Contact{
+		WorkEmail:      req.WorkEmail,
+		PersonalEmail:  req.PersonalEmail,
+		Phone:          req.Phone,
+		Address:        req.Address,
+		PayslipChannel: req.PayslipChannel,
+	}, req.CustomFields)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.CreateReply{
-		Item: &pb.EmployeeItem{
-			Id:          uint32(employee.ID),
-			Name:        employee.Name,
-			Position:    employee.Position,
-			BaseSalary:  employee.BaseSalary,
-			BankAccount: employee.BankAccount,
-			JoinDate:    timestamppb.New(employee.JoinDate),
-			Dependents:  int32(employee.Dependents),
-		},
+		Item: toEmployeeItem(employee, s.uc.CanViewPII(ctx)),
 	}, nil
 }
 
 func (s *EmployeeService) Update(ctx context.Context, req *pb.UpdateRequest) (*pb.UpdateReply, error) {
-	employee, err := s.uc.Update(ctx, req.Id, req.Name, req.Position, req.BaseSalary, req.BankAccount, req.JoinDate.AsTime(), int(req.Dependents))
+	employee, err := s.uc.Update(ctx, req.Id, req.Name, req.Position, req.BaseSalary, req.BankAccount, req.JoinDate.AsTime(), int(req.Dependents), biz.Contact{
+		WorkEmail:      req.WorkEmail,
+		PersonalEmail:  req.PersonalEmail,
+		Phone:          req.Phone,
+		Address:        req.Address,
+		PayslipChannel: req.PayslipChannel,
+	}, req.CustomFields)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.UpdateReply{
-		Item: &pb.EmployeeItem{
-			Id:          uint32(employee.ID),
-			Name:        employee.Name,
-			Position:    employee.Position,
-			BaseSalary:  employee.BaseSalary,
-			BankAccount: employee.BankAccount,
-			JoinDate:    timestamppb.New(employee.JoinDate),
-			Dependents:  int32(employee.Dependents),
-		},
+		Item: toEmployeeItem(employee, s.uc.CanViewPII(ctx)),
 	}, nil
 }
 
@@ -101,4 +183,288 @@ func (s *EmployeeService) Delete(ctx context.Context, req *pb.DeleteRequest) (*p
 		return nil, err
 	}
 	return &pb.DeleteReply{}, nil
-}
\ No newline at end of file
+}
+
+func (s *EmployeeService) CreateContract(ctx context.Context, req *pb.CreateContractRequest) (*pb.CreateContractReply, error) {
+	var endDate *time.Time
+	if req.EndDate != nil {
+		t := req.EndDate.AsTime()
+		endDate = &t
+	}
+	contract, err := s.employment.CreateContract(ctx,
+		uint(req.EmployeeId),
+		req.Type,
+		req.StartDate.AsTime(),
+		endDate,
+		req.ProbationSalaryPercent,
+		req.Note,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateContractReply{Item: toContractItem(contract)}, nil
+}
+
+func (s *EmployeeService) ListContracts(ctx context.Context, req *pb.ListContractsRequest) (*pb.ListContractsReply, error) {
+	contracts, err := s.employment.ListContracts(ctx, uint(req.EmployeeId))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListContractsReply{}
+	for _, c := range contracts {
+		resp.Items = append(resp.Items, toContractItem(c))
+	}
+	return resp, nil
+}
+
+func (s *EmployeeService) ChangeStatus(ctx context.Context, req *pb.ChangeStatusRequest) (*pb.ChangeStatusReply, error) {
+	employee, err := s.employment.ChangeStatus(ctx, uint(req.Id), req.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChangeStatusReply{Item: toEmployeeItem(employee, s.uc.CanViewPII(ctx))}, nil
+}
+
+func (s *EmployeeService) Terminate(ctx context.Context, req *pb.TerminateRequest) (*pb.TerminateReply, error) {
+	termination, err := s.employment.Terminate(ctx, uint(req.Id), req.LastWorkingDay.AsTime(), req.Reason, req.Note, req.Preview)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TerminateReply{Settlement: toSettlementItem(termination, s.uc.CanViewPII(ctx))}, nil
+}
+
+func (s *EmployeeService) GetTermination(ctx context.Context, req *pb.GetTerminationRequest) (*pb.GetTerminationReply, error) {
+	termination, err := s.employment.GetTermination(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTerminationReply{Settlement: toSettlementItem(termination, s.uc.CanViewPII(ctx))}, nil
+}
+
+func (s *EmployeeService) AddDependent(ctx context.Context, req *pb.AddDependentRequest) (*pb.AddDependentReply, error) {
+	var dateOfBirth *time.Time
+	if req.DateOfBirth != nil {
+		t := req.DateOfBirth.AsTime()
+		dateOfBirth = &t
+	}
+	dependent, err := s.employment.AddDependent(ctx,
+		uint(req.EmployeeId),
+		req.Name,
+		req.Relationship,
+		req.TaxId,
+		dateOfBirth,
+		req.FromMonth,
+		req.ToMonth,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddDependentReply{Item: toDependentItem(dependent, s.uc.CanViewPII(ctx))}, nil
+}
+
+func (s *EmployeeService) ListDependents(ctx context.Context, req *pb.ListDependentsRequest) (*pb.ListDependentsReply, error) {
+	dependents, err := s.employment.ListDependents(ctx, uint(req.EmployeeId))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListDependentsReply{}
+	reveal := s.uc.CanViewPII(ctx)
+	for _, d := range dependents {
+		resp.Items = append(resp.Items, toDependentItem(d, reveal))
+	}
+	return resp, nil
+}
+
+func (s *EmployeeService) EndDependent(ctx context.Context, req *pb.EndDependentRequest) (*pb.EndDependentReply, error) {
+	dependent, err := s.employment.EndDependent(ctx, uint(req.EmployeeId), uint(req.Id), req.ToMonth)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EndDependentReply{Item: toDependentItem(dependent, s.uc.CanViewPII(ctx))}, nil
+}
+
+func (s *EmployeeService) RotateEncryptionKeys(ctx context.Context, req *pb.RotateEncryptionKeysRequest) (*pb.RotateEncryptionKeysReply, error) {
+	keyID, employees, dependents, err := s.uc.RotateEncryptionKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RotateEncryptionKeysReply{
+		ActiveKey:  keyID,
+		Employees:  int32(employees),
+		Dependents: int32(dependents),
+	}, nil
+}
+
+func (s *EmployeeService) CreateCustomField(ctx context.Context, req *pb.CreateCustomFieldRequest) (*pb.CreateCustomFieldReply, error) {
+	field, err := s.uc.CreateCustomField(ctx, req.Key, req.Type, biz.CustomFieldSpec{
+		Label:      req.Label,
+		Required:   req.Required,
+		EnumValues: req.EnumValues,
+		Pattern:    req.Pattern,
+		Min:        req.Min,
+		Max:        req.Max,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateCustomFieldReply{Item: toCustomFieldItem(field)}, nil
+}
+
+func (s *EmployeeService) ListCustomFields(ctx context.Context, req *pb.ListCustomFieldsRequest) (*pb.ListCustomFieldsReply, error) {
+	fields, err := s.uc.ListCustomFields(ctx, req.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.CustomFieldItem, 0, len(fields))
+	for _, f := range fields {
+		items = append(items, toCustomFieldItem(f))
+	}
+	return &pb.ListCustomFieldsReply{Items: items}, nil
+}
+
+func (s *EmployeeService) UpdateCustomField(ctx context.Context, req *pb.UpdateCustomFieldRequest) (*pb.UpdateCustomFieldReply, error) {
+	field, err := s.uc.UpdateCustomField(ctx, uint(req.Id), biz.CustomFieldSpec{
+		Label:      req.Label,
+		Required:   req.Required,
+		EnumValues: req.EnumValues,
+		Pattern:    req.Pattern,
+		Min:        req.Min,
+		Max:        req.Max,
+	}, req.Active)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateCustomFieldReply{Item: toCustomFieldItem(field)}, nil
+}
+
+func toCustomFieldItem(f *model.CustomField) *pb.CustomFieldItem {
+	return &pb.CustomFieldItem{
+		Id:         uint32(f.ID),
+		Key:        f.Key,
+		Label:      f.Label,
+		Type:       f.Type,
+		Required:   f.Required,
+		EnumValues: f.EnumValues,
+		Pattern:    f.Pattern,
+		Min:        f.Min,
+		Max:        f.Max,
+		Active:     f.Active,
+	}
+}
+
+// toCustomFieldValue types a stored value by its field definition.
+func toCustomFieldValue(field *model.CustomField, value string) *pb.CustomFieldValue {
+	switch field.Type {
+	case model.FieldNumber:
+		n, _ := strconv.ParseFloat(value, 64)
+		return &pb.CustomFieldValue{Kind: &pb.CustomFieldValue_NumberValue{NumberValue: n}}
+	case model.FieldBoolean:
+		return &pb.CustomFieldValue{Kind: &pb.CustomFieldValue_BoolValue{BoolValue: value == "true"}}
+	case model.FieldDate:
+		return &pb.CustomFieldValue{Kind: &pb.CustomFieldValue_DateValue{DateValue: value}}
+	}
+	return &pb.CustomFieldValue{Kind: &pb.CustomFieldValue_StringValue{StringValue: value}}
+}
+
+func toDependentItem(d *model.Dependent, reveal bool) *pb.DependentItem {
+	item := &pb.DependentItem{
+		Id:           uint32(d.ID),
+		EmployeeId:   uint32(d.EmployeeID),
+		Name:         d.Name,
+		Relationship: d.Relationship,
+		TaxId:        d.TaxID,
+		FromMonth:    d.FromMonth.Format("2006-01"),
+	}
+	if !reveal {
+		item.TaxId = biz.MaskTail(d.TaxID)
+	}
+	if d.DateOfBirth != nil {
+		item.DateOfBirth = timestamppb.New(*d.DateOfBirth)
+	}
+	if d.ToMonth != nil {
+		item.ToMonth = d.ToMonth.Format("2006-01")
+	}
+	return item
+}
+
+func toContractItem(c *model.Contract) *pb.ContractItem {
+	item := &pb.ContractItem{
+		Id:                     uint32(c.ID),
+		EmployeeId:             uint32(c.EmployeeID),
+		Type:                   c.Type,
+		StartDate:              timestamppb.New(c.StartDate),
+		ProbationSalaryPercent: c.ProbationSalaryPercent,
+		Note:                   c.Note,
+	}
+	if c.EndDate != nil {
+		item.EndDate = timestamppb.New(*c.EndDate)
+	}
+	return item
+}
+
+// toSettlementItem converts t for a response. Unless reveal is set the
+// amounts are left out, as they disclose the salary.
+func toSettlementItem(t *model.Termination, reveal bool) *pb.SettlementItem {
+	item := &pb.SettlementItem{
+		EmployeeId:      uint32(t.EmployeeID),
+		LastWorkingDay:  timestamppb.New(t.LastWorkingDay),
+		Reason:          t.Reason,
+		Note:            t.Note,
+		WorkingDays:     int32(t.WorkingDays),
+		ProratedSalary:  t.ProratedSalary,
+		UnusedLeaveDays: t.UnusedLeaveDays,
+		LeavePayout:     t.LeavePayout,
+		ServiceYears:    t.ServiceYears,
+		Severance:       t.Severance,
+		Total:           t.Total,
+	}
+	if !reveal {
+		item.ProratedSalary = 0
+		item.LeavePayout = 0
+		item.Severance = 0
+		item.Total = 0
+		item.PiiMasked = true
+	}
+	return item
+}
+
+// toEmployeeItem converts e for a response. Unless reveal is set the bank
+// account is cut to its last 4 digits and the salary left out.
+func toEmployeeItem(e *model.Employee, reveal bool) *pb.EmployeeItem {
+	item := &pb.EmployeeItem{
+		Id:             uint32(e.ID),
+		Name:           e.Name,
+		Position:       e.Position,
+		BaseSalary:     e.BaseSalary,
+		BankAccount:    e.BankAccount,
+		JoinDate:       timestamppb.New(e.JoinDate),
+		Dependents:     int32(e.Dependents),
+		DepartmentId:   idOrZero(e.DepartmentID),
+		PositionId:     idOrZero(e.PositionID),
+		ManagerId:      idOrZero(e.ManagerID),
+		Status:         e.Status,
+		WorkEmail:      e.WorkEmail,
+		PersonalEmail:  e.PersonalEmail,
+		Phone:          e.Phone,
+		Address:        e.Address,
+		PayslipChannel: e.PayslipChannel,
+	}
+	if e.TerminatedAt != nil {
+		item.TerminatedAt = timestamppb.New(*e.TerminatedAt)
+	}
+	for _, v := range e.FieldValues {
+		if !v.Field.Active {
+			continue
+		}
+		if item.CustomFields == nil {
+			item.CustomFields = make(map[string]*pb.CustomFieldValue)
+		}
+		item.CustomFields[v.Field.Key] = toCustomFieldValue(&v.Field, v.Value)
+	}
+	if !reveal {
+		item.BankAccount = biz.MaskTail(e.BankAccount)
+		item.BaseSalary = 0
+		item.PiiMasked = true
+	}
+	return item
+}
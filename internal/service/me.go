@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "myapp/api/me/v1"
+	"myapp/internal/biz"
+	"myapp/internal/repository"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MeService serves the caller's own records, found through the employee
+// linked to their account.
+type MeService struct {
+	pb.UnimplementedMeServer
+	employees  *biz.EmployeeUsecase
+	employment *biz.EmploymentUsecase
+	timesheets *biz.TimesheetUsecase
+	payroll    *biz.PayrollUsecase
+}
+
+func NewMeService(employees *biz.EmployeeUsecase, employment *biz.EmploymentUsecase, timesheets *biz.TimesheetUsecase, payroll *biz.PayrollUsecase) *MeService {
+	return &MeService{employees: employees, employment: employment, timesheets: timesheets, payroll: payroll}
+}
+
+// employeeID returns the caller's employee ID, mapping a missing link to
+// FailedPrecondition.
+func (s *MeService) employeeID(ctx context.Context) (uint, error) {
+	id, err := biz.CurrentEmployeeID(ctx)
+	if errors.Is(err, biz.ErrAccountNotLinked) {
+		return 0, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return id, err
+}
+
+func (s *MeService) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.GetProfileReply, error) {
+	id, err := s.employeeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	employee, err := s.employees.Get(ctx, uint32(id))
+	if err != nil {
+		return nil, err
+	}
+	user, _ := biz.UserFromContext(ctx)
+	return &pb.GetProfileReply{
+		UserId:   uint32(user.ID),
+		Username: user.Username,
+		Role:     user.Role,
+		// Employees always see their own personal data unmasked.
+		Employee: toEmployeeItem(employee, true),
+	}, nil
+}
+
+func (s *MeService) ListMyTimesheets(ctx context.Context, req *pb.ListMyTimesheetsRequest) (*pb.ListMyTimesheetsReply, error) {
+	id, err := s.employeeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := repository.TimesheetFilter{EmployeeID: id, Status: req.Status}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+	rows, nextToken, err := s.timesheets.List(ctx, filter, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListMyTimesheetsReply{NextPageToken: nextToken}
+	for _, ts := range rows {
+		resp.Items = append(resp.Items, toTimesheetItem(ts))
+	}
+	return resp, nil
+}
+
+func (s *MeService) ListMyPayslips(ctx context.Context, req *pb.ListMyPayslipsRequest) (*pb.ListMyPayslipsReply, error) {
+	id, err := s.employeeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payrolls, nextToken, err := s.payroll.ListPayrolls(ctx, "", id, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListMyPayslipsReply{NextPageToken: nextToken}
+	for _, p := range payrolls {
+		resp.Items = append(resp.Items, toPayrollItem(p))
+	}
+	return resp, nil
+}
+
+func (s *MeService) GetMyPayslipPDF(ctx context.Context, req *pb.GetMyPayslipPDFRequest) (*pb.GetMyPayslipPDFReply, error) {
+	id, err := s.employeeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pdfData, err := s.payroll.ExportPayrollPDF(ctx, uint32(id), req.MonthYear)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot generate PDF: %v", err)
+	}
+	filename := fmt.Sprintf("payslip_%s.pdf", req.MonthYear)
+
+	hctx, ok := ctx.(http.Context)
+	if !ok {
+		return &pb.GetMyPayslipPDFReply{PdfData: pdfData, Filename: filename}, nil
+	}
+	w := hctx.Response()
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(pdfData)))
+	if _, err := w.Write(pdfData); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write PDF")
+	}
+	return &pb.GetMyPayslipPDFReply{}, nil
+}
+
+func (s *MeService) GetMyLeaveBalance(ctx context.Context, req *pb.GetMyLeaveBalanceRequest) (*pb.GetMyLeaveBalanceReply, error) {
+	id, err := s.employeeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := s.employment.LeaveBalance(ctx, id, int(req.Year))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMyLeaveBalanceReply{
+		Year:          int32(balance.Year),
+		EntitledDays:  balance.Entitled,
+		AccruedDays:   balance.Accrued,
+		UsedDays:      balance.Used,
+		RemainingDays: balance.Remaining,
+	}, nil
+}
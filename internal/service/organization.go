@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+
+	pb "myapp/api/organization/v1"
+	"myapp/internal/biz"
+	"myapp/internal/data/model"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OrganizationService struct {
+	pb.UnimplementedOrganizationServer
+	uc *biz.OrganizationUsecase
+}
+
+func NewOrganizationService(uc *biz.OrganizationUsecase) *OrganizationService {
+	return &OrganizationService{uc: uc}
+}
+
+func (s *OrganizationService) CreateDepartment(ctx context.Context, req *pb.CreateDepartmentRequest) (*pb.CreateDepartmentReply, error) {
+	department, err := s.uc.CreateDepartment(ctx, req.Name, req.Code, uint(req.ParentId), uint(req.HeadId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateDepartmentReply{Item: toDepartmentItem(department)}, nil
+}
+
+func (s *OrganizationService) ListDepartments(ctx context.Context, req *pb.ListDepartmentsRequest) (*pb.ListDepartmentsReply, error) {
+	departments, err := s.uc.ListDepartments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListDepartmentsReply{}
+	for _, d := range departments {
+		resp.Items = append(resp.Items, toDepartmentItem(d))
+	}
+	return resp, nil
+}
+
+func (s *OrganizationService) CreatePosition(ctx context.Context, req *pb.CreatePositionRequest) (*pb.CreatePositionReply, error) {
+	position, err := s.uc.CreatePosition(ctx, req.Title, uint(req.DepartmentId), int(req.Level))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreatePositionReply{Item: toPositionItem(position)}, nil
+}
+
+func (s *OrganizationService) ListPositions(ctx context.Context, req *pb.ListPositionsRequest) (*pb.ListPositionsReply, error) {
+	positions, err := s.uc.ListPositions(ctx, uint(req.DepartmentId))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListPositionsReply{}
+	for _, p := range positions {
+		resp.Items = append(resp.Items, toPositionItem(p))
+	}
+	return resp, nil
+}
+
+func (s *OrganizationService) GetOrgTree(ctx context.Context, req *pb.GetOrgTreeRequest) (*pb.GetOrgTreeReply, error) {
+	roots, err := s.uc.OrgTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetOrgTreeReply{}
+	for _, node := range roots {
+		resp.Roots = append(resp.Roots, toDepartmentNode(node))
+	}
+	return resp, nil
+}
+
+func (s *OrganizationService) MoveEmployee(ctx context.Context, req *pb.MoveEmployeeRequest) (*pb.MoveEmployeeReply, error) {
+	assignment, err := s.uc.MoveEmployee(ctx,
+		uint(req.EmployeeId),
+		uint(req.DepartmentId),
+		uint(req.PositionId),
+		uint(req.ManagerId),
+		req.EffectiveFrom.AsTime(),
+		req.Reason,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MoveEmployeeReply{Item: toAssignmentItem(assignment)}, nil
+}
+
+func (s *OrganizationService) ListAssignments(ctx context.Context, req *pb.ListAssignmentsRequest) (*pb.ListAssignmentsReply, error) {
+	assignments, err := s.uc.ListAssignments(ctx, uint(req.EmployeeId))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListAssignmentsReply{}
+	for _, a := range assignments {
+		resp.Items = append(resp.Items, toAssignmentItem(a))
+	}
+	return resp, nil
+}
+
+func (s *OrganizationService) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsReply, error) {
+	reports, err := s.uc.ListReports(ctx, uint(req.EmployeeId), req.Indirect)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListReportsReply{}
+	for _, r := range reports {
+		resp.Items = append(resp.Items, &pb.ReportItem{
+			EmployeeId:   uint32(r.Employee.ID),
+			Name:         r.Employee.Name,
+			DepartmentId: idOrZero(r.Employee.DepartmentID),
+			PositionId:   idOrZero(r.Employee.PositionID),
+			ManagerId:    idOrZero(r.Employee.ManagerID),
+			Depth:        int32(r.Depth),
+		})
+	}
+	return resp, nil
+}
+
+func toDepartmentItem(d *model.Department) *pb.DepartmentItem {
+	return &pb.DepartmentItem{
+		Id:       uint32(d.ID),
+		Name:     d.Name,
+		Code:     d.Code,
+		ParentId: idOrZero(d.ParentID),
+		HeadId:   idOrZero(d.HeadID),
+	}
+}
+
+func toDepartmentNode(node *biz.DepartmentNode) *pb.DepartmentNode {
+	item := &pb.DepartmentNode{
+		Department: toDepartmentItem(node.Department),
+		Headcount:  int32(node.Headcount),
+	}
+	for _, child := range node.Children {
+		item.Children = append(item.Children, toDepartmentNode(child))
+	}
+	return item
+}
+
+func toPositionItem(p *model.Position) *pb.PositionItem {
+	return &pb.PositionItem{
+		Id:           uint32(p.ID),
+		Title:        p.Title,
+		DepartmentId: idOrZero(p.DepartmentID),
+		Level:        int32(p.Level),
+	}
+}
+
+func toAssignmentItem(a *model.EmployeeAssignment) *pb.AssignmentItem {
+	item := &pb.AssignmentItem{
+		Id:            uint32(a.ID),
+		EmployeeId:    uint32(a.EmployeeID),
+		DepartmentId:  idOrZero(a.DepartmentID),
+		PositionId:    idOrZero(a.PositionID),
+		ManagerId:     idOrZero(a.ManagerID),
+		EffectiveFrom: timestamppb.New(a.EffectiveFrom),
+		Reason:        a.Reason,
+	}
+	if a.EffectiveTo != nil {
+		item.EffectiveTo = timestamppb.New(*a.EffectiveTo)
+	}
+	return item
+}
+
+// idOrZero converts an optional foreign key to its proto form, where 0 means unset.
+func idOrZero(id *uint) uint32 {
+	if id == nil {
+		return 0
+	}
+	return uint32(*id)
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	v1 "myapp/api/payroll/v1"
 	"myapp/internal/biz"
+	"myapp/internal/data/model"
 
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"google.golang.org/grpc/codes"
@@ -51,13 +53,122 @@ func (s *PayrollService) ExportPayrollPDF(ctx context.Context, req *v1.ExportPay
 	return &v1.ExportPayrollPDFReply{}, nil
 }
 
+func (s *PayrollService) ExportBankTransfer(ctx context.Context, req *v1.ExportBankTransferRequest) (*v1.ExportBankTransferReply, error) {
+	csvData, err := s.uc.ExportBankTransfer(ctx, req.MonthYear)
+	if err != nil {
+		return nil, err
+	}
+	filename := fmt.Sprintf("bank_transfer_%s.csv", req.MonthYear)
+
+	hctx, ok := ctx.(http.Context)
+	if !ok {
+		return &v1.ExportBankTransferReply{CsvData: csvData, Filename: filename}, nil
+	}
+
+	w := hctx.Response()
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(csvData)))
+
+	if _, err := w.Write(csvData); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write CSV")
+	}
+
+	return &v1.ExportBankTransferReply{}, nil
+}
+
 func (s *PayrollService) SendPayslipEmail(ctx context.Context, req *v1.SendPayslipEmailRequest) (*v1.SendPayslipEmailReply, error) {
-	err := s.uc.SendPayslipEmail(ctx, req.EmployeeId, req.MonthYear, req.ToEmail)
+	sentTo, err := s.uc.SendPayslipEmail(ctx, req.EmployeeId, req.MonthYear, req.ToEmail)
+	if errors.Is(err, biz.ErrPayslipOnPaper) || errors.Is(err, biz.ErrNoPayslipAddress) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "send email failed: %v", err)
 	}
 
 	return &v1.SendPayslipEmailReply{
 		Message: "Payslip sent successfully via email",
+		SentTo:  sentTo,
 	}, nil
-}
\ No newline at end of file
+}
+func (s *PayrollService) ListPayrolls(ctx context.Context, req *v1.ListPayrollsRequest) (*v1.ListPayrollsReply, error) {
+	payrolls, nextToken, err := s.uc.ListPayrolls(ctx, req.MonthYear, uint(req.EmployeeId), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListPayrollsReply{NextPageToken: nextToken}
+	for _, p := range payrolls {
+		resp.Items = append(resp.Items, toPayrollItem(p))
+	}
+	return resp, nil
+}
+
+func toPayrollItem(p *model.Payroll) *v1.PayrollItem {
+	return &v1.PayrollItem{
+		Id:            uint32(p.ID),
+		EmployeeId:    uint32(p.EmployeeID),
+		MonthYear:     p.MonthYear.Format("2006-01"),
+		WorkingDays:   int32(p.WorkingDays),
+		OvertimeHours: p.OvertimeHours,
+		LeaveDays:     int32(p.LeaveDays),
+		Dependents:    int32(p.Dependents),
+		BasicSalary:   p.BasicSalary,
+		Allowances:    p.Allowances,
+		GrossSalary:   p.GrossSalary,
+		Deductions:    p.Deductions,
+		NetSalary:     p.NetSalary,
+		Status:        p.Status,
+	}
+}
+
+func (s *PayrollService) ListPendingTimesheets(ctx context.Context, req *v1.ListPendingTimesheetsRequest) (*v1.ListPendingTimesheetsReply, error) {
+	pending, err := s.uc.ListPendingTimesheets(ctx, req.MonthYear, uint(req.ManagerId))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ListPendingTimesheetsReply{}
+	for _, p := range pending {
+		resp.Items = append(resp.Items, &v1.PendingTimesheetsItem{
+			EmployeeId:       uint32(p.EmployeeID),
+			EmployeeName:     p.EmployeeName,
+			DraftEntries:     int32(p.Draft),
+			SubmittedEntries: int32(p.Submitted),
+		})
+	}
+	return resp, nil
+}
+
+func (s *PayrollService) DependentReport(ctx context.Context, req *v1.DependentReportRequest) (*v1.DependentReportReply, error) {
+	items, err := s.uc.DependentReport(ctx, int(req.Year))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.DependentReportReply{}
+	reveal := s.uc.CanViewPII(ctx)
+	for _, it := range items {
+		d := it.Dependent
+		item := &v1.DependentReportItem{
+			EmployeeId:    uint32(d.EmployeeID),
+			EmployeeName:  it.EmployeeName,
+			DependentId:   uint32(d.ID),
+			DependentName: d.Name,
+			Relationship:  d.Relationship,
+			TaxId:         d.TaxID,
+			FromMonth:     d.FromMonth.Format("2006-01"),
+			MonthsInYear:  int32(it.MonthsInYear),
+		}
+		if !reveal {
+			item.TaxId = biz.MaskTail(d.TaxID)
+		}
+		if d.DateOfBirth != nil {
+			item.DateOfBirth = d.DateOfBirth.Format("2006-01-02")
+		}
+		if d.ToMonth != nil {
+			item.ToMonth = d.ToMonth.Format("2006-01")
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return resp, nil
+}
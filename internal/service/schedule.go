@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "myapp/api/schedule/v1"
+	"myapp/internal/biz"
+	"myapp/internal/data/model"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ScheduleService struct {
+	pb.UnimplementedScheduleServer
+	uc *biz.ScheduleUsecase
+}
+
+func NewScheduleService(uc *biz.ScheduleUsecase) *ScheduleService {
+	return &ScheduleService{uc: uc}
+}
+
+func (s *ScheduleService) CreateShift(ctx context.Context, req *pb.CreateShiftRequest) (*pb.CreateShiftReply, error) {
+	shift, err := s.uc.CreateShift(ctx, req.Name, req.StartTime, req.EndTime, int(req.BreakMinutes), req.IsNightShift)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateShiftReply{Item: toShiftItem(shift)}, nil
+}
+
+func (s *ScheduleService) ListShifts(ctx context.Context, req *pb.ListShiftsRequest) (*pb.ListShiftsReply, error) {
+	shifts, err := s.uc.ListShifts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListShiftsReply{}
+	for _, shift := range shifts {
+		resp.Items = append(resp.Items, toShiftItem(shift))
+	}
+	return resp, nil
+}
+
+func (s *ScheduleService) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.CreateScheduleReply, error) {
+	shiftIDs := make([]uint, 0, len(req.ShiftIds))
+	for _, id := range req.ShiftIds {
+		shiftIDs = append(shiftIDs, uint(id))
+	}
+	schedule, err := s.uc.CreateSchedule(ctx, req.Name, req.CycleStart.AsTime(), shiftIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateScheduleReply{Item: toScheduleItem(schedule)}, nil
+}
+
+func (s *ScheduleService) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ListSchedulesReply, error) {
+	schedules, err := s.uc.ListSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListSchedulesReply{}
+	for _, schedule := range schedules {
+		resp.Items = append(resp.Items, toScheduleItem(schedule))
+	}
+	return resp, nil
+}
+
+func (s *ScheduleService) AssignSchedule(ctx context.Context, req *pb.AssignScheduleRequest) (*pb.AssignScheduleReply, error) {
+	var effectiveTo *time.Time
+	if req.EffectiveTo != nil {
+		t := req.EffectiveTo.AsTime()
+		effectiveTo = &t
+	}
+	assignment, err := s.uc.AssignSchedule(ctx, uint(req.ScheduleId), uint(req.EmployeeId), req.EffectiveFrom.AsTime(), effectiveTo)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AssignScheduleReply{AssignmentId: uint32(assignment.ID)}, nil
+}
+
+func (s *ScheduleService) GetEmployeeSchedule(ctx context.Context, req *pb.GetEmployeeScheduleRequest) (*pb.GetEmployeeScheduleReply, error) {
+	days, err := s.uc.GetEmployeeSchedule(ctx, uint(req.EmployeeId), req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetEmployeeScheduleReply{}
+	for _, d := range days {
+		day := &pb.ScheduledDay{
+			Date:       timestamppb.New(d.Date),
+			ScheduleId: uint32(d.ScheduleID),
+		}
+		if d.Shift != nil {
+			day.Shift = toShiftItem(d.Shift)
+		}
+		resp.Days = append(resp.Days, day)
+	}
+	return resp, nil
+}
+
+func toShiftItem(shift *model.Shift) *pb.ShiftItem {
+	return &pb.ShiftItem{
+		Id:             uint32(shift.ID),
+		Name:           shift.Name,
+		StartTime:      shift.StartTime,
+		EndTime:        shift.EndTime,
+		BreakMinutes:   int32(shift.BreakMinutes),
+		IsNightShift:   shift.IsNightShift,
+		ScheduledHours: biz.ShiftHours(shift),
+	}
+}
+
+func toScheduleItem(schedule *model.WorkSchedule) *pb.ScheduleItem {
+	item := &pb.ScheduleItem{
+		Id:         uint32(schedule.ID),
+		Name:       schedule.Name,
+		CycleStart: timestamppb.New(schedule.CycleStart),
+	}
+	for _, p := range strings.Split(schedule.Pattern, ",") {
+		id, _ := strconv.ParseUint(p, 10, 32)
+		item.ShiftIds = append(item.ShiftIds, uint32(id))
+	}
+	return item
+}
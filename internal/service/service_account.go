@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "myapp/api/auth/v1"
+	"myapp/internal/biz"
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AuthService) CreateServiceAccount(ctx context.Context, req *pb.CreateServiceAccountRequest) (*pb.ServiceAccountReply, error) {
+	account, err := s.serviceAccounts.Create(ctx, req.Name, req.Description)
+	if err != nil {
+		return nil, serviceAccountError(err)
+	}
+	return &pb.ServiceAccountReply{Item: toServiceAccountItem(account)}, nil
+}
+
+func (s *AuthService) ListServiceAccounts(ctx context.Context, req *pb.ListServiceAccountsRequest) (*pb.ListServiceAccountsReply, error) {
+	accounts, err := s.serviceAccounts.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListServiceAccountsReply{}
+	for _, a := range accounts {
+		resp.Items = append(resp.Items, toServiceAccountItem(a))
+	}
+	return resp, nil
+}
+
+func (s *AuthService) DisableServiceAccount(ctx context.Context, req *pb.DisableServiceAccountRequest) (*pb.ServiceAccountReply, error) {
+	account, err := s.serviceAccounts.Disable(ctx, uint(req.Id))
+	if err != nil {
+		return nil, serviceAccountError(err)
+	}
+	return &pb.ServiceAccountReply{Item: toServiceAccountItem(account)}, nil
+}
+
+func (s *AuthService) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyReply, error) {
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+	key, raw, err := s.serviceAccounts.CreateKey(ctx, uint(req.Id), req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		return nil, serviceAccountError(err)
+	}
+	return &pb.CreateAPIKeyReply{Item: toAPIKeyItem(key), Key: raw}, nil
+}
+
+func (s *AuthService) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.APIKeyReply, error) {
+	key, err := s.serviceAccounts.RevokeKey(ctx, uint(req.Id))
+	if err != nil {
+		return nil, serviceAccountError(err)
+	}
+	return &pb.APIKeyReply{Item: toAPIKeyItem(key)}, nil
+}
+
+func serviceAccountError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrServiceAccountNotFound), errors.Is(err, repository.ErrAPIKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, biz.ErrServiceAccountDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, biz.ErrServiceAccountName), errors.Is(err, biz.ErrAPIKeyScopes),
+		errors.Is(err, biz.ErrAPIKeyExpiry), errors.Is(err, biz.ErrInvalidScope):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func toServiceAccountItem(a *model.ServiceAccount) *pb.ServiceAccountItem {
+	item := &pb.ServiceAccountItem{
+		Id:          uint32(a.ID),
+		Name:        a.Name,
+		Description: a.Description,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+	if a.DisabledAt != nil {
+		item.DisabledAt = timestamppb.New(*a.DisabledAt)
+	}
+	for i := range a.Keys {
+		item.Keys = append(item.Keys, toAPIKeyItem(&a.Keys[i]))
+	}
+	return item
+}
+
+func toAPIKeyItem(k *model.APIKey) *pb.APIKeyItem {
+	item := &pb.APIKeyItem{
+		Id:               uint32(k.ID),
+		ServiceAccountId: uint32(k.ServiceAccountID),
+		Name:             k.Name,
+		Prefix:           biz.APIKeyPrefix + k.Prefix,
+		Scopes:           k.Scopes,
+		ExpiresAt:        timestamppb.New(k.ExpiresAt),
+		LastUsedIp:       k.LastUsedIP,
+		CreatedAt:        timestamppb.New(k.CreatedAt),
+	}
+	if k.LastUsedAt != nil {
+		item.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+	if k.RevokedAt != nil {
+		item.RevokedAt = timestamppb.New(*k.RevokedAt)
+	}
+	return item
+}
@@ -6,6 +6,10 @@ import (
 
 	v1 "myapp/api/timesheet/v1"
 	"myapp/internal/biz"
+	"myapp/internal/data/model"
+	"myapp/internal/repository"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type TimesheetService struct {
@@ -18,9 +22,192 @@ func NewTimesheetService(uc *biz.TimesheetUsecase) *TimesheetService {
 }
 
 func (s *TimesheetService) Create(ctx context.Context, req *v1.CreateTimesheetRequest) (*v1.CreateTimesheetReply, error) {
-	err := s.uc.Create(ctx, req)
+	warnings, err := s.uc.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateTimesheetReply{Message: "attendance recorded successfully", Warnings: warnings}, nil
+}
+
+func (s *TimesheetService) Update(ctx context.Context, req *v1.UpdateTimesheetRequest) (*v1.UpdateTimesheetReply, error) {
+	warnings, err := s.uc.Update(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UpdateTimesheetReply{Message: "attendance updated successfully", Warnings: warnings}, nil
+}
+
+func (s *TimesheetService) ListTimesheets(ctx context.Context, req *v1.ListTimesheetsRequest) (*v1.ListTimesheetsReply, error) {
+	filter := repository.TimesheetFilter{
+		EmployeeID: uint(req.EmployeeId),
+		Status:     req.Status,
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+	rows, nextToken, err := s.uc.List(ctx, filter, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListTimesheetsReply{NextPageToken: nextToken}
+	for _, ts := range rows {
+		resp.Items = append(resp.Items, toTimesheetItem(ts))
+	}
+	return resp, nil
+}
+
+func (s *TimesheetService) OvertimeReport(ctx context.Context, req *v1.OvertimeReportRequest) (*v1.OvertimeReportReply, error) {
+	usage, err := s.uc.OvertimeReport(ctx, req.MonthYear)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.OvertimeReportReply{}
+	for _, u := range usage {
+		resp.Items = append(resp.Items, &v1.OvertimeUsage{
+			EmployeeId:   uint32(u.EmployeeID),
+			EmployeeName: u.EmployeeName,
+			MonthlyHours: u.MonthlyHours,
+			MonthlyLimit: u.MonthlyLimit,
+			YearlyHours:  u.YearlyHours,
+			YearlyLimit:  u.YearlyLimit,
+			Exceeded:     u.Exceeded,
+		})
+	}
+	return resp, nil
+}
+
+func (s *TimesheetService) AnomalyReport(ctx context.Context, req *v1.AnomalyReportRequest) (*v1.AnomalyReportReply, error) {
+	anomalies, err := s.uc.AnomalyReport(ctx, req.MonthYear, uint(req.EmployeeId), uint(req.ManagerId))
 	if err != nil {
 		return nil, err
 	}
-	return &v1.CreateTimesheetReply{Message: "attendance recorded successfully"}, nil
-}
\ No newline at end of file
+	resp := &v1.AnomalyReportReply{}
+	for _, a := range anomalies {
+		item := &v1.Anomaly{
+			EmployeeId:   uint32(a.EmployeeID),
+			EmployeeName: a.EmployeeName,
+			Type:         a.Type,
+			Detail:       a.Detail,
+		}
+		if a.WorkDate != nil {
+			item.WorkDate = timestamppb.New(*a.WorkDate)
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return resp, nil
+}
+
+func (s *TimesheetService) SubmitPeriod(ctx context.Context, req *v1.SubmitPeriodRequest) (*v1.SubmitPeriodReply, error) {
+	period, err := s.uc.SubmitPeriod(ctx, uint(req.EmployeeId), req.PeriodType, req.Date.AsTime())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SubmitPeriodReply{Item: toPeriodItem(period)}, nil
+}
+
+func (s *TimesheetService) ApprovePeriod(ctx context.Context, req *v1.ReviewPeriodRequest) (*v1.ReviewPeriodReply, error) {
+	period, err := s.uc.ApprovePeriod(ctx, uint(req.Id), req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReviewPeriodReply{Item: toPeriodItem(period)}, nil
+}
+
+func (s *TimesheetService) RejectPeriod(ctx context.Context, req *v1.ReviewPeriodRequest) (*v1.ReviewPeriodReply, error) {
+	period, err := s.uc.RejectPeriod(ctx, uint(req.Id), req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReviewPeriodReply{Item: toPeriodItem(period)}, nil
+}
+
+func (s *TimesheetService) ListPeriods(ctx context.Context, req *v1.ListPeriodsRequest) (*v1.ListPeriodsReply, error) {
+	periods, err := s.uc.ListPeriods(ctx, req.Status, uint(req.EmployeeId), uint(req.ManagerId), req.Indirect)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.ListPeriodsReply{}
+	for _, p := range periods {
+		resp.Items = append(resp.Items, toPeriodItem(p))
+	}
+	return resp, nil
+}
+
+func (s *TimesheetService) ImportTimesheets(ctx context.Context, req *v1.ImportTimesheetsRequest) (*v1.ImportTimesheetsReply, error) {
+	result, err := s.uc.Import(ctx, req.Format, req.Content, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ImportTimesheetsReply{
+		TotalRows:    int32(len(result.Rows)),
+		ValidRows:    int32(result.Valid),
+		ImportedRows: int32(result.Imported),
+		DryRun:       result.DryRun,
+	}
+	for _, r := range result.Rows {
+		row := &v1.ImportRowResult{
+			Row:      int32(r.Row),
+			Errors:   r.Errors,
+			Warnings: r.Warnings,
+		}
+		if ts := r.Timesheet; ts != nil {
+			row.EmployeeId = uint32(ts.EmployeeID)
+			row.WorkDate = timestamppb.New(ts.WorkDate)
+			row.HoursWorked = ts.HoursWorked
+			row.OvertimeHours = ts.OvertimeHours
+			row.IsLeave = ts.IsLeave
+		}
+		resp.Rows = append(resp.Rows, row)
+	}
+	return resp, nil
+}
+
+func toTimesheetItem(ts *model.Timesheet) *v1.TimesheetItem {
+	item := &v1.TimesheetItem{
+		Id:             uint32(ts.ID),
+		EmployeeId:     uint32(ts.EmployeeID),
+		WorkDate:       timestamppb.New(ts.WorkDate),
+		HoursWorked:    ts.HoursWorked,
+		OvertimeHours:  ts.OvertimeHours,
+		IsLeave:        ts.IsLeave,
+		LeaveType:      ts.LeaveType,
+		Note:           ts.Note,
+		ShiftId:        idOrZero(ts.ShiftID),
+		ScheduledHours: ts.ScheduledHours,
+		LateMinutes:    int32(ts.LateMinutes),
+		Status:         ts.Status,
+	}
+	if ts.CheckIn != nil {
+		item.CheckIn = timestamppb.New(*ts.CheckIn)
+	}
+	if ts.CheckOut != nil {
+		item.CheckOut = timestamppb.New(*ts.CheckOut)
+	}
+	return item
+}
+
+func toPeriodItem(p *model.TimesheetPeriod) *v1.TimesheetPeriodItem {
+	item := &v1.TimesheetPeriodItem{
+		Id:          uint32(p.ID),
+		EmployeeId:  uint32(p.EmployeeID),
+		PeriodType:  p.PeriodType,
+		StartDate:   timestamppb.New(p.StartDate),
+		EndDate:     timestamppb.New(p.EndDate),
+		Status:      p.Status,
+		Comment:     p.Comment,
+		SubmittedAt: timestamppb.New(p.SubmittedAt),
+	}
+	if p.ReviewedBy != nil {
+		item.ReviewedBy = uint32(*p.ReviewedBy)
+	}
+	if p.ReviewedAt != nil {
+		item.ReviewedAt = timestamppb.New(*p.ReviewedAt)
+	}
+	return item
+}
@@ -0,0 +1,98 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// publicKey rebuilds a verification key from a JWK the way a relying party
+// would.
+func publicKey(t *testing.T, jwk JWK) interface{} {
+	t.Helper()
+	decode := func(s string) []byte {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			t.Fatalf("JWK %s: %v", jwk.Kid, err)
+		}
+		return b
+	}
+	switch jwk.Kty {
+	case "RSA":
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(decode(jwk.N)), E: int(new(big.Int).SetBytes(decode(jwk.E)).Int64())}
+	case "OKP":
+		return ed25519.PublicKey(decode(jwk.X))
+	}
+	t.Fatalf("JWK %s has unexpected type %q", jwk.Kid, jwk.Kty)
+	return nil
+}
+
+func TestJWKSRoundTrip(t *testing.T) {
+	keys := newTestKeys(t)
+	rsaRing := newTestKeyring(t, "k1", map[string]string{"k1": keys.rsaPrivate})
+	edRing := newTestKeyring(t, "k2", map[string]string{"k2": keys.ed25519Private})
+	// The served ring keeps k1 as a public key only.
+	served := newTestKeyring(t, "k2", map[string]string{"k1": keys.rsaPublic, "k2": keys.ed25519Private})
+
+	body, err := json.Marshal(served.JWKS())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var set JWKSet
+	if err := json.Unmarshal(body, &set); err != nil {
+		t.Fatal(err)
+	}
+	if len(set.Keys) != 2 || set.Keys[0].Kid != "k1" || set.Keys[1].Kid != "k2" {
+		t.Fatalf("unexpected key set %s", body)
+	}
+	for _, private := range []string{`"d"`, `"p"`, `"q"`} {
+		if strings.Contains(string(body), private) {
+			t.Fatalf("key set leaks private key material: %s", body)
+		}
+	}
+	if k := set.Keys[0]; k.Kty != "RSA" || k.Alg != AlgRS256 || k.Use != "sig" {
+		t.Fatalf("unexpected RSA JWK %+v", k)
+	}
+	if k := set.Keys[1]; k.Kty != "OKP" || k.Crv != "Ed25519" || k.Alg != AlgEdDSA || k.Use != "sig" {
+		t.Fatalf("unexpected Ed25519 JWK %+v", k)
+	}
+
+	byKid := map[string]JWK{}
+	for _, k := range set.Keys {
+		byKid[k.Kid] = k
+	}
+	for _, ring := range []*Keyring{rsaRing, edRing} {
+		raw, err := ring.Sign(claimsFor(time.Now().Add(time.Minute)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = jwt.Parse(raw, func(token *jwt.Token) (interface{}, error) {
+			jwk := byKid[token.Header["kid"].(string)]
+			if token.Method.Alg() != jwk.Alg {
+				t.Fatalf("token alg %s, JWK alg %s", token.Method.Alg(), jwk.Alg)
+			}
+			return publicKey(t, jwk), nil
+		})
+		if err != nil {
+			t.Fatalf("token of %s doesn't verify with the published key: %v", ring.ActiveKey(), err)
+		}
+	}
+}
+
+func TestJWKSEphemeral(t *testing.T) {
+	kr, err := NewEphemeralKeyring()
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := kr.JWKS()
+	if len(set.Keys) != 1 || set.Keys[0].Kid != kr.ActiveKey() || set.Keys[0].Kty != "OKP" {
+		t.Fatalf("unexpected key set %+v", set)
+	}
+}
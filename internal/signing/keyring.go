@@ -0,0 +1,162 @@
+// Package signing signs and verifies access tokens with asymmetric keys.
+// Tokens carry the ID of their key in the kid header. New tokens are signed
+// with the active key, while every key in the ring verifies, so a key can be
+// rotated without invalidating tokens already issued: add the new key, make it
+// active, and drop the old one once its tokens have expired. Retired keys may
+// be given as public keys only.
+package signing
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported algorithms, chosen by the key type.
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+var (
+	ErrNoActiveKey = errors.New("signing: active key is not configured or has no private key")
+	ErrUnknownKey  = errors.New("signing: token is signed with an unknown key")
+)
+
+// Key is one signing key. Verification-only keys have no private half.
+type Key struct {
+	ID      string
+	Alg     string
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// Keyring holds the keys by ID.
+type Keyring struct {
+	active string
+	keys   map[string]*Key
+}
+
+// NewKeyring loads keys from PEM files, keyed by key ID. The active key must
+// have its private key; the others may be public keys.
+func NewKeyring(active string, files map[string]string) (*Keyring, error) {
+	kr := &Keyring{active: active, keys: make(map[string]*Key, len(files))}
+	for id, path := range files {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("signing: read key %q: %w", id, err)
+		}
+		key, err := ParseKey(id, raw)
+		if err != nil {
+			return nil, err
+		}
+		kr.keys[id] = key
+	}
+	if key, ok := kr.keys[active]; !ok || key.private == nil {
+		return nil, ErrNoActiveKey
+	}
+	return kr, nil
+}
+
+// NewEphemeralKeyring generates a single Ed25519 key that lives as long as
+// the process. Tokens stop verifying on restart, so it only suits
+// development.
+func NewEphemeralKeyring() (*Keyring, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	key := &Key{ID: "ephemeral", Alg: AlgEdDSA, private: private, public: public}
+	return &Keyring{active: key.ID, keys: map[string]*Key{key.ID: key}}, nil
+}
+
+// ParseKey reads an RSA or Ed25519 key from PEM: a PKCS#8 or PKCS#1 private
+// key, or a PKIX public key.
+func ParseKey(id string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("signing: key %q is not PEM encoded", id)
+	}
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("signing: key %q has unsupported PEM type %q", id, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("signing: parse key %q: %w", id, err)
+	}
+
+	key := &Key{ID: id}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Alg, key.private, key.public = AlgRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.Alg, key.public = AlgRS256, k
+	case ed25519.PrivateKey:
+		key.Alg, key.private, key.public = AlgEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.Alg, key.public = AlgEdDSA, k
+	default:
+		return nil, fmt.Errorf("signing: key %q must be RSA or Ed25519", id)
+	}
+	if rsaKey, ok := key.public.(*rsa.PublicKey); ok && rsaKey.N.BitLen() < 2048 {
+		return nil, fmt.Errorf("signing: RSA key %q must be at least 2048 bits", id)
+	}
+	return key, nil
+}
+
+// ActiveKey returns the ID of the key new tokens are signed with.
+func (k *Keyring) ActiveKey() string {
+	return k.active
+}
+
+// Sign signs claims with the active key.
+func (k *Keyring) Sign(claims jwt.Claims) (string, error) {
+	key := k.keys[k.active]
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Alg), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.private)
+}
+
+// Parse verifies a token and fills claims. The kid header must name a key in
+// the ring and the alg header must match that key, so a token can't choose
+// how it is verified.
+func (k *Keyring) Parse(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := k.keys[kid]
+		if !ok {
+			return nil, ErrUnknownKey
+		}
+		if token.Method.Alg() != key.Alg {
+			return nil, fmt.Errorf("signing: key %q does not use %s", kid, token.Method.Alg())
+		}
+		return key.public, nil
+	}, jwt.WithValidMethods([]string{AlgRS256, AlgEdDSA}), jwt.WithExpirationRequired())
+}
+
+// Keys returns the keys sorted by ID.
+func (k *Keyring) Keys() []*Key {
+	keys := make([]*Key, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys
+}
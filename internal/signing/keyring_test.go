@@ -0,0 +1,228 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// testKeys holds PEM files for an RSA and an Ed25519 key pair.
+type testKeys struct {
+	dir                           string
+	rsaPrivate, rsaPublic         string
+	ed25519Private, ed25519Public string
+	rsaPublicPEM                  []byte
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k := &testKeys{dir: t.TempDir()}
+	k.rsaPrivate = k.write(t, "rsa.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+	k.rsaPublic = k.write(t, "rsa.pub", "PUBLIC KEY", marshalPublic(t, &rsaKey.PublicKey))
+	k.rsaPublicPEM, _ = os.ReadFile(k.rsaPublic)
+	edPKCS8, err := x509.MarshalPKCS8PrivateKey(edPrivate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.ed25519Private = k.write(t, "ed.pem", "PRIVATE KEY", edPKCS8)
+	k.ed25519Public = k.write(t, "ed.pub", "PUBLIC KEY", marshalPublic(t, edPublic))
+	return k
+}
+
+func marshalPublic(t *testing.T, key interface{}) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+func (k *testKeys) write(t *testing.T, name, pemType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(k.dir, name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: pemType, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func newTestKeyring(t *testing.T, active string, files map[string]string) *Keyring {
+	t.Helper()
+	kr, err := NewKeyring(active, files)
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	return kr
+}
+
+func claimsFor(expires time.Time) *jwt.RegisteredClaims {
+	return &jwt.RegisteredClaims{Subject: "1", ExpiresAt: jwt.NewNumericDate(expires)}
+}
+
+func TestSignParse(t *testing.T) {
+	keys := newTestKeys(t)
+	for _, tt := range []struct{ name, file, alg string }{
+		{"RSA", keys.rsaPrivate, AlgRS256},
+		{"Ed25519", keys.ed25519Private, AlgEdDSA},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			kr := newTestKeyring(t, "k1", map[string]string{"k1": tt.file})
+			raw, err := kr.Sign(claimsFor(time.Now().Add(time.Minute)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var claims jwt.RegisteredClaims
+			token, err := kr.Parse(raw, &claims)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if token.Header["kid"] != "k1" || token.Method.Alg() != tt.alg || claims.Subject != "1" {
+				t.Fatalf("unexpected token %v with claims %+v", token.Header, claims)
+			}
+		})
+	}
+}
+
+func TestParseAfterRotation(t *testing.T) {
+	keys := newTestKeys(t)
+	old := newTestKeyring(t, "k1", map[string]string{"k1": keys.rsaPrivate})
+	valid, err := old.Sign(claimsFor(time.Now().Add(time.Minute)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := old.Sign(claimsFor(time.Now().Add(-time.Minute)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// k2 is active and k1 is kept, as a public key only, for its tokens.
+	rotated := newTestKeyring(t, "k2", map[string]string{"k1": keys.rsaPublic, "k2": keys.ed25519Private})
+	if _, err := rotated.Parse(valid, &jwt.RegisteredClaims{}); err != nil {
+		t.Fatalf("token of the retired key: %v", err)
+	}
+	if _, err := rotated.Parse(expired, &jwt.RegisteredClaims{}); !errors.Is(err, jwt.ErrTokenExpired) {
+		t.Fatalf("expired token of the retired key: got %v, want ErrTokenExpired", err)
+	}
+	next, err := rotated.Sign(claimsFor(time.Now().Add(time.Minute)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token, err := rotated.Parse(next, &jwt.RegisteredClaims{}); err != nil || token.Header["kid"] != "k2" {
+		t.Fatalf("new token not signed with the active key: %v", err)
+	}
+
+	dropped := newTestKeyring(t, "k2", map[string]string{"k2": keys.ed25519Private})
+	if _, err := dropped.Parse(valid, &jwt.RegisteredClaims{}); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("token of a dropped key: got %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	keys := newTestKeys(t)
+	kr := newTestKeyring(t, "k1", map[string]string{"k1": keys.rsaPrivate, "k2": keys.ed25519Private})
+	other := newTestKeyring(t, "k1", map[string]string{"k1": newTestKeys(t).rsaPrivate})
+	edRing := newTestKeyring(t, "k2", map[string]string{"k2": keys.ed25519Private})
+	future := time.Now().Add(time.Minute)
+
+	withHeader := func(method jwt.SigningMethod, kid interface{}, key interface{}, claims jwt.Claims) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != nil {
+			token.Header["kid"] = kid
+		}
+		raw, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raw
+	}
+	resign := func(ring *Keyring, kid string) string {
+		token := jwt.NewWithClaims(jwt.GetSigningMethod(ring.keys[ring.active].Alg), claimsFor(future))
+		token.Header["kid"] = kid
+		raw, err := token.SignedString(ring.keys[ring.active].private)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raw
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"unknown kid", resign(kr, "k9"), ErrUnknownKey},
+		{"missing kid", withHeader(jwt.SigningMethodRS256, nil, kr.keys["k1"].private, claimsFor(future)), ErrUnknownKey},
+		{"kid that isn't a string", withHeader(jwt.SigningMethodRS256, 1, kr.keys["k1"].private, claimsFor(future)), ErrUnknownKey},
+		// HS256 keyed with the public key, which anyone can download.
+		{"HS256 with the public key", withHeader(jwt.SigningMethodHS256, "k1", keys.rsaPublicPEM, claimsFor(future)), nil},
+		{"none", withHeader(jwt.SigningMethodNone, "k1", jwt.UnsafeAllowNoneSignatureType, claimsFor(future)), nil},
+		{"EdDSA under an RSA kid", resign(edRing, "k1"), nil},
+		{"signed with another key", resign(other, "k1"), jwt.ErrTokenSignatureInvalid},
+		{"no expiry", withHeader(jwt.SigningMethodRS256, "k1", kr.keys["k1"].private, &jwt.RegisteredClaims{Subject: "1"}), jwt.ErrTokenRequiredClaimMissing},
+		{"expired", withHeader(jwt.SigningMethodRS256, "k1", kr.keys["k1"].private, claimsFor(time.Now().Add(-time.Minute))), jwt.ErrTokenExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := kr.Parse(tt.token, &jwt.RegisteredClaims{})
+			if err == nil {
+				t.Fatal("token accepted")
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewKeyringRejects(t *testing.T) {
+	keys := newTestKeys(t)
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	smallFile := keys.write(t, "small.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(small))
+	notPEM := filepath.Join(keys.dir, "plain.txt")
+	os.WriteFile(notPEM, []byte("not a key"), 0o600)
+
+	tests := []struct {
+		name   string
+		active string
+		files  map[string]string
+		want   error
+	}{
+		{"active key missing", "k2", map[string]string{"k1": keys.rsaPrivate}, ErrNoActiveKey},
+		{"active key is public only", "k1", map[string]string{"k1": keys.rsaPublic}, ErrNoActiveKey},
+		{"RSA key under 2048 bits", "k1", map[string]string{"k1": smallFile}, nil},
+		{"not PEM", "k1", map[string]string{"k1": notPEM}, nil},
+		{"missing file", "k1", map[string]string{"k1": filepath.Join(keys.dir, "absent.pem")}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeyring(tt.active, tt.files)
+			if err == nil {
+				t.Fatal("keyring accepted")
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
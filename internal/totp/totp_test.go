@@ -0,0 +1,109 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 seed of RFC 6238 appendix B, "12345678901234567890".
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeRFC6238Vectors(t *testing.T) {
+	// The RFC lists eight-digit codes; six-digit codes are their last six.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := tt.want[2:]; got != want {
+			t.Errorf("Code at %d = %s, want %s", tt.unix, got, want)
+		}
+	}
+}
+
+func TestCodeSecretFormats(t *testing.T) {
+	want, _ := Code(rfcSecret, 1)
+	for _, secret := range []string{strings.ToLower(rfcSecret), strings.TrimRight(rfcSecret, "=")} {
+		if got, err := Code(secret, 1); err != nil || got != want {
+			t.Errorf("Code(%q) = %s, %v; want %s", secret, got, err, want)
+		}
+	}
+	if _, err := Code("not base32!", 1); err != ErrInvalidSecret {
+		t.Fatalf("got %v, want ErrInvalidSecret", err)
+	}
+}
+
+func TestValidateSkewWindow(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := Step(now)
+
+	for offset := int64(-3); offset <= 3; offset++ {
+		code, err := Code(rfcSecret, step+offset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		matched, ok := Validate(rfcSecret, code, now)
+		inWindow := offset >= -Skew && offset <= Skew
+		if ok != inWindow {
+			t.Errorf("code of step %+d accepted = %v, want %v", offset, ok, inWindow)
+		}
+		if ok && matched != step+offset {
+			t.Errorf("code of step %+d matched step %d", offset, matched-step)
+		}
+	}
+}
+
+func TestValidateInput(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	code, _ := Code(rfcSecret, Step(now))
+
+	if _, ok := Validate(rfcSecret, " "+code[:3]+" "+code[3:]+" ", now); !ok {
+		t.Error("code with spaces refused")
+	}
+	for _, bad := range []string{"", code[:5], code + "0", "abcdef"} {
+		if _, ok := Validate(rfcSecret, bad, now); ok {
+			t.Errorf("Validate accepted %q", bad)
+		}
+	}
+	if _, ok := Validate("not base32!", code, now); ok {
+		t.Error("Validate accepted a code for an invalid secret")
+	}
+}
+
+func TestGenerateSecretAndURI(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Code(secret, 1); err != nil {
+		t.Fatalf("generated secret %q is unusable: %v", secret, err)
+	}
+	other, _ := GenerateSecret()
+	if other == secret {
+		t.Fatal("GenerateSecret repeated a secret")
+	}
+
+	u, err := url.Parse(URI("My App", "ana@example.com", secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if u.Scheme != "otpauth" || u.Host != "totp" || q.Get("secret") != secret || q.Get("issuer") != "My App" ||
+		q.Get("digits") != "6" || q.Get("period") != "30" {
+		t.Fatalf("unexpected URI %s", u)
+	}
+}